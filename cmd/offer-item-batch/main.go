@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/terui-ryota/offer-item/cmd"
+	"github.com/terui-ryota/offer-item/internal/app/batch"
+	"github.com/terui-ryota/offer-item/internal/app/batch/app"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	_ "go.uber.org/automaxprocs"
+)
+
+func main() {
+	job := flag.String("job", "", "実行する処理 (refresh-offer-item-statuses, purge-deleted-offer-items, archive-completed-offer-items, archive-offer-item, unarchive-offer-item, migrate-legacy-images)")
+	offerItemID := flag.String("offer-item-id", "", "対象のオファー案件ID (archive-offer-item, unarchive-offer-item)")
+	flag.Parse()
+
+	a, err := batch.InitializeApp(app.Options{Job: app.Job(*job), OfferItemID: model.OfferItemID(*offerItemID)})
+	if err != nil {
+		log.Default().Println("batch.InitializeApp:", err)
+		os.Exit(1)
+	}
+	cmd.StartApp(a)
+}
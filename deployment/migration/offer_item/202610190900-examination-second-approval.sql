@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE `offer_item`
+  ADD COLUMN `requires_second_approval` tinyint(1) NOT NULL DEFAULT '0' AFTER `needs_after_review`;
+
+ALTER TABLE `examination`
+  ADD COLUMN `is_passed` tinyint(1) DEFAULT NULL AFTER `examiner_name`,
+  ADD COLUMN `status` int(10) unsigned NOT NULL DEFAULT '0' AFTER `is_passed`,
+  ADD COLUMN `confirmer_name` varchar(255) DEFAULT NULL AFTER `status`;
+
+-- +migrate Down
+ALTER TABLE `examination`
+  DROP COLUMN `confirmer_name`,
+  DROP COLUMN `status`,
+  DROP COLUMN `is_passed`;
+
+ALTER TABLE `offer_item`
+  DROP COLUMN `requires_second_approval`;
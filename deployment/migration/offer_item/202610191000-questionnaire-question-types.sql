@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE `questionnaire_question`
+  ADD COLUMN `is_optional` tinyint(1) NOT NULL DEFAULT '0' AFTER `answer_options`,
+  ADD COLUMN `min_value` double DEFAULT NULL AFTER `is_optional`,
+  ADD COLUMN `max_value` double DEFAULT NULL AFTER `min_value`;
+
+ALTER TABLE `questionnaire_question_answer`
+  ADD COLUMN `selected_options` json DEFAULT NULL AFTER `answer`;
+
+-- +migrate Down
+ALTER TABLE `questionnaire_question_answer`
+  DROP COLUMN `selected_options`;
+
+ALTER TABLE `questionnaire_question`
+  DROP COLUMN `max_value`,
+  DROP COLUMN `min_value`,
+  DROP COLUMN `is_optional`;
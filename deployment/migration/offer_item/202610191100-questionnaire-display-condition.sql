@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE `questionnaire_question`
+  ADD COLUMN `display_condition_question_id` char(22) DEFAULT NULL AFTER `max_value`,
+  ADD COLUMN `display_condition_option` text AFTER `display_condition_question_id`;
+
+-- +migrate Down
+ALTER TABLE `questionnaire_question`
+  DROP COLUMN `display_condition_option`,
+  DROP COLUMN `display_condition_question_id`;
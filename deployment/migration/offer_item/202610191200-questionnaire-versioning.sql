@@ -0,0 +1,39 @@
+-- +migrate Up
+ALTER TABLE `questionnaire`
+  ADD COLUMN `deleted_at` datetime DEFAULT NULL AFTER `description`;
+
+ALTER TABLE `questionnaire_question`
+  ADD COLUMN `version` int(10) unsigned NOT NULL DEFAULT 1 AFTER `priority`;
+
+ALTER TABLE `questionnaire_question_answer`
+  ADD COLUMN `question_version` int(10) unsigned NOT NULL DEFAULT 1 AFTER `answer`;
+
+CREATE TABLE `questionnaire_question_history` (
+  `id` char(22) NOT NULL,
+  `questionnaire_question_id` char(22) NOT NULL,
+  `offer_item_id` char(22) NOT NULL,
+  `version` int(10) unsigned NOT NULL,
+  `title` text NOT NULL,
+  `type` int(11) NOT NULL,
+  `image` mediumtext NOT NULL,
+  `answer_options` json DEFAULT NULL,
+  `is_optional` tinyint(1) NOT NULL,
+  `min_value` double DEFAULT NULL,
+  `max_value` double DEFAULT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `questionnaire_question_id_version` (`questionnaire_question_id`,`version`),
+  KEY `offer_item_id` (`offer_item_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE IF EXISTS `questionnaire_question_history`;
+
+ALTER TABLE `questionnaire_question_answer`
+  DROP COLUMN `question_version`;
+
+ALTER TABLE `questionnaire_question`
+  DROP COLUMN `version`;
+
+ALTER TABLE `questionnaire`
+  DROP COLUMN `deleted_at`;
@@ -0,0 +1,22 @@
+-- +migrate Up
+-- 画像データはオブジェクトストレージに保存し、DBにはオブジェクトキーのみを保存する
+-- 既存の画像データはバッチ (migrate-legacy-images) でオブジェクトストレージに移行するため、ここではカラムの型を変更しない
+-- カラムは移行後に 202610192300-narrow-object-storage-keys.sql で狭める
+ALTER TABLE `questionnaire_question`
+  MODIFY COLUMN `image` mediumtext NOT NULL COMMENT '質問画像のオブジェクトキー。移行前の値は画像データ';
+
+ALTER TABLE `questionnaire_question_history`
+  MODIFY COLUMN `image` mediumtext NOT NULL COMMENT '質問画像のオブジェクトキー。移行前の値は画像データ';
+
+ALTER TABLE `examination`
+  MODIFY COLUMN `sns_screenshot_url` mediumblob COMMENT 'SNS投稿のスクリーンショットのオブジェクトキー。移行前の値は画像データ';
+
+-- +migrate Down
+ALTER TABLE `examination`
+  MODIFY COLUMN `sns_screenshot_url` mediumblob;
+
+ALTER TABLE `questionnaire_question_history`
+  MODIFY COLUMN `image` mediumtext NOT NULL;
+
+ALTER TABLE `questionnaire_question`
+  MODIFY COLUMN `image` mediumtext NOT NULL;
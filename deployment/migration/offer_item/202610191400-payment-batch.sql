@@ -0,0 +1,34 @@
+-- +migrate Up
+CREATE TABLE `payment_batch` (
+  `id` char(22) NOT NULL,
+  `status` int(10) unsigned NOT NULL COMMENT '1: 作成済み, 2: 確定',
+  `total_amount` int(11) NOT NULL COMMENT '支払い総額',
+  `confirmed_at` datetime DEFAULT NULL,
+  `created_at` datetime NOT NULL,
+  `updated_at` datetime NOT NULL,
+  `deleted_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `payment_item` (
+  `id` char(22) NOT NULL,
+  `payment_batch_id` char(22) NOT NULL,
+  `offer_item_id` char(22) NOT NULL,
+  `assignee_id` char(22) NOT NULL,
+  `ameba_id` varchar(256) NOT NULL,
+  `writing_fee` int(11) NOT NULL COMMENT '執筆報酬',
+  `special_amount` int(11) NOT NULL COMMENT '特単金額',
+  `amount` int(11) NOT NULL COMMENT '支払い金額',
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_assignee_id` (`assignee_id`),
+  KEY `idx_payment_batch_id` (`payment_batch_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `assignee`
+  ADD COLUMN `payment_batch_id` char(22) DEFAULT NULL COMMENT '支払い完了時の支払いバッチID' AFTER `decline_reason`;
+
+-- +migrate Down
+ALTER TABLE `assignee` DROP COLUMN `payment_batch_id`;
+DROP TABLE `payment_item`;
+DROP TABLE `payment_batch`;
@@ -0,0 +1,26 @@
+-- +migrate Up
+CREATE TABLE `writing_fee_tier` (
+  `id` char(22) NOT NULL,
+  `offer_item_id` char(22) NOT NULL,
+  `min_follower_count` int(11) NOT NULL COMMENT 'この単価が適用される最小フォロワー数',
+  `writing_fee` int(11) NOT NULL COMMENT '執筆報酬',
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `offer_item_id` (`offer_item_id`,`min_follower_count`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE `writing_fee_history` (
+  `id` char(22) NOT NULL,
+  `assignee_id` char(22) NOT NULL,
+  `previous_writing_fee` int(11) NOT NULL COMMENT '変更前の執筆報酬',
+  `writing_fee` int(11) NOT NULL COMMENT '変更後の執筆報酬',
+  `changed_by` varchar(64) NOT NULL COMMENT '変更したユーザー',
+  `changed_at` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_assignee_id` (`assignee_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE `writing_fee_history`;
+DROP TABLE `writing_fee_tier`;
@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE `offer_item`
+  ADD COLUMN `status` int(11) NOT NULL DEFAULT 0 COMMENT 'ライフサイクル状態(1:下書き 2:募集開始前 3:参加募集中 4:実施中 5:審査中 6:支払い中 7:完了 8:アーカイブ)' AFTER `is_failed_after_review_mail_sent`,
+  ADD KEY `idx_status` (`status`);
+
+-- 終了している案件は完了、それ以外は参加募集スケジュールから判定する
+-- 参加募集期間後の案件は実施中とし、アサイニーのステージによる判定は状態の定期更新に任せる
+UPDATE `offer_item` o
+  LEFT JOIN `schedule` s ON s.`offer_item_id` = o.`id` AND s.`schedule_type` = 1
+SET o.`status` = CASE
+  WHEN o.`is_closed` = 1 THEN 7
+  WHEN s.`start_date` IS NOT NULL AND NOW() < s.`start_date` THEN 2
+  WHEN s.`end_date` IS NOT NULL AND NOW() <= s.`end_date` THEN 3
+  ELSE 4
+END;
+
+-- is_closed はデプロイ中の旧バージョンのサーバーが参照するため、ここでは削除しない
+-- アプリケーションが状態と合わせて更新し、全てのサーバーが status を参照するようになった後のマイグレーションで削除する
+ALTER TABLE `offer_item`
+  ALTER COLUMN `status` DROP DEFAULT;
+
+-- +migrate Down
+ALTER TABLE `offer_item`
+  DROP KEY `idx_status`,
+  DROP COLUMN `status`;
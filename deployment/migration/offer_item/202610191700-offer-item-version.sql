@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE `offer_item`
+  ADD COLUMN `version` int(11) NOT NULL DEFAULT 1 COMMENT '楽観的排他制御のためのバージョン' AFTER `status`;
+
+-- +migrate Down
+ALTER TABLE `offer_item`
+  DROP COLUMN `version`;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE `offer_item_revision` (
+  `id` char(22) NOT NULL,
+  `offer_item_id` char(22) NOT NULL,
+  `version` int(11) NOT NULL COMMENT '更新後のオファー案件のバージョン',
+  `changes` json DEFAULT NULL COMMENT '変更された項目(項目名・変更したメソッド・変更前後の値)',
+  `snapshot` json DEFAULT NULL COMMENT '更新後のオファー案件・スケジュール・案件情報・アンケート',
+  `changed_by` varchar(255) NOT NULL DEFAULT '' COMMENT '更新した操作者',
+  `changed_at` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `idx_offer_item_id_changed_at` (`offer_item_id`,`changed_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+-- +migrate Down
+DROP TABLE `offer_item_revision`;
@@ -0,0 +1,20 @@
+-- +migrate Up
+ALTER TABLE `questionnaire_question`
+  ADD COLUMN `deleted_at` datetime DEFAULT NULL AFTER `version`;
+
+ALTER TABLE `questionnaire_question_answer`
+  ADD COLUMN `deleted_at` datetime DEFAULT NULL AFTER `selected_options`;
+
+-- 保持期間を過ぎた論理削除済みのオファー案件の検索に使用する
+ALTER TABLE `offer_item`
+  ADD INDEX `idx_deleted_at` (`deleted_at`);
+
+-- +migrate Down
+ALTER TABLE `offer_item`
+  DROP INDEX `idx_deleted_at`;
+
+ALTER TABLE `questionnaire_question_answer`
+  DROP COLUMN `deleted_at`;
+
+ALTER TABLE `questionnaire_question`
+  DROP COLUMN `deleted_at`;
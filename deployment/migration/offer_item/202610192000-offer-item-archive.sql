@@ -0,0 +1,21 @@
+-- +migrate Up
+CREATE TABLE `offer_item_archive` (
+  `id` char(22) NOT NULL,
+  `offer_item_id` char(22) NOT NULL,
+  `format_version` int(11) NOT NULL COMMENT 'アーカイブの形式のバージョン',
+  `object_key` varchar(255) NOT NULL COMMENT 'アーカイブを保存したオブジェクトのキー',
+  `archived_by` varchar(255) NOT NULL DEFAULT '',
+  `archived_at` datetime NOT NULL,
+  `created_at` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `offer_item_id` (`offer_item_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+ALTER TABLE `offer_item`
+  ADD INDEX `idx_status_updated_at` (`status`, `updated_at`);
+
+-- +migrate Down
+ALTER TABLE `offer_item`
+  DROP INDEX `idx_status_updated_at`;
+
+DROP TABLE `offer_item_archive`;
@@ -0,0 +1,16 @@
+-- +migrate Up
+-- カーソルによるページ送りで、ステージごとのアサイニーを ID 順に取得する
+ALTER TABLE `assignee`
+  ADD INDEX `idx_offer_item_id_stage_id` (`offer_item_id`, `stage`, `id`),
+  ADD INDEX `idx_stage_id` (`stage`, `id`);
+
+ALTER TABLE `offer_item`
+  ADD INDEX `idx_created_at_id` (`created_at`, `id`);
+
+-- +migrate Down
+ALTER TABLE `offer_item`
+  DROP INDEX `idx_created_at_id`;
+
+ALTER TABLE `assignee`
+  DROP INDEX `idx_stage_id`,
+  DROP INDEX `idx_offer_item_id_stage_id`;
@@ -0,0 +1,8 @@
+-- +migrate Up
+-- 日本語の語を検索できるよう ngram パーサーを使用する。検索語の最小文字数は ngram_token_size (デフォルト 2) に合わせる
+ALTER TABLE `offer_item`
+  ADD FULLTEXT INDEX `ft_offer_item_content` (`name`, `product_features`, `cautionary_points`, `reference_info`) WITH PARSER ngram;
+
+-- +migrate Down
+ALTER TABLE `offer_item`
+  DROP INDEX `ft_offer_item_content`;
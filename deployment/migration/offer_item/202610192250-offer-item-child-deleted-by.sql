@@ -0,0 +1,44 @@
+-- +migrate Up
+-- オファー案件の削除時に、集約に含まれるレコードにも削除した操作者を記録する
+ALTER TABLE `schedule`
+  ADD COLUMN `deleted_by` varchar(64) DEFAULT NULL AFTER `deleted_at`;
+
+ALTER TABLE `assignee`
+  ADD COLUMN `deleted_by` varchar(64) DEFAULT NULL AFTER `deleted_at`;
+
+ALTER TABLE `examination`
+  ADD COLUMN `deleted_by` varchar(64) DEFAULT NULL AFTER `deleted_at`;
+
+ALTER TABLE `drafted_item_info`
+  ADD COLUMN `deleted_by` varchar(64) DEFAULT NULL AFTER `deleted_at`;
+
+ALTER TABLE `questionnaire`
+  ADD COLUMN `deleted_by` varchar(64) DEFAULT NULL AFTER `deleted_at`;
+
+ALTER TABLE `questionnaire_question`
+  ADD COLUMN `deleted_by` varchar(64) DEFAULT NULL AFTER `deleted_at`;
+
+ALTER TABLE `questionnaire_question_answer`
+  ADD COLUMN `deleted_by` varchar(64) DEFAULT NULL AFTER `deleted_at`;
+
+-- +migrate Down
+ALTER TABLE `questionnaire_question_answer`
+  DROP COLUMN `deleted_by`;
+
+ALTER TABLE `questionnaire_question`
+  DROP COLUMN `deleted_by`;
+
+ALTER TABLE `questionnaire`
+  DROP COLUMN `deleted_by`;
+
+ALTER TABLE `drafted_item_info`
+  DROP COLUMN `deleted_by`;
+
+ALTER TABLE `examination`
+  DROP COLUMN `deleted_by`;
+
+ALTER TABLE `assignee`
+  DROP COLUMN `deleted_by`;
+
+ALTER TABLE `schedule`
+  DROP COLUMN `deleted_by`;
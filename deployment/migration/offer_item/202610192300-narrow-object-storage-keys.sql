@@ -0,0 +1,21 @@
+-- +migrate Up
+-- バッチ (migrate-legacy-images) で画像データをオブジェクトストレージに移行した後に適用する
+-- 画像データが残っている場合は、strict モードの ALTER TABLE がエラーになり値は切り詰められない
+ALTER TABLE `questionnaire_question`
+  MODIFY COLUMN `image` varchar(255) NOT NULL COMMENT '質問画像のオブジェクトキー';
+
+ALTER TABLE `questionnaire_question_history`
+  MODIFY COLUMN `image` varchar(255) NOT NULL COMMENT '質問画像のオブジェクトキー';
+
+ALTER TABLE `examination`
+  MODIFY COLUMN `sns_screenshot_url` varchar(255) DEFAULT NULL COMMENT 'SNS投稿のスクリーンショットのオブジェクトキー';
+
+-- +migrate Down
+ALTER TABLE `examination`
+  MODIFY COLUMN `sns_screenshot_url` mediumblob COMMENT 'SNS投稿のスクリーンショットのオブジェクトキー。移行前の値は画像データ';
+
+ALTER TABLE `questionnaire_question_history`
+  MODIFY COLUMN `image` mediumtext NOT NULL COMMENT '質問画像のオブジェクトキー。移行前の値は画像データ';
+
+ALTER TABLE `questionnaire_question`
+  MODIFY COLUMN `image` mediumtext NOT NULL COMMENT '質問画像のオブジェクトキー。移行前の値は画像データ';
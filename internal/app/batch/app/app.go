@@ -0,0 +1,117 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/application/usecase"
+	"github.com/terui-ryota/offer-item/internal/common"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Job バッチで実行する処理の名前
+type Job string
+
+const (
+	// 日付の経過で変わるオファー案件の状態を更新する
+	JobRefreshOfferItemStatuses Job = "refresh-offer-item-statuses"
+	// 保持期間を過ぎた論理削除済みのオファー案件を物理削除する
+	JobPurgeDeletedOfferItems Job = "purge-deleted-offer-items"
+	// 完了から一定期間が経過したオファー案件をアーカイブに退避する
+	JobArchiveCompletedOfferItems Job = "archive-completed-offer-items"
+	// 指定したオファー案件をアーカイブに退避する
+	JobArchiveOfferItem Job = "archive-offer-item"
+	// 指定したオファー案件をアーカイブから再取り込みする
+	JobUnarchiveOfferItem Job = "unarchive-offer-item"
+	// DBに直接保存された画像データをオブジェクトストレージに移行する
+	JobMigrateLegacyImages Job = "migrate-legacy-images"
+)
+
+// Options バッチの実行時に指定するオプション
+type Options struct {
+	Job Job
+	// 対象のオファー案件ID。オファー案件を指定して実行する処理でのみ使用する
+	OfferItemID model.OfferItemID
+}
+
+func NewApp(
+	opts Options,
+	offerItemUsecase usecase.OfferItemUsecase,
+	cfg *config.GRPCConfig,
+) common.App {
+	return &App{job: opts.Job, offerItemID: opts.OfferItemID, offerItemUsecase: offerItemUsecase, cfg: cfg}
+}
+
+// App 指定された処理を1回実行して終了するバッチ
+type App struct {
+	job              Job
+	offerItemID      model.OfferItemID
+	offerItemUsecase usecase.OfferItemUsecase
+	cfg              *config.GRPCConfig
+}
+
+func (a *App) Start() {
+	logger.Default().Info("start job", zap.String("job", string(a.job)))
+	if err := a.run(context.Background()); err != nil {
+		logger.Default().Error("failed to run job.", zap.String("job", string(a.job)), zap.Error(err))
+		os.Exit(1)
+	}
+	logger.Default().Info("finish job", zap.String("job", string(a.job)))
+}
+
+func (a *App) run(ctx context.Context) error {
+	switch a.job {
+	case JobRefreshOfferItemStatuses:
+		if err := a.offerItemUsecase.RefreshOfferItemStatuses(ctx); err != nil {
+			return fmt.Errorf("a.offerItemUsecase.RefreshOfferItemStatuses: %w", err)
+		}
+	case JobPurgeDeletedOfferItems:
+		if a.cfg.Retention == nil || a.cfg.Retention.DeletedOfferItemRetention.Duration <= 0 {
+			return fmt.Errorf("retention.deleted_offer_item_retention is not configured")
+		}
+		deletedBefore := time.Now().Add(-a.cfg.Retention.DeletedOfferItemRetention.Duration)
+		purged, err := a.offerItemUsecase.PurgeDeletedOfferItems(ctx, deletedBefore)
+		if err != nil {
+			return fmt.Errorf("a.offerItemUsecase.PurgeDeletedOfferItems: %w", err)
+		}
+		logger.Default().Info("purged deleted offer items", zap.Int("count", purged), zap.Time("deleted_before", deletedBefore))
+	case JobArchiveCompletedOfferItems:
+		if a.cfg.Retention == nil || a.cfg.Retention.CompletedOfferItemArchiveAfter.Duration <= 0 {
+			return fmt.Errorf("retention.completed_offer_item_archive_after is not configured")
+		}
+		completedBefore := time.Now().Add(-a.cfg.Retention.CompletedOfferItemArchiveAfter.Duration)
+		archived, err := a.offerItemUsecase.ArchiveCompletedOfferItems(ctx, completedBefore)
+		if err != nil {
+			return fmt.Errorf("a.offerItemUsecase.ArchiveCompletedOfferItems: %w", err)
+		}
+		logger.Default().Info("archived completed offer items", zap.Int("count", archived), zap.Time("completed_before", completedBefore))
+	case JobArchiveOfferItem:
+		if a.offerItemID == "" {
+			return fmt.Errorf("offer item id is required")
+		}
+		if err := a.offerItemUsecase.ArchiveOfferItem(ctx, a.offerItemID); err != nil {
+			return fmt.Errorf("a.offerItemUsecase.ArchiveOfferItem: %w", err)
+		}
+	case JobUnarchiveOfferItem:
+		if a.offerItemID == "" {
+			return fmt.Errorf("offer item id is required")
+		}
+		if err := a.offerItemUsecase.UnarchiveOfferItem(ctx, a.offerItemID); err != nil {
+			return fmt.Errorf("a.offerItemUsecase.UnarchiveOfferItem: %w", err)
+		}
+	case JobMigrateLegacyImages:
+		migrated, err := a.offerItemUsecase.MigrateLegacyImages(ctx)
+		if err != nil {
+			return fmt.Errorf("a.offerItemUsecase.MigrateLegacyImages: %w", err)
+		}
+		logger.Default().Info("migrated legacy images", zap.Int("count", migrated))
+	default:
+		return fmt.Errorf("unknown job: %s", a.job)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:generate go run github.com/google/wire/cmd/wire
+//go:build wireinject
+
+package batch
+
+import (
+	"github.com/google/wire"
+	"github.com/terui-ryota/offer-item/internal/app/batch/app"
+	grpcConf "github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/application"
+	"github.com/terui-ryota/offer-item/internal/common"
+	"github.com/terui-ryota/offer-item/internal/common/config"
+	"github.com/terui-ryota/offer-item/internal/infrastructure"
+)
+
+// バッチ初期化。設定はgRPCサーバーと共通のものを使用する
+func InitializeApp(opts app.Options) (common.App, error) {
+	wire.Build(
+		app.NewApp,
+		grpcConf.LoadConfig,
+		wire.FieldsOf(new(*grpcConf.GRPCConfig), "Database", "Rakuten", "Validation", "Storage", "AffiliateItem", "ExternalContexts"),
+		config.LoadDB,
+		infrastructure.WireSet,
+		application.WireSet,
+		grpcConf.LoadHttpClient,
+	)
+	return nil, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/terui-ryota/offer-item/internal/common"
 	"github.com/terui-ryota/offer-item/pkg/apperr"
 	"github.com/terui-ryota/offer-item/pkg/logger"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
 	"github.com/terui-ryota/offer-item/servers"
 	"github.com/terui-ryota/offer-item/servers/grpc_proxyserver"
 	"github.com/terui-ryota/protofiles/go/offer_item"
@@ -35,6 +36,7 @@ func NewApp(
 	}
 	interceptors := []grpc.UnaryServerInterceptor{
 		//common_metadata.UnaryServerInterceptor(),
+		requestmeta.UnaryServerInterceptor(),
 		apperr.ApplicationErrorUnaryServerInterceptor(),
 	}
 	opts = append(opts,
@@ -25,22 +25,143 @@ type GRPCConfig struct {
 	Validation       *ValidationConfig            `yaml:"validation"`
 	Rakuten          *RakutenConfig               `yaml:"rakuten"`
 	HttpClient       HttpClient                   `yaml:"http_client"`
+	Storage          *StorageConfig               `yaml:"storage"`
+	Retention        *RetentionConfig             `yaml:"retention"`
+	AffiliateItem    *AffiliateItemConfig         `yaml:"affiliate_item"`
 }
 
 type ValidationConfig struct {
 	MaxInputAssigneeListNum int `yaml:"max_input_assignee_list_num"`
+	// オファー案件の更新時にバージョンの指定を必須にするか。デフォルトは false
+	// バージョンは gRPC メタデータの x-offer-item-version で指定する。全てのクライアントの対応後に有効にすること
+	RequireOfferItemVersion bool `yaml:"require_offer_item_version"`
+}
+
+type RetentionConfig struct {
+	// 論理削除されたオファー案件を物理削除するまでの保持期間
+	DeletedOfferItemRetention libtime.Duration `yaml:"deleted_offer_item_retention"`
+	// 完了したオファー案件をアーカイブに退避するまでの期間
+	CompletedOfferItemArchiveAfter libtime.Duration `yaml:"completed_offer_item_archive_after"`
 }
 
 type RakutenConfig struct {
-	ApplicationID []string            `yaml:"application_id"`
-	RateLimit     int                 `yaml:"rate_limit"`
-	RakutenIchiba RakutenIchibaConfig `yaml:"ichiba"`
+	ApplicationID []string `yaml:"application_id"`
+	// アプリIDごとの秒間のリクエスト数の上限。0 以下の場合は制限しない
+	RateLimit int `yaml:"rate_limit"`
+	// 並行してリクエストする最大数。0 以下の場合は 1
+	Concurrency    int                         `yaml:"concurrency"`
+	Retry          RakutenRetryConfig          `yaml:"retry"`
+	CircuitBreaker RakutenCircuitBreakerConfig `yaml:"circuit_breaker"`
+	RakutenIchiba  RakutenIchibaConfig         `yaml:"ichiba"`
+}
+
+// RakutenRetryConfig 接続エラー、5xx、429 のリトライの設定
+type RakutenRetryConfig struct {
+	// 最初のリクエストを含む最大試行回数。1 以下の場合はリトライしない
+	MaxAttempts int `yaml:"max_attempts"`
+	// 初回のリトライまでの待機時間。リトライごとに 2 倍にする
+	InitialBackoff libtime.Duration `yaml:"initial_backoff"`
+	// 待機時間の上限。Retry-After がこれを超える場合はリトライしない
+	MaxBackoff libtime.Duration `yaml:"max_backoff"`
+}
+
+// RakutenCircuitBreakerConfig 楽天APIの障害時にリクエストを遮断する設定
+type RakutenCircuitBreakerConfig struct {
+	// 連続して失敗した回数がこの値に達するとリクエストを遮断する。0 以下の場合は遮断しない
+	FailureThreshold int `yaml:"failure_threshold"`
+	// 遮断してから試しにリクエストするまでの時間
+	OpenDuration libtime.Duration `yaml:"open_duration"`
 }
 
 type RakutenIchibaConfig struct {
 	Format        string `yaml:"format"`
 	PartnerID     string `yaml:"partner_id"`
 	ClickIDPrefix string `yaml:"click_id_prefix"`
+	// 楽天商品検索APIのURL。空の場合は楽天のAPIを使用する
+	ItemSearchURL string `yaml:"item_search_url"`
+}
+
+// AffiliateItemConfig 案件情報の取得元の設定
+type AffiliateItemConfig struct {
+	Providers []AffiliateItemProviderConfig `yaml:"providers"`
+	Cache     *CacheConfig                  `yaml:"cache"`
+}
+
+// CacheConfig 取得した案件情報のキャッシュの設定
+type CacheConfig struct {
+	// memory または redis。memory はプロセスごとにキャッシュするため、キャッシュの削除はリクエストを処理したレプリカにのみ反映される
+	Driver string `yaml:"driver"`
+	// 取得した値を最新とみなす期間
+	TTL libtime.Duration `yaml:"ttl"`
+	// TTL の経過後、バックグラウンドで再取得する間に古い値を返す期間
+	StaleTTL libtime.Duration `yaml:"stale_ttl"`
+	Redis    RedisConfig      `yaml:"redis"`
+}
+
+// RedisConfig Redis の接続設定
+type RedisConfig struct {
+	// host:port
+	Addr     string `yaml:"addr"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+	// 他の用途とキーが重複しないよう、キーの先頭に付ける文字列
+	KeyPrefix string `yaml:"key_prefix"`
+	// 接続・読み書きのタイムアウト
+	Timeout libtime.Duration `yaml:"timeout"`
+	// 保持するアイドル接続の最大数
+	MaxIdleConns int `yaml:"max_idle_conns"`
+}
+
+// AffiliateItemProviderConfig 案件IDの接頭辞ごとの取得元の設定
+type AffiliateItemProviderConfig struct {
+	// 案件IDの接頭辞。複数の接頭辞が一致する場合は長い方を優先する
+	Prefix string `yaml:"prefix"`
+	// rakuten, grpc または catalog
+	Driver string `yaml:"driver"`
+	// rakuten で使用する親案件の情報
+	ParentItem *ParentItemConfig `yaml:"parent_item"`
+	// catalog で使用する案件カタログの JSON ファイルのパス
+	CatalogPath string `yaml:"catalog_path"`
+}
+
+// ParentItemConfig DF案件の親となる案件の情報
+type ParentItemConfig struct {
+	ID       string `yaml:"id"`
+	Name     string `yaml:"name"`
+	ImageURL string `yaml:"image_url"`
+	// 広告主名
+	ContentName string `yaml:"content_name"`
+	URL         string `yaml:"url"`
+	// 料率(%)
+	CommissionRate float32 `yaml:"commission_rate"`
+	// 固定料率(%)
+	StaticCommissionRate float32 `yaml:"static_commission_rate"`
+}
+
+type StorageConfig struct {
+	// local または s3
+	Driver string             `yaml:"driver"`
+	Local  LocalStorageConfig `yaml:"local"`
+	S3     S3StorageConfig    `yaml:"s3"`
+}
+
+type LocalStorageConfig struct {
+	BaseDir string `yaml:"base_dir"`
+	// 保存したファイルを配信するURL。未設定の場合は file:// のURLを返す
+	BaseURL string `yaml:"base_url"`
+}
+
+// S3StorageConfig S3 互換のオブジェクトストレージの設定。パス形式(endpoint/bucket/key)でアクセスする
+type S3StorageConfig struct {
+	Endpoint        string `yaml:"endpoint"`
+	Region          string `yaml:"region"`
+	Bucket          string `yaml:"bucket"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	// オブジェクトを公開しているURL(CDNなど)。未設定の場合は署名付きURLを発行する
+	PublicBaseURL string `yaml:"public_base_url"`
+	// 署名付きURLの有効期限
+	PresignExpires libtime.Duration `yaml:"presign_expires"`
 }
 
 type HttpClient struct {
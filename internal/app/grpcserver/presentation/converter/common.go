@@ -24,6 +24,7 @@ func ListConditionPBToModel(pb *common.ListCondition) (*model.ListCondition, err
 	if err != nil {
 		return nil, err
 	}
+	// TODO: protofiles の ListCondition にカーソルと総数取得の有無が追加されたら model.NewCursorListCondition を使用する
 	lc, err := model.NewListCondition(int(pb.GetOffset()), int(pb.GetLimit()), sorts)
 	if err != nil {
 		return nil, fmt.Errorf("model.NewListCondition: %w", err)
@@ -43,6 +44,7 @@ func DescPBToModel(pb common.Ordering) bool {
 	return pb != common.Ordering_ASC
 }
 
+// TODO: protofiles の ListResult に次ページのカーソルが追加されたら m.NextCursor() を設定する
 func ListResultModelToPB(m *model.ListResult) *common.ListResult {
 	return &common.ListResult{
 		Count:      uint32(m.Count()),
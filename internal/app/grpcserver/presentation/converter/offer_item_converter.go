@@ -30,15 +30,21 @@ func OfferItemModelToPB(m *model.OfferItem) (*offer_item.OfferItem, error) {
 		schedules = append(schedules, ScheduleModelToPB(schedule))
 	}
 
-	if m.DraftedItemInfo() == nil {
+	// 下書きの場合は案件情報が未設定のことがある
+	var (
+		draftedItemInfo *offer_item.ItemInfo
+		pickInfo        *offer_item.PickInfo
+	)
+	if m.DraftedItemInfo() != nil {
+		draftedItemInfo = ItemInfoModelToPB(m.DraftedItemInfo())
+	} else if !m.IsDraft() {
 		return nil, apperr.OfferItemInternalError.Wrap(errors.New(fmt.Sprintf("DraftedItemInfo is nil for OfferItem with ID: %s", m.ID().String())))
 	}
-	draftedItemInfo := ItemInfoModelToPB(m.DraftedItemInfo())
-
-	if m.PickInfo() == nil {
+	if m.PickInfo() != nil {
+		pickInfo = PickInfoModelToPB(m.PickInfo())
+	} else if !m.IsDraft() {
 		return nil, apperr.OfferItemInternalError.Wrap(errors.New(fmt.Sprintf("PickInfo is nil for OfferItem with ID: %s", m.ID().String())))
 	}
-	pickInfo := PickInfoModelToPB(m.PickInfo())
 
 	offerItemPB := &offer_item.OfferItem{
 		Id:             m.ID().String(),
@@ -80,6 +86,7 @@ func OfferItemModelToPB(m *model.OfferItem) (*offer_item.OfferItem, error) {
 		Schedules:                         schedules,
 		DraftedItemInfo:                   draftedItemInfo,
 		PickInfo:                          pickInfo,
+		// TODO: protofiles にバージョンの項目が追加されたら m.Version() を設定する
 	}
 
 	return offerItemPB, nil
@@ -159,7 +166,7 @@ func QuestionnaireModelToPB(m *model.Questionnaire) *offer_item.Questionnaire {
 func QuestionAnswerModelToPB(m *model.QuestionAnswer) *offer_item.QuestionAnswer {
 	return &offer_item.QuestionAnswer{
 		QuestionId: m.QuestionID().String(),
-		Content:    m.Content(),
+		Content:    m.DisplayContent(),
 	}
 }
 
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"google.golang.org/grpc/metadata"
+)
+
+// SearchHighlightsMetadataKey 全文検索で検索語に一致した箇所を返す gRPC レスポンスヘッダーのキー
+// TODO: protofiles の SearchOfferItemResponse にハイライトの項目が追加されたら、レスポンスに設定する
+const SearchHighlightsMetadataKey = "x-search-highlights-bin"
+
+type searchHighlightJSON struct {
+	Field    string               `json:"field"`
+	Fragment string               `json:"fragment"`
+	Matches  []highlightRangeJSON `json:"matches"`
+}
+
+type highlightRangeJSON struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// SearchHighlightsModelToMetadata オファー案件 ID ごとのハイライトを JSON にしてメタデータに変換する
+func SearchHighlightsModelToMetadata(result *model.ListOfferItemResult) (metadata.MD, error) {
+	highlights := make(map[string][]searchHighlightJSON, len(result.OfferItems()))
+	for _, offerItem := range result.OfferItems() {
+		hs := make([]searchHighlightJSON, 0, len(result.Highlights(offerItem.ID())))
+		for _, h := range result.Highlights(offerItem.ID()) {
+			matches := make([]highlightRangeJSON, 0, len(h.Matches()))
+			for _, m := range h.Matches() {
+				matches = append(matches, highlightRangeJSON{Start: m.Start(), End: m.End()})
+			}
+			hs = append(hs, searchHighlightJSON{
+				Field:    string(h.Field()),
+				Fragment: h.Fragment(),
+				Matches:  matches,
+			})
+		}
+		highlights[offerItem.ID().String()] = hs
+	}
+	b, err := json.Marshal(highlights)
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return metadata.Pairs(SearchHighlightsMetadataKey, string(b)), nil
+}
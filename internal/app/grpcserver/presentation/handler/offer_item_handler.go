@@ -10,7 +10,9 @@ import (
 	"github.com/terui-ryota/offer-item/internal/domain/dto"
 	"github.com/terui-ryota/offer-item/internal/domain/model"
 	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
 	offer_item "github.com/terui-ryota/protofiles/go/offer_item"
+	"google.golang.org/grpc"
 )
 
 func NewOfferItemHandler(offerItemUsecase usecase.OfferItemUsecase, assigneeUsecase usecase.AssigneeUsecase) offer_item.OfferItemHandlerServer {
@@ -195,7 +197,20 @@ func (h *offerItemHandler) SaveOfferItem(ctx context.Context, req *offer_item.Sa
 	}
 
 	// DTOに変換する
-	offerItemDTO := dto.SaveOfferItemPBToDTO(req.GetOfferItem())
+	offerItemDTO, err := dto.SaveOfferItemPBToDTO(req.GetOfferItem())
+	if err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("dto.SaveOfferItemPBToDTO: %w", err))
+	}
+	// TODO: protofiles にバージョンの項目が追加されたら、メタデータではなくリクエストの値を設定する
+	version, err := requestmeta.OfferItemVersionFromIncomingContext(ctx)
+	if err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("requestmeta.OfferItemVersionFromIncomingContext: %w", err))
+	}
+	offerItemDTO.Version = version
+	// TODO: protofiles に執筆報酬のデフォルト単価とフォロワー数の項目が追加されたら、メタデータではなくリクエストの値を設定する
+	if err := setWritingFeeMetadata(ctx, offerItemDTO); err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("setWritingFeeMetadata: %w", err))
+	}
 
 	// 作成する
 	if err := h.offerItemUsecase.SaveOfferItem(ctx, offerItemDTO); err != nil {
@@ -207,6 +222,36 @@ func (h *offerItemHandler) SaveOfferItem(ctx context.Context, req *offer_item.Sa
 	}, nil
 }
 
+// setWritingFeeMetadata メタデータで指定された執筆報酬のデフォルト単価とアサイニーのフォロワー数を DTO に設定する
+func setWritingFeeMetadata(ctx context.Context, d *dto.OfferItemDTO) error {
+	tiers, err := requestmeta.WritingFeeTiersFromIncomingContext(ctx)
+	if err != nil {
+		return fmt.Errorf("requestmeta.WritingFeeTiersFromIncomingContext: %w", err)
+	}
+	if tiers != nil {
+		writingFeeTiers := make([]dto.WritingFeeTier, 0, len(*tiers))
+		for _, t := range *tiers {
+			writingFeeTiers = append(writingFeeTiers, dto.WritingFeeTier{
+				MinFollowerCount: t.MinFollowerCount,
+				WritingFee:       t.WritingFee,
+			})
+		}
+		d.WritingFeeTiers = &writingFeeTiers
+	}
+
+	followerCounts, err := requestmeta.FollowerCountsFromIncomingContext(ctx)
+	if err != nil {
+		return fmt.Errorf("requestmeta.FollowerCountsFromIncomingContext: %w", err)
+	}
+	for i := range d.Assignees {
+		if count, ok := followerCounts[d.Assignees[i].AmebaID]; ok {
+			d.Assignees[i].FollowerCount = &count
+		}
+	}
+	return nil
+}
+
+// TODO: protofiles に案件情報のキャッシュを削除する管理用の RPC が追加されたら h.offerItemUsecase.InvalidateAffiliateItemCache を呼び出すハンドラーを追加する
 func (h *offerItemHandler) GetOfferItem(ctx context.Context, req *offer_item.GetOfferItemRequest) (*offer_item.GetOfferItemResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, apperr.OfferItemValidationError.Wrap(err)
@@ -243,7 +288,8 @@ func (h *offerItemHandler) ListOfferItem(ctx context.Context, req *offer_item.Li
 	}
 
 	// オファー案件一覧を取得
-	result, err := h.offerItemUsecase.ListOfferItem(ctx, condition)
+	// TODO: protofiles に状態の絞り込み条件が追加されたら statuses を設定する
+	result, err := h.offerItemUsecase.ListOfferItem(ctx, condition, nil)
 	if err != nil {
 		return nil, fmt.Errorf("h.offerItemUsecase.ListOfferItem: %w", err)
 	}
@@ -304,6 +350,10 @@ func (h *offerItemHandler) SearchOfferItem(ctx context.Context, req *offer_item.
 		offerItemName := req.GetOfferItemName()
 		searchCriteria.NameContains = &offerItemName
 	}
+	// TODO: protofiles に状態の絞り込み条件が追加されたら searchCriteria.StatusIn を設定する
+	// TODO: protofiles に投稿先・フラグ・スケジュールの期間・アサイニー・作成日時の絞り込み条件が追加されたら searchCriteria に設定する
+	// TODO: protofiles に全文検索の query が追加されたら、メタデータではなくリクエストの値を設定する
+	searchCriteria.Query = requestmeta.SearchQueryFromIncomingContext(ctx)
 
 	condition, err := converter.ListConditionPBToModel(req.GetCondition())
 	if err != nil {
@@ -315,6 +365,17 @@ func (h *offerItemHandler) SearchOfferItem(ctx context.Context, req *offer_item.
 		return nil, fmt.Errorf("h.offerItemUsecase.SearchOfferItem: %w", err)
 	}
 
+	// 全文検索の場合は、検索語に一致した箇所をレスポンスヘッダーで返す
+	if len(searchCriteria.QueryTerms()) > 0 {
+		md, err := converter.SearchHighlightsModelToMetadata(searchResult)
+		if err != nil {
+			return nil, fmt.Errorf("converter.SearchHighlightsModelToMetadata: %w", err)
+		}
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			return nil, fmt.Errorf("grpc.SetHeader: %w", err)
+		}
+	}
+
 	// protoに変換する
 	offerItemPBs := make([]*offer_item.OfferItem, 0, len(searchResult.OfferItems()))
 	for _, offerItem := range searchResult.OfferItems() {
@@ -332,6 +393,7 @@ func (h *offerItemHandler) SearchOfferItem(ctx context.Context, req *offer_item.
 	}, nil
 }
 
+// TODO: protofiles にブロガーのダッシュボードの RPC が追加されたら h.offerItemUsecase.GetBloggerDashboard を呼び出すハンドラーを追加する
 func (h *offerItemHandler) ListAssigneeOfferItemPair(ctx context.Context, req *offer_item.ListAssigneeOfferItemPairRequest) (*offer_item.ListAssigneeOfferItemPairResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, apperr.OfferItemValidationError.Wrap(err)
@@ -364,6 +426,9 @@ func (h *offerItemHandler) ListAssigneeOfferItemPair(ctx context.Context, req *o
 	}, nil
 }
 
+// TODO: protofiles にアンケート回答をエクスポートする RPC が追加されたら h.assigneeUsecase.ExportQuestionnaireAnswers を呼び出すハンドラーを追加する
+// TODO: protofiles に審査結果のアップロード・承認の RPC が追加されたら、ExaminationUsecase をハンドラーに注入し UploadExaminationResults・ConfirmExaminationResults を呼び出すハンドラーを追加する
+
 // GetQuestionnaire implements offer_item_v2.OfferItemHandlerServer.
 func (h *offerItemHandler) GetQuestionnaire(ctx context.Context, req *offer_item.GetQuestionnaireRequest) (*offer_item.GetQuestionnaireResponse, error) {
 	if err := req.Validate(); err != nil {
@@ -388,14 +453,15 @@ func (h *offerItemHandler) ListAssignee(ctx context.Context, req *offer_item.Lis
 	offerItemID := model.OfferItemID(req.GetOfferItemId())
 	stage := model.Stage(req.GetStage())
 
-	assignees, err := h.assigneeUsecase.ListAssignee(ctx, offerItemID, stage)
+	// TODO: protofiles にリスト条件とレスポンスの次ページのカーソルが追加されたら、カーソルによるページ送りの条件を指定する
+	result, err := h.assigneeUsecase.ListAssignee(ctx, offerItemID, stage, nil)
 	if err != nil {
 		return nil, fmt.Errorf("h.offerItemUsecase.ListAssignee: %w", err)
 	}
 
 	// protoに変換する
-	assigneePBs := make([]*offer_item.Assignee, 0, len(assignees))
-	for _, assignee := range assignees {
+	assigneePBs := make([]*offer_item.Assignee, 0, len(result.Assignees()))
+	for _, assignee := range result.Assignees() {
 		assigneePBs = append(assigneePBs, converter.AssigneeModelToPB(assignee))
 	}
 
@@ -406,14 +472,15 @@ func (h *offerItemHandler) ListAssignee(ctx context.Context, req *offer_item.Lis
 }
 
 func (h *offerItemHandler) ListAssigneeUnderExamination(ctx context.Context, req *offer_item.ListAssigneeUnderExaminationRequest) (*offer_item.ListAssigneeUnderExaminationResponse, error) {
-	assignees, err := h.assigneeUsecase.ListAssigneeUnderExamination(ctx)
+	// TODO: protofiles にリスト条件とレスポンスの次ページのカーソルが追加されたら、カーソルによるページ送りの条件を指定する
+	result, err := h.assigneeUsecase.ListAssigneeUnderExamination(ctx, nil)
 	if err != nil {
 		return nil, fmt.Errorf("h.offerItemUsecase.ListAssigneeUnderExamination: %w", err)
 	}
 
 	// protoに変換する
-	assigneePBs := make([]*offer_item.Assignee, 0, len(assignees))
-	for _, assignee := range assignees {
+	assigneePBs := make([]*offer_item.Assignee, 0, len(result.Assignees()))
+	for _, assignee := range result.Assignees() {
 		assigneePBs = append(assigneePBs, converter.AssigneeModelToPB(assignee))
 	}
 
@@ -19,7 +19,7 @@ import (
 //	wire.Build(
 //		app.NewApp,
 //		grpcConf.LoadConfig,
-//		wire.FieldsOf(new(*grpcConf.GRPCConfig), "Database", "Rakuten", "Validation"),
+//		wire.FieldsOf(new(*grpcConf.GRPCConfig), "Database", "Rakuten", "Validation", "Storage"),
 //		config.LoadDB,
 //		infrastructure.WireSet,
 //		application.WireSet,
@@ -33,7 +33,7 @@ func InitializeApp() (common.App, error) {
 	wire.Build(
 		app.NewApp,
 		grpcConf.LoadConfig,
-		wire.FieldsOf(new(*grpcConf.GRPCConfig), "Database", "Rakuten", "Validation"),
+		wire.FieldsOf(new(*grpcConf.GRPCConfig), "Database", "Rakuten", "Validation", "Storage", "AffiliateItem", "ExternalContexts"),
 		config.LoadDB,
 		infrastructure.WireSet,
 		application.WireSet,
@@ -16,6 +16,7 @@ import (
 	config2 "github.com/terui-ryota/offer-item/internal/common/config"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/adapter_impl"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/component/rakuten"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/storage"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/repository_impl"
 )
 
@@ -36,11 +37,29 @@ func InitializeApp() (common.App, error) {
 	}
 	applicationIDHelper := rakuten.NewApplicationIDHelper(rakutenConfig)
 	rakutenIchibaClient := rakuten.NewRakutenIchibaClient(rakutenConfig, client, applicationIDHelper)
-	affiliateItemAdapter := adapter_impl.NewAffiliateItemAdapterImpl(rakutenIchibaClient)
+	affiliateItemConfig := grpcConfig.AffiliateItem
+	externalContexts := grpcConfig.ExternalContexts
+	affiliateItemProviderRegistry, err := adapter_impl.NewAffiliateItemProviderRegistryFromConfig(affiliateItemConfig, externalContexts, rakutenIchibaClient)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := adapter_impl.NewAffiliateItemCache(affiliateItemConfig)
+	if err != nil {
+		return nil, err
+	}
+	affiliateItemAdapter := adapter_impl.NewAffiliateItemAdapterImpl(affiliateItemProviderRegistry, cache, affiliateItemConfig)
 	examinationRepository := repository_impl.NewExaminationRepositoryImpl()
 	validationConfig := grpcConfig.Validation
 	offerItemService := service.NewOfferItemServiceImpl(affiliateItemAdapter)
-	offerItemUsecase := usecase.NewOfferItemUsecase(db, offerItemRepository, assigneeRepository, questionnaireRepository, questionnaireQuestionAnswerRepository, affiliateItemAdapter, examinationRepository, validationConfig, offerItemService)
+	storageConfig := grpcConfig.Storage
+	objectStorage, err := storage.NewObjectStorage(storageConfig, client)
+	if err != nil {
+		return nil, err
+	}
+	writingFeeTierRepository := repository_impl.NewWritingFeeTierRepositoryImpl()
+	offerItemArchiveRepository := repository_impl.NewOfferItemArchiveRepositoryImpl(objectStorage)
+	legacyImageRepository := repository_impl.NewLegacyImageRepositoryImpl()
+	offerItemUsecase := usecase.NewOfferItemUsecase(db, offerItemRepository, assigneeRepository, questionnaireRepository, questionnaireQuestionAnswerRepository, affiliateItemAdapter, examinationRepository, validationConfig, offerItemService, objectStorage, writingFeeTierRepository, offerItemArchiveRepository, legacyImageRepository)
 	assigneeUsecase := usecase.NewAssigneeUsecase(db, grpcConfig, assigneeRepository, offerItemRepository, questionnaireRepository, questionnaireQuestionAnswerRepository)
 	offerItemHandlerServer := handler.NewOfferItemHandler(offerItemUsecase, assigneeUsecase)
 	commonApp := app.NewApp(offerItemHandlerServer, grpcConfig)
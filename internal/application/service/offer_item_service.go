@@ -31,28 +31,68 @@ func (o *offerItemServiceImpl) AddItemInfo(ctx context.Context, offerItems model
 	}
 
 	// 案件情報を取得
-	itemMap, err := o.affiliateItemAdapter.BulkGetItems(ctx, itemIdentifiers)
+	itemMap, unavailable, err := o.affiliateItemAdapter.BulkGetItems(ctx, itemIdentifiers)
 	if err != nil {
 		return fmt.Errorf("o.affiliateItemAdapter.BulkGetItems: %w", err)
 	}
+	unavailableIdentifiers := make(map[model.ItemIdentifier]struct{}, len(unavailable))
+	for _, identifier := range unavailable {
+		unavailableIdentifiers[identifier] = struct{}{}
+	}
 
 	// 取得した情報を適用する
 	for _, offerItem := range offerItems {
+		if offerItem.Item() == nil {
+			continue
+		}
 		var dfItemID model.DFItemID
 		if offerItem.DfItem() != nil {
 			dfItemID = offerItem.DfItem().ID()
 		}
 
 		// 案件情報を適用
-		if items, ok := itemMap[*model.NewItemIdentifier(offerItem.Item().ID(), dfItemID)]; ok {
-			if err := offerItem.SetItem(&items.Item); err != nil {
-				return fmt.Errorf("offerItem.SetItem: %w", err)
+		identifier := *model.NewItemIdentifier(offerItem.Item().ID(), dfItemID)
+		items, ok := itemMap[identifier]
+		if !ok {
+			// 商品の削除により取得できない場合は下書きの案件情報で補わない
+			if _, ok := unavailableIdentifiers[identifier]; !ok {
+				continue
 			}
-			if items.DFItem.Exists() {
-				offerItem.SetDFItem(&items.DFItem)
+			// 楽天APIの障害により取得できない場合は、下書きの案件情報を使用する
+			if err := applyDraftedItemInfo(offerItem, dfItemID); err != nil {
+				return fmt.Errorf("applyDraftedItemInfo: %w", err)
 			}
+			continue
+		}
+		if err := offerItem.SetItem(&items.Item); err != nil {
+			return fmt.Errorf("offerItem.SetItem: %w", err)
+		}
+		if items.DFItem.Exists() {
+			offerItem.SetDFItem(&items.DFItem)
 		}
 	}
 
 	return nil
 }
+
+func applyDraftedItemInfo(offerItem *model.OfferItem, dfItemID model.DFItemID) error {
+	info := offerItem.DraftedItemInfo()
+	if info == nil {
+		return nil
+	}
+	item, err := info.ToItem(offerItem.Item().ID(), dfItemID != "")
+	if err != nil {
+		return fmt.Errorf("info.ToItem: %w", err)
+	}
+	if err := offerItem.SetItem(item); err != nil {
+		return fmt.Errorf("offerItem.SetItem: %w", err)
+	}
+	if dfItemID != "" {
+		dfItem, err := info.ToDFItem(dfItemID)
+		if err != nil {
+			return fmt.Errorf("info.ToDFItem: %w", err)
+		}
+		offerItem.SetDFItem(dfItem)
+	}
+	return nil
+}
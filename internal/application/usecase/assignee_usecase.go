@@ -3,8 +3,10 @@ package usecase
 import (
 	"context"
 	"database/sql"
+	"encoding/csv"
 	"errors"
 	"fmt"
+	"io"
 
 	grpcCong "github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
 	"github.com/terui-ryota/offer-item/internal/application/service"
@@ -17,8 +19,9 @@ import (
 )
 
 type AssigneeUsecase interface {
-	ListAssignee(ctx context.Context, offerItemID model.OfferItemID, stage model.Stage) (model.AssigneeList, error)
-	ListAssigneeUnderExamination(ctx context.Context) (model.AssigneeList, error)
+	// condition が nil の場合は全件を取得する
+	ListAssignee(ctx context.Context, offerItemID model.OfferItemID, stage model.Stage, condition *model.ListCondition) (*model.ListAssigneeResult, error)
+	ListAssigneeUnderExamination(ctx context.Context, condition *model.ListCondition) (*model.ListAssigneeResult, error)
 	ListAssigneeCount(ctx context.Context, offerItemID model.OfferItemID) ([]model.AssigneeCount, error)
 	InviteOffer(ctx context.Context, offerItemID model.OfferItemID) error
 	UploadLotteryResults(ctx context.Context, offerItemID model.OfferItemID, mapLotteryResult map[model.AmebaID]model.LotteryResult) error
@@ -26,7 +29,10 @@ type AssigneeUsecase interface {
 	CompletedOfferItem(ctx context.Context, offerItemID model.OfferItemID) error
 	FinishedShipment(ctx context.Context, offerItemID model.OfferItemID) error
 	GetAssigneeByAmebaIDOfferItemID(ctx context.Context, amebaID model.AmebaID, offerItemID model.OfferItemID) (*model.Assignee, error)
+	ListWritingFeeChanges(ctx context.Context, offerItemID model.OfferItemID, amebaID model.AmebaID) ([]model.WritingFeeChange, error)
 	BulkGetQuestionnaireQuestionAnswers(ctx context.Context, offerItemID model.OfferItemID, amebaIDs []model.AmebaID) (map[model.AmebaID]map[model.QuestionID]model.QuestionAnswer, error)
+	GetQuestionnaireSummary(ctx context.Context, offerItemID model.OfferItemID, textAnswerCondition model.ListCondition) (*model.QuestionnaireSummary, error)
+	ExportQuestionnaireAnswers(ctx context.Context, offerItemID model.OfferItemID, w io.Writer) error
 	Invitation(ctx context.Context, offerItemID model.OfferItemID, amebaID model.AmebaID, accepted bool, questionAnswers map[model.QuestionID]string) error
 	Decline(ctx context.Context, offerItemID model.OfferItemID, amebaID model.AmebaID, declineReason string) error
 }
@@ -90,6 +96,134 @@ func (a *assigneeUsecaseImpl) BulkGetQuestionnaireQuestionAnswers(ctx context.Co
 	return res, nil
 }
 
+// GetQuestionnaireSummary implements AssigneeUsecase.
+func (a *assigneeUsecaseImpl) GetQuestionnaireSummary(ctx context.Context, offerItemID model.OfferItemID, textAnswerCondition model.ListCondition) (*model.QuestionnaireSummary, error) {
+	ctx, span := trace.StartSpan(ctx, "assigneeUsecaseImpl.GetQuestionnaireSummary")
+	defer span.End()
+
+	questionnaire, err := a.questionnaireRepository.Get(ctx, a.db, offerItemID, false)
+	if err != nil {
+		return nil, fmt.Errorf("a.questionnaireRepository.Get: %w", err)
+	}
+
+	// 回答を全件読み込まないよう、回答数は DB で集計し、自由回答は取得条件の範囲のみ取得する
+	respondentCount, err := a.questionnaireQuestionAnswerRepository.CountRespondents(ctx, a.db, offerItemID)
+	if err != nil {
+		return nil, fmt.Errorf("a.questionnaireQuestionAnswerRepository.CountRespondents: %w", err)
+	}
+	answerCounts, err := a.questionnaireQuestionAnswerRepository.CountByQuestionVersion(ctx, a.db, offerItemID)
+	if err != nil {
+		return nil, fmt.Errorf("a.questionnaireQuestionAnswerRepository.CountByQuestionVersion: %w", err)
+	}
+
+	choiceQuestionIDs := make([]model.QuestionID, 0)
+	textAnswers := make(map[model.QuestionVersionKey][]string)
+	for _, q := range questionnaire.QuestionVersions() {
+		if q.IsChoice() {
+			choiceQuestionIDs = append(choiceQuestionIDs, q.ID())
+			continue
+		}
+		key := q.VersionKey()
+		if answerCounts[key] == 0 {
+			continue
+		}
+		answers, err := a.questionnaireQuestionAnswerRepository.ListTextAnswers(ctx, a.db, offerItemID, key, textAnswerCondition.Offset(), textAnswerCondition.Limit())
+		if err != nil {
+			return nil, fmt.Errorf("a.questionnaireQuestionAnswerRepository.ListTextAnswers: %w", err)
+		}
+		textAnswers[key] = answers
+	}
+	contentCounts, err := a.questionnaireQuestionAnswerRepository.CountByAnswerContent(ctx, a.db, offerItemID, choiceQuestionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("a.questionnaireQuestionAnswerRepository.CountByAnswerContent: %w", err)
+	}
+	return model.NewQuestionnaireSummary(*questionnaire, respondentCount, answerCounts, contentCounts, textAnswers), nil
+}
+
+// アンケート回答のエクスポートで1度に取得するアサイニー数
+const exportQuestionnaireAnswersPageSize = model.MaxAssigneeListLimit
+
+// ExportQuestionnaireAnswers implements AssigneeUsecase.
+// アサイニーごとに1行、質問のバージョンごとに1列(Questionnaire.QuestionVersions の順)のCSVを書き込む
+// 列名は質問のタイトルとバージョン。回答は回答時のバージョンの列にのみ書き込み、未回答の質問は空欄とする
+// アサイニー数に比例してメモリを消費しないよう、アサイニーと回答をページ単位で取得し、ページごとに書き出す
+func (a *assigneeUsecaseImpl) ExportQuestionnaireAnswers(ctx context.Context, offerItemID model.OfferItemID, w io.Writer) error {
+	ctx, span := trace.StartSpan(ctx, "assigneeUsecaseImpl.ExportQuestionnaireAnswers")
+	defer span.End()
+
+	questionnaire, err := a.questionnaireRepository.Get(ctx, a.db, offerItemID, false)
+	if err != nil {
+		return fmt.Errorf("a.questionnaireRepository.Get: %w", err)
+	}
+
+	questions := questionnaire.QuestionVersions()
+	cw := csv.NewWriter(w)
+	header := []string{"ameba_id", "stage"}
+	for _, q := range questions {
+		header = append(header, fmt.Sprintf("%s (v%d)", q.Title(), q.Version()))
+	}
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("cw.Write: %w", err)
+	}
+
+	var cursor string
+	for {
+		condition, err := model.NewCursorListCondition(exportQuestionnaireAnswersPageSize, nil, cursor, false)
+		if err != nil {
+			return fmt.Errorf("model.NewCursorListCondition: %w", err)
+		}
+		result, err := a.assigneeRepository.ListPageByOfferItemID(ctx, a.db, offerItemID, condition)
+		if err != nil {
+			return fmt.Errorf("a.assigneeRepository.ListPageByOfferItemID: %w", err)
+		}
+		assignees := result.Assignees()
+		if len(assignees) > 0 {
+			answers, err := a.questionnaireQuestionAnswerRepository.BulkGetByOfferItemIDAndAssigneeIDs(ctx, offerItemID, assignees.IDs())
+			if err != nil {
+				return fmt.Errorf("a.questionnaireQuestionAnswerRepository.BulkGetByOfferItemIDAndAssigneeIDs: %w", err)
+			}
+			for _, assignee := range assignees {
+				record := []string{assignee.AmebaID().String(), assignee.Stage().Label()}
+				for _, q := range questions {
+					var content string
+					if answer, ok := answers[assignee.ID()][q.ID()]; ok && answer.QuestionVersion() == q.Version() {
+						content = answer.DisplayContent()
+					}
+					record = append(record, content)
+				}
+				if err := cw.Write(record); err != nil {
+					return fmt.Errorf("cw.Write: %w", err)
+				}
+			}
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return fmt.Errorf("cw.Flush: %w", err)
+		}
+
+		cursor = result.ListResult().NextCursor()
+		if cursor == "" {
+			return nil
+		}
+	}
+}
+
+// ListWritingFeeChanges アサイニーの執筆報酬の変更履歴を取得する
+func (a *assigneeUsecaseImpl) ListWritingFeeChanges(ctx context.Context, offerItemID model.OfferItemID, amebaID model.AmebaID) ([]model.WritingFeeChange, error) {
+	ctx, span := trace.StartSpan(ctx, "assigneeUsecaseImpl.ListWritingFeeChanges")
+	defer span.End()
+
+	assignee, err := a.assigneeRepository.GetByAmebaIDOfferItemID(ctx, a.db, amebaID, offerItemID)
+	if err != nil {
+		return nil, fmt.Errorf("a.assigneeRepository.GetByAmebaIDOfferItemID: %w", err)
+	}
+	changes, err := a.assigneeRepository.ListWritingFeeChanges(ctx, a.db, assignee.ID())
+	if err != nil {
+		return nil, fmt.Errorf("a.assigneeRepository.ListWritingFeeChanges: %w", err)
+	}
+	return changes, nil
+}
+
 // 支払い完了ステージに変更する
 func (a *assigneeUsecaseImpl) PaymentCompleted(ctx context.Context, offerItemID model.OfferItemID, amebaIDs []model.AmebaID) error {
 	ctx, span := trace.StartSpan(ctx, "assigneeUsecaseImpl.PaymentCompleted")
@@ -117,7 +251,7 @@ func (a *assigneeUsecaseImpl) PaymentCompleted(ctx context.Context, offerItemID
 	return nil
 }
 
-// オファー案件の全アサイニーのステージを完了に変更する
+// オファー案件の全アサイニーのステージを終了に変更し、案件を完了状態に遷移させる
 func (a *assigneeUsecaseImpl) CompletedOfferItem(ctx context.Context, offerItemID model.OfferItemID) error {
 	ctx, span := trace.StartSpan(ctx, "assigneeUsecaseImpl.CompletedOfferItem")
 	defer span.End()
@@ -139,11 +273,17 @@ func (a *assigneeUsecaseImpl) CompletedOfferItem(ctx context.Context, offerItemI
 		if err != nil {
 			return fmt.Errorf("o.offerItemRepository.Get: %w", err)
 		}
-		offerItem.SetIsClosed(true)
+		before := offerItem.Clone()
+		if err := offerItem.ChangeStatus(model.OfferItemStatusCompleted); err != nil {
+			return fmt.Errorf("offerItem.ChangeStatus: %w", err)
+		}
 
 		if err := a.offerItemRepository.Update(ctx, tx, offerItem); err != nil {
 			return fmt.Errorf("o.offerItemRepository.Update: %w", err)
 		}
+		if err := createOfferItemStatusRevision(ctx, tx, a.offerItemRepository, a.questionnaireRepository, before, offerItem); err != nil {
+			return fmt.Errorf("createOfferItemStatusRevision: %w", err)
+		}
 		return nil
 	}); err != nil {
 		return fmt.Errorf("txhelper.WithTransaction: %w", err)
@@ -394,26 +534,54 @@ func (a *assigneeUsecaseImpl) ListAssigneeCount(ctx context.Context, offerItemID
 }
 
 // 下書き審査、記事審査中のアサイニー一覧を取得する
-func (a *assigneeUsecaseImpl) ListAssigneeUnderExamination(ctx context.Context) (model.AssigneeList, error) {
+func (a *assigneeUsecaseImpl) ListAssigneeUnderExamination(ctx context.Context, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
 	ctx, span := trace.StartSpan(ctx, "assigneeUsecaseImpl.ListAssigneeUnderExamination")
 	defer span.End()
 
-	result, err := a.assigneeRepository.ListUnderExamination(ctx, a.db)
+	if condition == nil {
+		assignees, err := a.assigneeRepository.ListUnderExamination(ctx, a.db)
+		if err != nil {
+			return nil, fmt.Errorf("a.assigneeRepository.ListUnderExamination: %w", err)
+		}
+		return model.NewListAssigneeResult(assignees, len(assignees))
+	}
+
+	if err := condition.ValidateAssigneeSorts(); err != nil {
+		return nil, fmt.Errorf("condition.ValidateAssigneeSorts: %w", err)
+	}
+	if condition.CursorMode() {
+		condition.ClampLimit(model.DefaultAssigneeListLimit, model.MaxAssigneeListLimit)
+	}
+	result, err := a.assigneeRepository.ListPageUnderExamination(ctx, a.db, condition)
 	if err != nil {
-		return nil, fmt.Errorf("o.assigneeRepository.List: %w", err)
+		return nil, fmt.Errorf("a.assigneeRepository.ListPageUnderExamination: %w", err)
 	}
 	return result, nil
 }
 
 // オファー案件IDとステージに紐づくアサイニー一覧を取得する
-func (a *assigneeUsecaseImpl) ListAssignee(ctx context.Context, offerItemID model.OfferItemID, stage model.Stage) (model.AssigneeList, error) {
+func (a *assigneeUsecaseImpl) ListAssignee(ctx context.Context, offerItemID model.OfferItemID, stage model.Stage, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
 	ctx, span := trace.StartSpan(ctx, "assigneeUsecaseImpl.ListAssignee")
 	defer span.End()
 
-	result, err := a.assigneeRepository.ListByOfferItemIDStage(ctx, a.db, offerItemID, stage)
-	if err != nil {
-		return nil, fmt.Errorf("o.assigneeRepository.List: %w", err)
+	if condition == nil {
+		assignees, err := a.assigneeRepository.ListByOfferItemIDStage(ctx, a.db, offerItemID, stage)
+		if err != nil {
+			return nil, fmt.Errorf("a.assigneeRepository.ListByOfferItemIDStage: %w", err)
+		}
+		return model.NewListAssigneeResult(assignees, len(assignees))
 	}
 
+	if err := condition.ValidateAssigneeSorts(); err != nil {
+		return nil, fmt.Errorf("condition.ValidateAssigneeSorts: %w", err)
+	}
+	// カーソルによるページ送りでは、大規模な案件でも 1 回の呼び出しで取得する件数を抑えるため、取得上限数を設ける
+	if condition.CursorMode() {
+		condition.ClampLimit(model.DefaultAssigneeListLimit, model.MaxAssigneeListLimit)
+	}
+	result, err := a.assigneeRepository.ListPageByOfferItemIDStage(ctx, a.db, offerItemID, stage, condition)
+	if err != nil {
+		return nil, fmt.Errorf("a.assigneeRepository.ListPageByOfferItemIDStage: %w", err)
+	}
 	return result, nil
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 
 	"github.com/terui-ryota/offer-item/internal/common/txhelper"
+	"github.com/terui-ryota/offer-item/internal/domain/adapter"
 	"github.com/terui-ryota/offer-item/internal/domain/dto"
 	"github.com/terui-ryota/offer-item/internal/domain/model"
 	"github.com/terui-ryota/offer-item/internal/domain/repository"
@@ -16,8 +18,10 @@ import (
 type ExaminationUsecase interface {
 	BulkGetExaminations(ctx context.Context, offerItemID model.OfferItemID, entryType model.EntryType) (map[model.AmebaID]*model.Examination, error)
 	UploadExaminationResults(ctx context.Context, offerItemID model.OfferItemID, entryType model.EntryType, examinationResultMap map[string]*dto.ExaminationResultDTO) error
+	ConfirmExaminationResults(ctx context.Context, offerItemID model.OfferItemID, entryType model.EntryType, confirmationMap map[string]*dto.ExaminationConfirmationDTO) error
 	GetExaminationByAssigneeIDOfferItemID(ctx context.Context, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType) (*model.Examination, error)
 	Submission(ctx context.Context, offerItemID model.OfferItemID, amebaID model.AmebaID, entryType model.EntryType, entryID *model.EntryID) error
+	UploadSNSScreenshot(ctx context.Context, offerItemID model.OfferItemID, amebaID model.AmebaID, contentType string, data []byte) (model.ObjectKey, error)
 }
 
 func NewExaminationUsecase(
@@ -25,12 +29,14 @@ func NewExaminationUsecase(
 	examinationRepository repository.ExaminationRepository,
 	assigneeRepository repository.AssigneeRepository,
 	offerItemRepository repository.OfferItemRepository,
+	objectStorage adapter.ObjectStorage,
 ) ExaminationUsecase {
 	return &ExaminationUsecaseImpl{
 		db:                    db,
 		examinationRepository: examinationRepository,
 		assigneeRepository:    assigneeRepository,
 		offerItemRepository:   offerItemRepository,
+		objectStorage:         objectStorage,
 	}
 }
 
@@ -39,6 +45,7 @@ type ExaminationUsecaseImpl struct {
 	examinationRepository repository.ExaminationRepository
 	assigneeRepository    repository.AssigneeRepository
 	offerItemRepository   repository.OfferItemRepository
+	objectStorage         adapter.ObjectStorage
 }
 
 // AmebaIDをkeyにしたmapを取得する
@@ -75,7 +82,7 @@ func (e *ExaminationUsecaseImpl) UploadExaminationResults(ctx context.Context, o
 		// 下書き審査の場合(下書き再審査も含む)
 		case model.EntryTypeDraft:
 			// ステージが「下書き審査」のアサイニーを取得
-			assigneeList, err := e.assigneeRepository.ListByOfferItemIDStage(ctx, e.db, offerItemID, model.StagePreExamination)
+			assigneeList, err := e.assigneeRepository.ListByOfferItemIDStage(ctx, tx, offerItemID, model.StagePreExamination)
 			if err != nil {
 				return fmt.Errorf("o.assigneeRepository.ListByOfferItemID: %w", err)
 			}
@@ -87,20 +94,28 @@ func (e *ExaminationUsecaseImpl) UploadExaminationResults(ctx context.Context, o
 					continue
 				}
 
+				// 二重承認が必要な場合は審査結果を承認待ちにし、ステージは変更しない
+				if offerItem.RequiresSecondApproval() {
+					if err := e.setPendingExaminationResult(ctx, tx, offerItemID, assignee.ID(), model.EntryTypeDraft, examinationResult); err != nil {
+						return fmt.Errorf("e.setPendingExaminationResult: %w", err)
+					}
+					continue
+				}
+
 				// 下書き審査を通過した場合はステージを「記事投稿」に、通過していない場合「下書き再審査」に変更する
 				if err := assignee.PreExamination(examinationResult.IsPassed); err != nil {
 					return fmt.Errorf("assignee.SetStagePreReexamination: %w", err)
 				}
 
 				// 審査結果を設定する
-				preExamination, err := e.examinationRepository.Get(ctx, e.db, offerItemID, assignee.ID(), model.EntryTypeDraft)
+				preExamination, err := e.examinationRepository.Get(ctx, tx, offerItemID, assignee.ID(), model.EntryTypeDraft, true)
 				if err != nil {
 					return fmt.Errorf("u.examinationRepository.GetExamination: %w", err)
 				}
 				if err = preExamination.SetExaminationResult(examinationResult.IsPassed, examinationResult.ExaminerName, examinationResult.Reason); err != nil {
 					return fmt.Errorf("preExamination.SetExaminationResult: %w", err)
 				}
-				if err = e.examinationRepository.Update(ctx, e.db, preExamination); err != nil {
+				if err = e.examinationRepository.Update(ctx, tx, preExamination); err != nil {
 					return fmt.Errorf("u.examinationRepository.Update: %w", err)
 				}
 
@@ -111,13 +126,13 @@ func (e *ExaminationUsecaseImpl) UploadExaminationResults(ctx context.Context, o
 				}
 
 				// アサイニーのステージを更新
-				if err = e.assigneeRepository.Update(ctx, e.db, assignee); err != nil {
+				if err = e.assigneeRepository.Update(ctx, tx, assignee); err != nil {
 					return fmt.Errorf("o.assigneeRepository.Update: %w", err)
 				}
 			}
 		case model.EntryTypeEntry:
 			// ステージが「記事投稿」のアサイニーを取得
-			assigneeList, err := e.assigneeRepository.ListByOfferItemIDStage(ctx, e.db, offerItemID, model.StageExamination)
+			assigneeList, err := e.assigneeRepository.ListByOfferItemIDStage(ctx, tx, offerItemID, model.StageExamination)
 			if err != nil {
 				return fmt.Errorf("o.assigneeRepository.ListByOfferItemID: %w", err)
 			}
@@ -129,20 +144,28 @@ func (e *ExaminationUsecaseImpl) UploadExaminationResults(ctx context.Context, o
 					continue
 				}
 
+				// 二重承認が必要な場合は審査結果を承認待ちにし、ステージは変更しない
+				if offerItem.RequiresSecondApproval() {
+					if err := e.setPendingExaminationResult(ctx, tx, offerItemID, assignee.ID(), model.EntryTypeEntry, examinationResult); err != nil {
+						return fmt.Errorf("e.setPendingExaminationResult: %w", err)
+					}
+					continue
+				}
+
 				// 記事審査を通過した場合はステージを「支払い中」に、通過していない場合「記事再投稿」に変更する
 				if err = assignee.Examination(examinationResult.IsPassed); err != nil {
 					return fmt.Errorf("assignee.SetStagePreReexamination: %w", err)
 				}
 
 				// 審査結果を設定する
-				examination, err := e.examinationRepository.Get(ctx, e.db, offerItemID, assignee.ID(), model.EntryTypeEntry)
+				examination, err := e.examinationRepository.Get(ctx, tx, offerItemID, assignee.ID(), model.EntryTypeEntry, true)
 				if err != nil {
 					return fmt.Errorf("u.examinationRepository.GetExamination: %w", err)
 				}
 				if err = examination.SetExaminationResult(examinationResult.IsPassed, examinationResult.ExaminerName, examinationResult.Reason); err != nil {
 					return fmt.Errorf("preExamination.SetExaminationResult: %w", err)
 				}
-				if err = e.examinationRepository.Update(ctx, e.db, examination); err != nil {
+				if err = e.examinationRepository.Update(ctx, tx, examination); err != nil {
 					return fmt.Errorf("u.examinationRepository.Update: %w", err)
 				}
 
@@ -156,7 +179,7 @@ func (e *ExaminationUsecaseImpl) UploadExaminationResults(ctx context.Context, o
 					}
 				}
 				// ステージを更新
-				if err = e.assigneeRepository.Update(ctx, e.db, assignee); err != nil {
+				if err = e.assigneeRepository.Update(ctx, tx, assignee); err != nil {
 					return fmt.Errorf("o.assigneeRepository.Update: %w", err)
 				}
 			}
@@ -187,11 +210,102 @@ func (e *ExaminationUsecaseImpl) UploadExaminationResults(ctx context.Context, o
 	return nil
 }
 
+// 審査結果を承認待ちとして保存する
+func (e *ExaminationUsecaseImpl) setPendingExaminationResult(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType, examinationResult *dto.ExaminationResultDTO) error {
+	examination, err := e.examinationRepository.Get(ctx, tx, offerItemID, assigneeID, entryType, true)
+	if err != nil {
+		return fmt.Errorf("u.examinationRepository.Get: %w", err)
+	}
+	if err = examination.SetPendingExaminationResult(examinationResult.IsPassed, examinationResult.ExaminerName, examinationResult.Reason); err != nil {
+		return fmt.Errorf("examination.SetPendingExaminationResult: %w", err)
+	}
+	if err = e.examinationRepository.Update(ctx, tx, examination); err != nil {
+		return fmt.Errorf("u.examinationRepository.Update: %w", err)
+	}
+	return nil
+}
+
+// 二重承認が必要なオファー案件で、承認待ちの審査結果を別の審査者が承認または覆し、その結果を元にステージを更新する
+func (e *ExaminationUsecaseImpl) ConfirmExaminationResults(ctx context.Context, offerItemID model.OfferItemID, entryType model.EntryType, confirmationMap map[string]*dto.ExaminationConfirmationDTO) error {
+	ctx, span := trace.StartSpan(ctx, "ExaminationUsecaseImpl.ConfirmExaminationResults")
+	defer span.End()
+
+	offerItem, err := e.offerItemRepository.Get(ctx, e.db, offerItemID, false)
+	if err != nil {
+		return fmt.Errorf("u.offerItemRepository.Get: %w", err)
+	}
+	if !offerItem.RequiresSecondApproval() {
+		return apperr.OfferItemValidationError.Wrap(fmt.Errorf("offerItemID:%s does not require second approval", offerItemID))
+	}
+
+	var stage model.Stage
+	switch entryType {
+	case model.EntryTypeDraft:
+		stage = model.StagePreExamination
+	case model.EntryTypeEntry:
+		stage = model.StageExamination
+	default:
+		return apperr.OfferItemValidationError.Wrap(fmt.Errorf("entryType:%d is invalid", entryType))
+	}
+
+	amebaIDs := make([]model.AmebaID, 0, len(confirmationMap))
+	for amebaID := range confirmationMap {
+		amebaIDs = append(amebaIDs, model.AmebaID(amebaID))
+	}
+	// 行ロックの取得順を揃えるため、amebaID の順に取得する
+	sort.Slice(amebaIDs, func(i, j int) bool { return amebaIDs[i] < amebaIDs[j] })
+
+	// 同じ審査結果を同時に承認できないよう、アサイニーと審査をロックして取得し、全ての更新を1つのトランザクションで行う
+	if err = txhelper.WithTransaction(ctx, e.db, func(tx *sql.Tx) error {
+		assigneeList, err := e.assigneeRepository.ListByOfferItemIDAmebaIDs(ctx, tx, offerItemID, amebaIDs, true)
+		if err != nil {
+			return fmt.Errorf("o.assigneeRepository.ListByOfferItemIDAmebaIDs: %w", err)
+		}
+		for _, assignee := range assigneeList {
+			if assignee.Stage() != stage {
+				// 審査中のステージではないアサイニーは次のアサイニーに進む
+				continue
+			}
+			confirmation := confirmationMap[assignee.AmebaID().String()]
+
+			examination, err := e.examinationRepository.Get(ctx, tx, offerItemID, assignee.ID(), entryType, true)
+			if err != nil {
+				return fmt.Errorf("u.examinationRepository.Get: %w", err)
+			}
+			if err = examination.ConfirmExaminationResult(confirmation.IsPassed, confirmation.ConfirmerName, confirmation.Reason); err != nil {
+				return fmt.Errorf("examination.ConfirmExaminationResult: %w", err)
+			}
+			if err = e.examinationRepository.Update(ctx, tx, examination); err != nil {
+				return fmt.Errorf("u.examinationRepository.Update: %w", err)
+			}
+
+			// 確定した審査結果を元にステージを変更する
+			if entryType == model.EntryTypeDraft {
+				if err = assignee.PreExamination(*examination.IsPassed()); err != nil {
+					return fmt.Errorf("assignee.PreExamination: %w", err)
+				}
+			} else {
+				if err = assignee.Examination(*examination.IsPassed()); err != nil {
+					return fmt.Errorf("assignee.Examination: %w", err)
+				}
+			}
+			if err = e.assigneeRepository.Update(ctx, tx, assignee); err != nil {
+				return fmt.Errorf("o.assigneeRepository.Update: %w", err)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+
+	return nil
+}
+
 func (e *ExaminationUsecaseImpl) GetExaminationByAssigneeIDOfferItemID(ctx context.Context, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType) (*model.Examination, error) {
 	ctx, span := trace.StartSpan(ctx, "ExaminationUsecaseImpl.GetExaminationByAssigneeIDOfferItemID")
 	defer span.End()
 
-	result, err := e.examinationRepository.Get(ctx, e.db, offerItemID, assigneeID, entryType)
+	result, err := e.examinationRepository.Get(ctx, e.db, offerItemID, assigneeID, entryType, false)
 	if err != nil {
 		return nil, fmt.Errorf("u.examinationRepository.Get: %w", err)
 	}
@@ -262,3 +376,24 @@ func (e *ExaminationUsecaseImpl) Submission(ctx context.Context, offerItemID mod
 
 	return nil
 }
+
+// UploadSNSScreenshot SNS投稿のスクリーンショットをオブジェクトストレージに保存し、オブジェクトキーを返す
+// 返却したキーを記事提出時に指定することで、DBには画像データではなくキーのみを保存する
+func (e *ExaminationUsecaseImpl) UploadSNSScreenshot(ctx context.Context, offerItemID model.OfferItemID, amebaID model.AmebaID, contentType string, data []byte) (model.ObjectKey, error) {
+	ctx, span := trace.StartSpan(ctx, "ExaminationUsecaseImpl.UploadSNSScreenshot")
+	defer span.End()
+
+	// アサインされていない場合はアップロードさせない
+	if _, err := e.assigneeRepository.GetByAmebaIDOfferItemID(ctx, e.db, amebaID, offerItemID); err != nil {
+		return "", fmt.Errorf("e.assigneeRepository.GetByAmebaIDOfferItemID: %w", err)
+	}
+
+	object, err := model.NewImageObject(model.ObjectKeyPrefixSNSScreenshot, contentType, data)
+	if err != nil {
+		return "", apperr.OfferItemValidationError.Wrap(fmt.Errorf("model.NewImageObject: %w", err))
+	}
+	if err := e.objectStorage.Put(ctx, *object); err != nil {
+		return "", fmt.Errorf("e.objectStorage.Put: %w", err)
+	}
+	return object.Key(), nil
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
 	"github.com/terui-ryota/offer-item/internal/app/grpcserver/presentation/converter"
@@ -16,17 +18,35 @@ import (
 	"github.com/terui-ryota/offer-item/internal/domain/repository"
 	"github.com/terui-ryota/offer-item/pkg/apperr"
 	"github.com/terui-ryota/offer-item/pkg/id"
+	"github.com/terui-ryota/offer-item/pkg/logger"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
 	"go.opencensus.io/trace"
+	"go.uber.org/zap"
 )
 
 type OfferItemUsecase interface {
 	SaveOfferItem(ctx context.Context, offerItemDTO *dto.OfferItemDTO) error
 	GetOfferItem(ctx context.Context, offerItemID model.OfferItemID) (*model.OfferItem, error)
-	ListOfferItem(ctx context.Context, condition *model.ListCondition) (*model.ListOfferItemResult, error)
+	ListOfferItem(ctx context.Context, condition *model.ListCondition, statuses []model.OfferItemStatus) (*model.ListOfferItemResult, error)
 	DeleteOfferItem(ctx context.Context, offerItemID model.OfferItemID) error
+	RestoreOfferItem(ctx context.Context, offerItemID model.OfferItemID) error
+	PurgeDeletedOfferItems(ctx context.Context, deletedBefore time.Time) (int, error)
+	ArchiveOfferItem(ctx context.Context, offerItemID model.OfferItemID) error
+	ArchiveCompletedOfferItems(ctx context.Context, completedBefore time.Time) (int, error)
+	MigrateLegacyImages(ctx context.Context) (int, error)
+	UnarchiveOfferItem(ctx context.Context, offerItemID model.OfferItemID) error
 	SearchOfferItem(ctx context.Context, searchCriteria *dto.SearchOfferItemCriteria, condition *model.ListCondition) (*model.ListOfferItemResult, error)
 	ListAssigneeOfferItemPair(ctx context.Context, amebaID model.AmebaID) ([]model.AssigneeOfferItemPair, error)
+	GetBloggerDashboard(ctx context.Context, amebaID model.AmebaID) (*model.BloggerDashboard, error)
 	GetQuestionnaire(ctx context.Context, offerItemID model.OfferItemID) (*model.Questionnaire, error)
+	PreviewCommission(ctx context.Context, offerItemID model.OfferItemID, price model.Price) (*model.CommissionPreview, error)
+	ChangeOfferItemStatus(ctx context.Context, offerItemID model.OfferItemID, status model.OfferItemStatus) error
+	PublishOfferItem(ctx context.Context, offerItemID model.OfferItemID) error
+	ValidateOfferItem(ctx context.Context, offerItemID model.OfferItemID) (model.ValidationErrors, error)
+	RefreshOfferItemStatuses(ctx context.Context) error
+	ListOfferItemRevisions(ctx context.Context, offerItemID model.OfferItemID) ([]*model.OfferItemRevision, error)
+	GetOfferItemAsOf(ctx context.Context, offerItemID model.OfferItemID, at time.Time) (*model.OfferItemRevision, error)
+	InvalidateAffiliateItemCache(ctx context.Context, itemID model.ItemID, dfItemID model.DFItemID) error
 }
 
 func NewOfferItemUsecase(
@@ -40,6 +60,10 @@ func NewOfferItemUsecase(
 	examinationRepository repository.ExaminationRepository,
 	validationConfig *config.ValidationConfig,
 	offerItemService service.OfferItemService,
+	objectStorage adapter.ObjectStorage,
+	writingFeeTierRepository repository.WritingFeeTierRepository,
+	offerItemArchiveRepository repository.OfferItemArchiveRepository,
+	legacyImageRepository repository.LegacyImageRepository,
 ) OfferItemUsecase {
 	return &offerItemUsecaseImpl{
 		db:                                    db,
@@ -49,9 +73,13 @@ func NewOfferItemUsecase(
 		questionnaireQuestionAnswerRepository: questionnaireQuestionAnswerRepository,
 		affiliateItemAdapter:                  affiliateItemAdapter,
 		//affiliatorAdapter:                     affiliatorAdapter,
-		examinationRepository: examinationRepository,
-		validationConfig:      validationConfig,
-		offerItemService:      offerItemService,
+		examinationRepository:      examinationRepository,
+		validationConfig:           validationConfig,
+		offerItemService:           offerItemService,
+		objectStorage:              objectStorage,
+		writingFeeTierRepository:   writingFeeTierRepository,
+		offerItemArchiveRepository: offerItemArchiveRepository,
+		legacyImageRepository:      legacyImageRepository,
 	}
 }
 
@@ -63,9 +91,13 @@ type offerItemUsecaseImpl struct {
 	questionnaireQuestionAnswerRepository repository.QuestionnaireQuestionAnswerRepository
 	affiliateItemAdapter                  adapter.AffiliateItemAdapter
 	//affiliatorAdapter                     adapter.AffiliatorAdapter
-	examinationRepository repository.ExaminationRepository
-	validationConfig      *config.ValidationConfig
-	offerItemService      service.OfferItemService
+	examinationRepository      repository.ExaminationRepository
+	validationConfig           *config.ValidationConfig
+	offerItemService           service.OfferItemService
+	objectStorage              adapter.ObjectStorage
+	writingFeeTierRepository   repository.WritingFeeTierRepository
+	offerItemArchiveRepository repository.OfferItemArchiveRepository
+	legacyImageRepository      repository.LegacyImageRepository
 }
 
 // GetQuestionnaire implements OfferItemUsecase.
@@ -77,6 +109,12 @@ func (o *offerItemUsecaseImpl) GetQuestionnaire(ctx context.Context, offerItemID
 	if err != nil {
 		return nil, fmt.Errorf("o.questionnaireRepository.Get: %w", err)
 	}
+	// DBにはオブジェクトキーを保存しているため、クライアントが取得できるURLに置き換える
+	if err := q.ResolveImageURLs(func(key model.ObjectKey) (string, error) {
+		return o.objectStorage.URL(ctx, key)
+	}); err != nil {
+		return nil, fmt.Errorf("q.ResolveImageURLs: %w", err)
+	}
 	return q, nil
 }
 
@@ -100,11 +138,9 @@ func (o *offerItemUsecaseImpl) ListAssigneeOfferItemPair(ctx context.Context, am
 		return nil, fmt.Errorf("o.offerItemRepository.BulkGetByAssigneeID: %w", err)
 	}
 
-	for _, offerItem := range offerItemMap {
-		// アイテム情報を付与する
-		if err = o.offerItemService.AddItemInfo(ctx, model.OfferItemList{offerItem}); err != nil {
-			return nil, fmt.Errorf("o.offerItemService.AddItemInfo: %w", err)
-		}
+	// アイテム情報をまとめて付与する
+	if err = o.offerItemService.AddItemInfo(ctx, offerItemMapToList(offerItemMap)); err != nil {
+		return nil, fmt.Errorf("o.offerItemService.AddItemInfo: %w", err)
 	}
 
 	assigneeOfferItemPairs := make([]model.AssigneeOfferItemPair, 0, len(assignees))
@@ -117,15 +153,80 @@ func (o *offerItemUsecaseImpl) ListAssigneeOfferItemPair(ctx context.Context, am
 	return assigneeOfferItemPairs, nil
 }
 
+// ブロガーのアサインを対応の要否で分類したダッシュボードを取得する
+// アサイニー・オファー案件・審査はそれぞれまとめて取得し、アサイン数に関わらずクエリ数が一定になるようにする
+func (o *offerItemUsecaseImpl) GetBloggerDashboard(ctx context.Context, amebaID model.AmebaID) (*model.BloggerDashboard, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.GetBloggerDashboard")
+	defer span.End()
+
+	assignees, err := o.assigneeRepository.ListByAmebaID(ctx, o.db, amebaID)
+	if err != nil {
+		return nil, fmt.Errorf("o.assigneeRepository.ListByAmebaID: %w", err)
+	}
+
+	// 終了したアサインも表示するため、完了済みの案件も含めて取得する
+	offerItemMap, err := o.offerItemRepository.BulkGet(ctx, o.db, assignees.OfferItemIDs(), true)
+	if err != nil {
+		return nil, fmt.Errorf("o.offerItemRepository.BulkGet: %w", err)
+	}
+
+	latestExaminations, err := o.examinationRepository.BulkGetLatestByAssigneeIDs(ctx, o.db, assignees.IDs())
+	if err != nil {
+		return nil, fmt.Errorf("o.examinationRepository.BulkGetLatestByAssigneeIDs: %w", err)
+	}
+
+	if err = o.offerItemService.AddItemInfo(ctx, offerItemMapToList(offerItemMap)); err != nil {
+		return nil, fmt.Errorf("o.offerItemService.AddItemInfo: %w", err)
+	}
+
+	return model.NewBloggerDashboard(amebaID, assignees, offerItemMap, latestExaminations), nil
+}
+
+// 案件情報のキャッシュを削除する。価格などの変更を TTL の経過を待たずに反映する場合に使用する
+// DF案件IDを指定しない場合は、案件IDに紐づく全てのDF案件のキャッシュも削除する
+func (o *offerItemUsecaseImpl) InvalidateAffiliateItemCache(ctx context.Context, itemID model.ItemID, dfItemID model.DFItemID) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.InvalidateAffiliateItemCache")
+	defer span.End()
+
+	if itemID == "" {
+		return apperr.OfferItemValidationError.Wrap(errors.New("ItemID is required"))
+	}
+	if err := o.affiliateItemAdapter.InvalidateItems(ctx, *model.NewItemIdentifier(itemID, dfItemID)); err != nil {
+		return fmt.Errorf("o.affiliateItemAdapter.InvalidateItems: %w", err)
+	}
+	return nil
+}
+
+func offerItemMapToList(offerItemMap map[model.OfferItemID]*model.OfferItem) model.OfferItemList {
+	list := make(model.OfferItemList, 0, len(offerItemMap))
+	for _, offerItem := range offerItemMap {
+		list = append(list, offerItem)
+	}
+	return list
+}
+
 // オファー案件を検索する
 func (o *offerItemUsecaseImpl) SearchOfferItem(ctx context.Context, searchCriteria *dto.SearchOfferItemCriteria, condition *model.ListCondition) (*model.ListOfferItemResult, error) {
 	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.SearchOfferItem")
 	defer span.End()
 
+	if err := searchCriteria.Validate(); err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("searchCriteria.Validate: %w", err))
+	}
+
+	if err := condition.ValidateOfferItemSorts(); err != nil {
+		return nil, fmt.Errorf("condition.ValidateOfferItemSorts: %w", err)
+	}
+	if condition.CursorMode() {
+		condition.ClampLimit(model.DefaultOfferItemListLimit, model.MaxOfferItemListLimit)
+	}
 	result, err := o.offerItemRepository.Search(ctx, o.db, searchCriteria, condition)
 	if err != nil {
 		return nil, fmt.Errorf("o.offerItemRepository.Search: %w", err)
 	}
+	if terms := searchCriteria.QueryTerms(); len(terms) > 0 {
+		result.SetHighlights(terms)
+	}
 
 	// アイテム情報を付与する
 	if err = o.offerItemService.AddItemInfo(ctx, result.OfferItems()); err != nil {
@@ -152,6 +253,203 @@ func (o *offerItemUsecaseImpl) DeleteOfferItem(ctx context.Context, offerItemID
 	return nil
 }
 
+// 論理削除されたオファー案件を復元する
+func (o *offerItemUsecaseImpl) RestoreOfferItem(ctx context.Context, offerItemID model.OfferItemID) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.RestoreOfferItem")
+	defer span.End()
+
+	if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+		if err := o.offerItemRepository.Restore(ctx, tx, offerItemID); err != nil {
+			return fmt.Errorf("o.offerItemRepository.Restore: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+
+	return nil
+}
+
+// 指定日時より前に論理削除されたオファー案件の集約を物理削除し、削除した件数を返す
+// 保持期間を過ぎた案件を削除するため、定期実行することを想定している
+func (o *offerItemUsecaseImpl) PurgeDeletedOfferItems(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.PurgeDeletedOfferItems")
+	defer span.End()
+
+	offerItemIDs, err := o.offerItemRepository.ListIDsDeletedBefore(ctx, o.db, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("o.offerItemRepository.ListIDsDeletedBefore: %w", err)
+	}
+
+	var purged int
+	for _, offerItemID := range offerItemIDs {
+		var objectKeys []model.ObjectKey
+		if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+			var err error
+			if objectKeys, err = o.offerItemRepository.Purge(ctx, tx, offerItemID); err != nil {
+				return fmt.Errorf("o.offerItemRepository.Purge: %w", err)
+			}
+			return nil
+		}); err != nil {
+			// 一覧の取得後に復元された場合は対象外とする
+			if errors.Is(err, apperr.OfferItemNotFoundError) {
+				continue
+			}
+			return purged, fmt.Errorf("txhelper.WithTransaction: %w. OfferItemID: %s", err, offerItemID.String())
+		}
+		purged++
+
+		// 物理削除がコミットされた後に、削除したレコードが参照していた画像を削除する
+		for _, key := range objectKeys {
+			if err := o.objectStorage.Delete(ctx, key); err != nil {
+				logger.FromContext(ctx).Warn("failed to delete object of purged offer item", zap.String("offerItemID", offerItemID.String()), zap.String("key", key.String()), zap.Error(err))
+			}
+		}
+	}
+	return purged, nil
+}
+
+// 完了したオファー案件をアーカイブの状態にし、集約をアーカイブに退避する
+func (o *offerItemUsecaseImpl) ArchiveOfferItem(ctx context.Context, offerItemID model.OfferItemID) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.ArchiveOfferItem")
+	defer span.End()
+
+	if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+		return o.archiveOfferItem(ctx, tx, offerItemID)
+	}); err != nil {
+		return fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+	return nil
+}
+
+// 指定日時より前に完了したオファー案件をアーカイブに退避し、退避した件数を返す
+// 完了した案件を定期的に退避することを想定している
+func (o *offerItemUsecaseImpl) ArchiveCompletedOfferItems(ctx context.Context, completedBefore time.Time) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.ArchiveCompletedOfferItems")
+	defer span.End()
+
+	offerItemIDs, err := o.offerItemArchiveRepository.ListArchivableIDs(ctx, o.db, completedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("o.offerItemArchiveRepository.ListArchivableIDs: %w", err)
+	}
+
+	var archived int
+	for _, offerItemID := range offerItemIDs {
+		if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+			return o.archiveOfferItem(ctx, tx, offerItemID)
+		}); err != nil {
+			return archived, fmt.Errorf("txhelper.WithTransaction: %w. OfferItemID: %s", err, offerItemID.String())
+		}
+		archived++
+	}
+	return archived, nil
+}
+
+func (o *offerItemUsecaseImpl) archiveOfferItem(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) error {
+	offerItem, err := o.offerItemRepository.Get(ctx, tx, offerItemID, true)
+	if err != nil {
+		return fmt.Errorf("o.offerItemRepository.Get: %w", err)
+	}
+	before := offerItem.Clone()
+	changed, err := offerItem.MarkArchived()
+	if err != nil {
+		return fmt.Errorf("offerItem.MarkArchived: %w", err)
+	}
+	if changed {
+		if err := o.offerItemRepository.Update(ctx, tx, offerItem); err != nil {
+			return fmt.Errorf("o.offerItemRepository.Update: %w", err)
+		}
+		if err := createOfferItemStatusRevision(ctx, tx, o.offerItemRepository, o.questionnaireRepository, before, offerItem); err != nil {
+			return fmt.Errorf("createOfferItemStatusRevision: %w", err)
+		}
+	}
+	if _, err := o.offerItemArchiveRepository.Archive(ctx, tx, offerItemID); err != nil {
+		return fmt.Errorf("o.offerItemArchiveRepository.Archive: %w", err)
+	}
+	return nil
+}
+
+// 移行前の画像データを一度に取得する件数
+const legacyImageMigrationBatchSize = 100
+
+// DBに直接保存された移行前の画像データをオブジェクトストレージに保存し、オブジェクトキーに置き換える
+// デコードできない値は移行前のデータのまま残し、ログに出力する。移行した件数を返す
+func (o *offerItemUsecaseImpl) MigrateLegacyImages(ctx context.Context) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.MigrateLegacyImages")
+	defer span.End()
+
+	var migrated int
+	for _, column := range model.LegacyImageColumns {
+		afterID := ""
+		for {
+			images, err := o.legacyImageRepository.ListByColumn(ctx, o.db, column, afterID, legacyImageMigrationBatchSize)
+			if err != nil {
+				return migrated, fmt.Errorf("o.legacyImageRepository.ListByColumn(%s): %w", column, err)
+			}
+			for _, image := range images {
+				afterID = image.ID()
+				ok, err := o.migrateLegacyImage(ctx, image)
+				if err != nil {
+					return migrated, fmt.Errorf("o.migrateLegacyImage: %w. column: %s, id: %s", err, column, image.ID())
+				}
+				if ok {
+					migrated++
+				}
+			}
+			if len(images) < legacyImageMigrationBatchSize {
+				break
+			}
+		}
+	}
+	return migrated, nil
+}
+
+func (o *offerItemUsecaseImpl) migrateLegacyImage(ctx context.Context, image *model.LegacyImage) (bool, error) {
+	object, err := image.ToImageObject()
+	if err != nil {
+		logger.FromContext(ctx).Warn("skip legacy image that cannot be decoded", zap.Stringer("column", image.Column()), zap.String("id", image.ID()), zap.Error(err))
+		return false, nil
+	}
+	if err := o.objectStorage.Put(ctx, *object); err != nil {
+		return false, fmt.Errorf("o.objectStorage.Put: %w", err)
+	}
+	replaced, err := o.legacyImageRepository.ReplaceWithObjectKey(ctx, o.db, image, object.Key())
+	if err != nil {
+		return false, fmt.Errorf("o.legacyImageRepository.ReplaceWithObjectKey: %w", err)
+	}
+	if !replaced {
+		// 移行中に値が更新された場合は更新後の値を優先し、保存したオブジェクトは削除する
+		if err := o.objectStorage.Delete(ctx, object.Key()); err != nil {
+			logger.FromContext(ctx).Warn("failed to delete unused object", zap.String("key", object.Key().String()), zap.Error(err))
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+// 退避したオファー案件の集約をアーカイブから再取り込みし、更新できる状態に戻す
+func (o *offerItemUsecaseImpl) UnarchiveOfferItem(ctx context.Context, offerItemID model.OfferItemID) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.UnarchiveOfferItem")
+	defer span.End()
+
+	var archive *model.OfferItemArchive
+	if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+		var err error
+		if archive, err = o.offerItemArchiveRepository.Unarchive(ctx, tx, offerItemID); err != nil {
+			return fmt.Errorf("o.offerItemArchiveRepository.Unarchive: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+
+	// 再取り込みがコミットされた後にアーカイブを削除する。削除に失敗しても再度退避する際に上書きされる
+	if err := o.objectStorage.Delete(ctx, archive.ObjectKey()); err != nil {
+		logger.FromContext(ctx).Warn("failed to delete offer item archive", zap.String("key", archive.ObjectKey().String()), zap.Error(err))
+	}
+	return nil
+}
+
 // offer-item、schedule、assignee、questionnaireの作成・更新を行う
 func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *dto.OfferItemDTO) error {
 	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.SaveOfferItem")
@@ -163,8 +461,8 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 		dfItemID model.DFItemID
 	)
 
-	// ItemIDが存在しない場合はエラーを返す
-	if offerItemDTO.ItemID == "" {
+	// ItemIDが存在しない場合はエラーを返す(下書きは未設定でもよい)
+	if offerItemDTO.ItemID == "" && !offerItemDTO.IsDraft {
 		return apperr.OfferItemValidationError.Wrap(errors.New("ItemID is required"))
 	}
 	itemID = model.ItemID(offerItemDTO.ItemID)
@@ -177,9 +475,13 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 		return apperr.OfferItemValidationError.Wrap(errors.New("assignees input over MAX_ASSIGNEE_INPUT_NUM"))
 	}
 
-	items, err := o.affiliateItemAdapter.GetItems(ctx, *model.NewItemIdentifier(itemID, dfItemID))
-	if err != nil {
-		return fmt.Errorf("o.affiliateItemAdapter.GetItems: %w. Item ID: %s, DF Item ID: %s", err, itemID.String(), dfItemID.String())
+	var items *model.Items
+	if itemID != "" {
+		var err error
+		items, err = o.affiliateItemAdapter.GetItems(ctx, *model.NewItemIdentifier(itemID, dfItemID))
+		if err != nil {
+			return fmt.Errorf("o.affiliateItemAdapter.GetItems: %w. Item ID: %s, DF Item ID: %s", err, itemID.String(), dfItemID.String())
+		}
 	}
 
 	//amebaIDs := offerItemDTO.Assignees.GetAmebaIDs()
@@ -188,18 +490,18 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 	//	return fmt.Errorf("o.affiliatorAdapter.BulkGetAffiliatorIDsByAmebaIDs: %w", err)
 	//}
 
+	var (
+		offerItemID model.OfferItemID
+		// トランザクション内で保存した質問画像。ロールバックした場合に削除する
+		uploadedObjectKeys []model.ObjectKey
+		// 更新前に参照していた画像。コミット後に参照されなくなった画像を削除する
+		beforeObjectKeys []model.ObjectKey
+	)
 	if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
-		var offerItemID model.OfferItemID
 		AssigneesDTOs := offerItemDTO.Assignees
 		// オファーアイテムIDの存在が存在する場合更新処理を行う
 		if offerItemDTO.ID != nil {
 			offerItemID = model.OfferItemID(*offerItemDTO.ID)
-			// スケジュールIDの存在を確認する
-			for _, schedule := range offerItemDTO.Schedules {
-				if schedule.ID == nil || *schedule.ID == "" {
-					return apperr.OfferItemValidationError.Wrap(errors.New("ScheduleID is required"))
-				}
-			}
 
 			// 行ロックする際、idでレコードを取得する
 			offerItem, err := o.offerItemRepository.Get(ctx, tx, offerItemID, true)
@@ -207,6 +509,40 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 				return fmt.Errorf("o.offerItemRepository.Get: %w", err)
 			}
 
+			// 他の操作で更新されていないか確認する
+			if err := o.checkOfferItemVersion(ctx, tx, offerItem, offerItemDTO); err != nil {
+				return fmt.Errorf("o.checkOfferItemVersion: %w", err)
+			}
+
+			// 変更履歴のため、更新前のオファー案件とアンケートを保持する
+			before := offerItem.Clone()
+			beforeQuestionnaire, err := getQuestionnaireIfExists(ctx, tx, o.questionnaireRepository, offerItemID)
+			if err != nil {
+				return fmt.Errorf("getQuestionnaireIfExists: %w", err)
+			}
+			if beforeObjectKeys, err = o.offerItemRepository.ListObjectKeys(ctx, tx, offerItemID); err != nil {
+				return fmt.Errorf("o.offerItemRepository.ListObjectKeys: %w", err)
+			}
+			uploadedObjectKeys, err = o.uploadQuestionnaireImages(ctx, offerItemDTO.Questionnaire, beforeQuestionnaire)
+			if err != nil {
+				return fmt.Errorf("o.uploadQuestionnaireImages: %w", err)
+			}
+
+			// 下書きは公開されるまで下書きとして保存する
+			if offerItem.IsDraft() {
+				if err := o.saveDraftOfferItem(ctx, tx, offerItem, offerItemDTO, items, true); err != nil {
+					return fmt.Errorf("o.saveDraftOfferItem: %w", err)
+				}
+				return o.recordOfferItemRevision(ctx, tx, before, beforeQuestionnaire, offerItem)
+			}
+
+			// スケジュールIDの存在を確認する
+			for _, schedule := range offerItemDTO.Schedules {
+				if schedule.ID == nil || *schedule.ID == "" {
+					return apperr.OfferItemValidationError.Wrap(errors.New("ScheduleID is required"))
+				}
+			}
+
 			if err := offerItem.SetItem(&items.Item); err != nil {
 				return fmt.Errorf("offerItem.SetItem: %w", err)
 			}
@@ -225,38 +561,27 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 				return fmt.Errorf("setOfferItemFields: %w", err)
 			}
 
+			// スケジュールの変更を状態に反映する
+			if _, err := o.refreshOfferItemStatus(ctx, tx, offerItem, &offerItemDTO.IsClosed); err != nil {
+				return fmt.Errorf("o.refreshOfferItemStatus: %w", err)
+			}
+
 			// OfferItem、Scheduleを更新
 			if err := o.offerItemRepository.Update(ctx, tx, offerItem); err != nil {
 				return fmt.Errorf("o.offerItemRepository.Update: %w", err)
 			}
 
-			if offerItemDTO.Questionnaire != nil {
-				// アンケートが設定されていれば新規作成または更新
-				q, err := o.questionnaireRepository.Get(ctx, tx, offerItem.ID(), true)
-				if err != nil {
-					if !errors.Is(err, apperr.OfferItemNotFoundError) {
-						return fmt.Errorf("o.questionnaireRepository.Get: %w", err)
-					}
-					q, err = createQuestionnaire(offerItemID, *offerItemDTO.Questionnaire)
-					if err != nil {
-						return fmt.Errorf("createQuestionnaire: %w", err)
-					}
-				} else {
-					q, err = updateQuestionnaire(*q, *offerItemDTO.Questionnaire)
-					if err != nil {
-						return fmt.Errorf("o.updateQuestionnaire: %w", err)
-					}
-				}
-				if err := o.questionnaireRepository.Save(ctx, tx, *q); err != nil {
-					return fmt.Errorf("o.questionnaireRepository.Save: %w", err)
-				}
-			} else {
-				if err := o.questionnaireQuestionAnswerRepository.DeleteByOfferItemID(ctx, tx, offerItem.ID()); err != nil {
-					return fmt.Errorf("o.questionnaireQuestionAnswerRepository.DeleteByOfferItemID: %w", err)
-				}
-				if err := o.questionnaireRepository.Delete(ctx, tx, offerItem.ID()); err != nil {
-					return fmt.Errorf("o.questionnaireRepository.Delete: %w", err)
-				}
+			if err := o.saveQuestionnaire(ctx, tx, offerItem.ID(), offerItemDTO.Questionnaire); err != nil {
+				return fmt.Errorf("o.saveQuestionnaire: %w", err)
+			}
+
+			if err := o.recordOfferItemRevision(ctx, tx, before, beforeQuestionnaire, offerItem); err != nil {
+				return fmt.Errorf("o.recordOfferItemRevision: %w", err)
+			}
+
+			writingFeeTiers, err := o.saveWritingFeeTiers(ctx, tx, offerItem.ID(), offerItemDTO.WritingFeeTiers, true)
+			if err != nil {
+				return fmt.Errorf("o.saveWritingFeeTiers: %w", err)
 			}
 
 			// AmebaIDの存在を確認する
@@ -295,7 +620,7 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 						}
 
 						// examinationが存在しない場合はエラーを返す
-						_, err := o.examinationRepository.Get(ctx, o.db, offerItemID, assignee.ID(), entryType)
+						_, err := o.examinationRepository.Get(ctx, o.db, offerItemID, assignee.ID(), entryType, false)
 						if errors.Is(err, apperr.OfferItemNotFoundError) {
 							return apperr.OfferItemNotFoundError.Wrap(errors.New("if stage is pre-examination or examination, examination must exist"))
 						}
@@ -325,7 +650,7 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 					assignee, err := model.NewAssignee(
 						offerItem.ID(),
 						model.AmebaID(assigneeDTO.AmebaID),
-						assigneeDTO.WritingFee,
+						defaultWritingFee(writingFeeTiers, assigneeDTO),
 						converter.StageDTOToModel(assigneeDTO.Stage),
 					)
 					if err != nil {
@@ -346,6 +671,19 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 					//}
 				}
 			}
+		} else if offerItemDTO.IsDraft {
+			var err error
+			if uploadedObjectKeys, err = o.uploadQuestionnaireImages(ctx, offerItemDTO.Questionnaire, nil); err != nil {
+				return fmt.Errorf("o.uploadQuestionnaireImages: %w", err)
+			}
+			offerItem, err := model.NewDraftOfferItem(model.OfferItemID(id.New()), offerItemDTO.Name)
+			if err != nil {
+				return fmt.Errorf("model.NewDraftOfferItem: %w", err)
+			}
+			if err := o.saveDraftOfferItem(ctx, tx, offerItem, offerItemDTO, items, false); err != nil {
+				return fmt.Errorf("o.saveDraftOfferItem: %w", err)
+			}
+			return o.recordOfferItemRevision(ctx, tx, nil, nil, offerItem)
 		} else {
 			var schedules model.ScheduleList
 			for _, scheduleDTO := range offerItemDTO.Schedules {
@@ -362,6 +700,11 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 
 			offerItemID = model.OfferItemID(id.New())
 
+			var err error
+			if uploadedObjectKeys, err = o.uploadQuestionnaireImages(ctx, offerItemDTO.Questionnaire, nil); err != nil {
+				return fmt.Errorf("o.uploadQuestionnaireImages: %w", err)
+			}
+
 			draftedItemInfoMinCommission, err := model.NewCommission(
 				model.CommissionType(offerItemDTO.DraftedItemInfo.MinCommission.CommissionType),
 				float32(offerItemDTO.DraftedItemInfo.MinCommission.CalculatedRate),
@@ -391,6 +734,12 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 				return fmt.Errorf("convertOfferItemDTOToItemInfo: %w", err)
 			}
 
+			// 新規作成時はアサイニーが存在しないため、スケジュールのみから状態を導出する
+			status := model.DeriveOfferItemStatus(schedules, nil, time.Now())
+			if offerItemDTO.IsClosed {
+				status = model.OfferItemStatusCompleted
+			}
+
 			offerItem, err := model.NewOfferItem(
 				offerItemID,
 				offerItemDTO.Name,
@@ -402,6 +751,7 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 				offerItemDTO.HasSample,
 				offerItemDTO.NeedsPreliminaryReview,
 				offerItemDTO.NeedsAfterReview,
+				offerItemDTO.RequiresSecondApproval != nil && *offerItemDTO.RequiresSecondApproval,
 				offerItemDTO.NeedsPRMark,
 				offerItemDTO.PostRequired,
 				converter.PostTargetDTOToModel(offerItemDTO.PostTarget),
@@ -419,7 +769,7 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 				offerItemDTO.IsArticlePostMailSent,
 				offerItemDTO.IsPassedAfterReviewMailSent,
 				offerItemDTO.IsFailedAfterReviewMailSent,
-				offerItemDTO.IsClosed,
+				status,
 				schedules,
 				draftedItemInfo,
 			)
@@ -439,6 +789,13 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 					return fmt.Errorf("o.questionnaireRepository.Save: %w", err)
 				}
 			}
+			if err := o.recordOfferItemRevision(ctx, tx, nil, nil, offerItem); err != nil {
+				return fmt.Errorf("o.recordOfferItemRevision: %w", err)
+			}
+			writingFeeTiers, err := o.saveWritingFeeTiers(ctx, tx, offerItem.ID(), offerItemDTO.WritingFeeTiers, false)
+			if err != nil {
+				return fmt.Errorf("o.saveWritingFeeTiers: %w", err)
+			}
 			// アサイニーインサート
 			for _, assigneeDTO := range AssigneesDTOs {
 				//itemID := offerItemDTO.ItemID
@@ -456,7 +813,7 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 				assignee, err := model.NewAssignee(
 					offerItem.ID(),
 					model.AmebaID(assigneeDTO.AmebaID),
-					assigneeDTO.WritingFee,
+					defaultWritingFee(writingFeeTiers, assigneeDTO),
 					converter.StageDTOToModel(assigneeDTO.Stage),
 				)
 				if err != nil {
@@ -480,12 +837,31 @@ func (o *offerItemUsecaseImpl) SaveOfferItem(ctx context.Context, offerItemDTO *
 
 		return nil
 	}); err != nil {
+		o.deleteObjects(ctx, uploadedObjectKeys)
 		return fmt.Errorf("txhelper.WithTransaction: %w", err)
 	}
 
+	// 差し替え・削除された質問画像は、更新がコミットされた後に削除する
+	if len(beforeObjectKeys) > 0 {
+		afterObjectKeys, err := o.offerItemRepository.ListObjectKeys(ctx, o.db, offerItemID)
+		if err != nil {
+			logger.FromContext(ctx).Warn("failed to list object keys of offer item", zap.String("offerItemID", offerItemID.String()), zap.Error(err))
+			return nil
+		}
+		o.deleteObjects(ctx, model.SubtractObjectKeys(beforeObjectKeys, afterObjectKeys))
+	}
 	return nil
 }
 
+// deleteObjects オブジェクトを削除する。削除に失敗しても参照されないオブジェクトが残るだけのため、ログに出力して続ける
+func (o *offerItemUsecaseImpl) deleteObjects(ctx context.Context, keys []model.ObjectKey) {
+	for _, key := range keys {
+		if err := o.objectStorage.Delete(ctx, key); err != nil {
+			logger.FromContext(ctx).Warn("failed to delete unused object", zap.String("key", key.String()), zap.Error(err))
+		}
+	}
+}
+
 func createQuestionnaire(offerItemID model.OfferItemID, input dto.Questionnaire) (*model.Questionnaire, error) {
 	qs := make([]model.Question, 0, len(input.Questions))
 	for _, q := range input.Questions {
@@ -495,12 +871,18 @@ func createQuestionnaire(offerItemID model.OfferItemID, input dto.Questionnaire)
 			q.Title,
 			q.ImageURL,
 			q.Options,
+			q.IsRequired(),
+			q.MinValue,
+			q.MaxValue,
 		)
 		if err != nil {
 			return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("model.NewQuestion: %w", err))
 		}
 		qs = append(qs, *q)
 	}
+	if err := setDisplayConditions(qs, input.Questions); err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("setDisplayConditions: %w", err))
+	}
 	questionnaire, err := model.NewQuestionnaire(
 		offerItemID,
 		input.Description,
@@ -512,7 +894,156 @@ func createQuestionnaire(offerItemID model.OfferItemID, input dto.Questionnaire)
 	return questionnaire, nil
 }
 
-func updateQuestionnaire(q model.Questionnaire, input dto.Questionnaire) (*model.Questionnaire, error) {
+// saveQuestionnaire アンケートを新規作成または更新する
+// input が nil の場合はアンケートを削除する
+func (o *offerItemUsecaseImpl) saveQuestionnaire(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, input *dto.Questionnaire) error {
+	if input == nil {
+		if err := o.deleteQuestionnaire(ctx, tx, offerItemID); err != nil {
+			return fmt.Errorf("o.deleteQuestionnaire: %w", err)
+		}
+		return nil
+	}
+
+	q, err := o.questionnaireRepository.Get(ctx, tx, offerItemID, true)
+	if err != nil {
+		if !errors.Is(err, apperr.OfferItemNotFoundError) {
+			return fmt.Errorf("o.questionnaireRepository.Get: %w", err)
+		}
+		q, err = createQuestionnaire(offerItemID, *input)
+		if err != nil {
+			return fmt.Errorf("createQuestionnaire: %w", err)
+		}
+	} else {
+		answeredQuestionIDs, err := o.listAnsweredQuestionIDs(ctx, tx, offerItemID)
+		if err != nil {
+			return fmt.Errorf("o.listAnsweredQuestionIDs: %w", err)
+		}
+		q, err = updateQuestionnaire(*q, *input, answeredQuestionIDs)
+		if err != nil {
+			return fmt.Errorf("o.updateQuestionnaire: %w", err)
+		}
+	}
+	if err := o.questionnaireRepository.Save(ctx, tx, *q); err != nil {
+		return fmt.Errorf("o.questionnaireRepository.Save: %w", err)
+	}
+	return nil
+}
+
+// saveDraftOfferItem 下書きのオファー案件を保存する
+// 名前以外の項目は未入力でもよい。アサイニーは公開後に追加する
+func (o *offerItemUsecaseImpl) saveDraftOfferItem(ctx context.Context, tx *sql.Tx, offerItem *model.OfferItem, d *dto.OfferItemDTO, items *model.Items, exists bool) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.saveDraftOfferItem")
+	defer span.End()
+
+	if len(d.Assignees) > 0 {
+		return apperr.OfferItemValidationError.Wrap(errors.New("assignees cannot be set to draft offer item"))
+	}
+
+	if items != nil {
+		if err := offerItem.SetItem(&items.Item); err != nil {
+			return fmt.Errorf("offerItem.SetItem: %w", err)
+		}
+		offerItem.SetDFItem(&items.DFItem)
+	}
+	if err := setOfferItemFields(offerItem, d); err != nil {
+		return fmt.Errorf("setOfferItemFields: %w", err)
+	}
+
+	if exists {
+		if err := o.offerItemRepository.Update(ctx, tx, offerItem); err != nil {
+			return fmt.Errorf("o.offerItemRepository.Update: %w", err)
+		}
+	} else {
+		if err := o.offerItemRepository.Create(ctx, tx, offerItem); err != nil {
+			return fmt.Errorf("o.offerItemRepository.Create: %w", err)
+		}
+	}
+
+	// 新規作成時は削除するアンケートがないため、設定されている場合のみ保存する
+	if exists || d.Questionnaire != nil {
+		if err := o.saveQuestionnaire(ctx, tx, offerItem.ID(), d.Questionnaire); err != nil {
+			return fmt.Errorf("o.saveQuestionnaire: %w", err)
+		}
+	}
+	if _, err := o.saveWritingFeeTiers(ctx, tx, offerItem.ID(), d.WritingFeeTiers, exists); err != nil {
+		return fmt.Errorf("o.saveWritingFeeTiers: %w", err)
+	}
+	return nil
+}
+
+// recordOfferItemRevision 保存後のアンケートを取得し、オファー案件の変更履歴を作成する
+// 新規作成の場合は before に nil を指定する
+func (o *offerItemUsecaseImpl) recordOfferItemRevision(ctx context.Context, tx *sql.Tx, before *model.OfferItem, beforeQuestionnaire *model.Questionnaire, after *model.OfferItem) error {
+	afterQuestionnaire, err := getQuestionnaireIfExists(ctx, tx, o.questionnaireRepository, after.ID())
+	if err != nil {
+		return fmt.Errorf("getQuestionnaireIfExists: %w", err)
+	}
+	if err := createOfferItemRevision(ctx, tx, o.offerItemRepository, before, beforeQuestionnaire, after, afterQuestionnaire); err != nil {
+		return fmt.Errorf("createOfferItemRevision: %w", err)
+	}
+	return nil
+}
+
+// checkOfferItemVersion リクエストのバージョンが現在のオファー案件のバージョンと一致するか確認する
+// 競合した場合は、リクエストのバージョンより後の変更履歴で変更された項目をエラーに含める
+func (o *offerItemUsecaseImpl) checkOfferItemVersion(ctx context.Context, tx *sql.Tx, offerItem *model.OfferItem, d *dto.OfferItemDTO) error {
+	if d.Version == nil {
+		if o.validationConfig.RequireOfferItemVersion {
+			return apperr.OfferItemValidationError.Wrap(errors.New("Version is required"))
+		}
+		return nil
+	}
+	if *d.Version == offerItem.Version() {
+		return nil
+	}
+	revisions, err := o.offerItemRepository.ListRevisions(ctx, tx, offerItem.ID())
+	if err != nil {
+		return fmt.Errorf("o.offerItemRepository.ListRevisions: %w", err)
+	}
+	return offerItem.CheckVersion(*d.Version, revisions)
+}
+
+// deleteQuestionnaire アンケートを削除する
+// 回答が存在する場合は、回答を残すためにアンケートを論理削除する
+func (o *offerItemUsecaseImpl) deleteQuestionnaire(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) error {
+	if _, err := o.questionnaireRepository.Get(ctx, tx, offerItemID, true); err != nil {
+		if errors.Is(err, apperr.OfferItemNotFoundError) {
+			return nil
+		}
+		return fmt.Errorf("o.questionnaireRepository.Get: %w", err)
+	}
+	answeredQuestionIDs, err := o.listAnsweredQuestionIDs(ctx, tx, offerItemID)
+	if err != nil {
+		return fmt.Errorf("o.listAnsweredQuestionIDs: %w", err)
+	}
+	if len(answeredQuestionIDs) == 0 {
+		if err := o.questionnaireRepository.Delete(ctx, tx, offerItemID); err != nil {
+			return fmt.Errorf("o.questionnaireRepository.Delete: %w", err)
+		}
+		return nil
+	}
+	if err := o.questionnaireRepository.SoftDelete(ctx, tx, offerItemID); err != nil {
+		return fmt.Errorf("o.questionnaireRepository.SoftDelete: %w", err)
+	}
+	return nil
+}
+
+// listAnsweredQuestionIDs 回答が存在する質問のIDを返す
+func (o *offerItemUsecaseImpl) listAnsweredQuestionIDs(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) (map[model.QuestionID]struct{}, error) {
+	answers, err := o.questionnaireQuestionAnswerRepository.ListByOfferItemID(ctx, tx, offerItemID)
+	if err != nil {
+		return nil, fmt.Errorf("o.questionnaireQuestionAnswerRepository.ListByOfferItemID: %w", err)
+	}
+	res := make(map[model.QuestionID]struct{})
+	for _, as := range answers {
+		for questionID := range as {
+			res[questionID] = struct{}{}
+		}
+	}
+	return res, nil
+}
+
+func updateQuestionnaire(q model.Questionnaire, input dto.Questionnaire, answeredQuestionIDs map[model.QuestionID]struct{}) (*model.Questionnaire, error) {
 	if err := q.SetDescription(input.Description); err != nil {
 		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("%w", err))
 	}
@@ -545,6 +1076,9 @@ func updateQuestionnaire(q model.Questionnaire, input dto.Questionnaire) (*model
 				qi.Title,
 				qi.ImageURL,
 				qi.Options,
+				qi.IsRequired(),
+				qi.MinValue,
+				qi.MaxValue,
 			)
 			if err != nil {
 				return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("model.NewQuestion: %w", err))
@@ -564,25 +1098,84 @@ func updateQuestionnaire(q model.Questionnaire, input dto.Questionnaire) (*model
 			if err := q.SetImageURL(qi.ImageURL); err != nil {
 				return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("q.SetImageURL: %w", err))
 			}
+			if qi.Required != nil {
+				q.SetRequired(*qi.Required)
+			}
+			if err := q.SetNumberRange(qi.MinValue, qi.MaxValue); err != nil {
+				return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("q.SetNumberRange: %w", err))
+			}
 			qs = append(qs, q)
 		}
 	}
-	if err := q.SetQuestions(qs); err != nil {
-		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("q.SetQuestions: %w", err))
+	if err := setDisplayConditions(qs, input.Questions); err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("setDisplayConditions: %w", err))
+	}
+	if err := q.ReviseQuestions(qs, answeredQuestionIDs); err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("q.ReviseQuestions: %w", err))
 	}
 	return &q, nil
 }
 
+// setDisplayConditions 入力の表示条件を質問に設定する。qs と inputs は同じ順序であること
+// protofiles に表示条件の項目が無いため、表示条件が指定されていない質問は保存済みの表示条件を維持する。
+// ただし参照先の質問や選択肢が削除された場合は、保存済みの表示条件を外す
+func setDisplayConditions(qs []model.Question, inputs []dto.Question) error {
+	radioOptions := make(map[model.QuestionID][]string, len(qs))
+	for _, q := range qs {
+		if q.QuestionType() == model.QuestionTypeRadio {
+			radioOptions[q.ID()] = q.Options()
+		}
+	}
+	for i, input := range inputs {
+		if input.DisplayCondition == nil {
+			if c := qs[i].DisplayCondition(); c != nil {
+				options, ok := radioOptions[c.QuestionID()]
+				if !ok || !slices.Contains(options, c.Option()) {
+					qs[i].SetDisplayCondition(nil)
+				}
+			}
+			continue
+		}
+		var questionID model.QuestionID
+		switch {
+		case input.DisplayCondition.QuestionID != nil:
+			questionID = model.QuestionID(*input.DisplayCondition.QuestionID)
+		case input.DisplayCondition.QuestionIndex != nil:
+			idx := *input.DisplayCondition.QuestionIndex
+			if idx < 0 || idx >= len(qs) {
+				return fmt.Errorf("questionIndex is out of range: %d", idx)
+			}
+			questionID = qs[idx].ID()
+		default:
+			return errors.New("questionID or questionIndex is required")
+		}
+		c, err := model.NewDisplayCondition(questionID, input.DisplayCondition.Option)
+		if err != nil {
+			return fmt.Errorf("model.NewDisplayCondition: %w", err)
+		}
+		qs[i].SetDisplayCondition(c)
+	}
+	return nil
+}
+
+// 下書きの場合は未入力の項目や未作成のスケジュールを許容する
 func setOfferItemFields(offerItem *model.OfferItem, d *dto.OfferItemDTO) error {
 	if err := offerItem.SetName(d.Name); err != nil {
 		return fmt.Errorf("offerItem.SetName: %w", err)
 	}
-	if err := offerItem.SetSpecialCommission(d.HasSpecialCommission, d.SpecialRate, d.SpecialAmount, offerItem.Item().MinCommissionRate().CommissionType()); err != nil {
+	var commissionType model.CommissionType
+	if offerItem.Item() != nil && offerItem.Item().MinCommissionRate() != nil {
+		commissionType = offerItem.Item().MinCommissionRate().CommissionType()
+	}
+	if err := offerItem.SetSpecialCommission(d.HasSpecialCommission, d.SpecialRate, d.SpecialAmount, commissionType); err != nil {
 		return fmt.Errorf("offerItem.SetSpecialCommission: %w", err)
 	}
 	offerItem.SetHasSample(d.HasSample)
 	offerItem.SetNeedsPreliminaryReview(d.NeedsPreliminaryReview)
 	offerItem.SetNeedsAfterReview(d.NeedsAfterReview)
+	if d.RequiresSecondApproval != nil {
+		offerItem.SetRequiresSecondApproval(*d.RequiresSecondApproval)
+	}
 	offerItem.SetNeedsPRMark(d.NeedsPRMark)
 	offerItem.SetPostRequired(d.PostRequired)
 	offerItem.SetPostTarget(converter.PostTargetDTOToModel(d.PostTarget))
@@ -618,6 +1211,22 @@ func setOfferItemFields(offerItem *model.OfferItem, d *dto.OfferItemDTO) error {
 	}()
 	schedules := make([]*model.Schedule, 0, len(d.Schedules))
 	for _, scheduleDTO := range d.Schedules {
+		// 下書きではスケジュールを後から追加できる
+		if (scheduleDTO.ID == nil || *scheduleDTO.ID == "") && offerItem.IsDraft() {
+			s, err := model.NewSchedule(
+				converter.ScheduleTypeDTOToModel(scheduleDTO.ScheduleType),
+				scheduleDTO.StartDate,
+				scheduleDTO.EndDate,
+			)
+			if err != nil {
+				return fmt.Errorf("model.NewSchedule: %w", err)
+			}
+			schedules = append(schedules, s)
+			continue
+		}
+		if scheduleDTO.ID == nil || *scheduleDTO.ID == "" {
+			return apperr.OfferItemValidationError.Wrap(errors.New("ScheduleID is required"))
+		}
 		s, ok := schedulesMap[model.ScheduleID(*scheduleDTO.ID)]
 		if !ok {
 			return fmt.Errorf("notfound: %s", *scheduleDTO.ID)
@@ -633,6 +1242,11 @@ func setOfferItemFields(offerItem *model.OfferItem, d *dto.OfferItemDTO) error {
 		return fmt.Errorf("offerItem.SetSchedules: %w", err)
 	}
 
+	// 下書きで案件が未設定の場合は案件情報を保存しない
+	if offerItem.IsDraft() && (d.DraftedItemInfo == nil || offerItem.Item() == nil) {
+		return nil
+	}
+
 	draftedItemInfoMinCommission, err := model.NewCommission(
 		model.CommissionType(d.DraftedItemInfo.MinCommission.CommissionType),
 		float32(d.DraftedItemInfo.MinCommission.CalculatedRate),
@@ -670,9 +1284,59 @@ func setOfferItemFields(offerItem *model.OfferItem, d *dto.OfferItemDTO) error {
 	return nil
 }
 
+// saveWritingFeeTiers 執筆報酬のデフォルト単価を保存する
+// 指定がない場合は変更せず、既存のオファー案件であれば保存済みの単価を返す
+func (o *offerItemUsecaseImpl) saveWritingFeeTiers(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, inputs *[]dto.WritingFeeTier, exists bool) (model.WritingFeeTierList, error) {
+	if inputs == nil {
+		if !exists {
+			return model.WritingFeeTierList{}, nil
+		}
+		tiers, err := o.writingFeeTierRepository.ListByOfferItemID(ctx, tx, offerItemID)
+		if err != nil {
+			return nil, fmt.Errorf("o.writingFeeTierRepository.ListByOfferItemID: %w", err)
+		}
+		return tiers, nil
+	}
+
+	tiers := make([]model.WritingFeeTier, 0, len(*inputs))
+	for _, input := range *inputs {
+		tier, err := model.NewWritingFeeTier(input.MinFollowerCount, input.WritingFee)
+		if err != nil {
+			return nil, fmt.Errorf("model.NewWritingFeeTier: %w", err)
+		}
+		tiers = append(tiers, *tier)
+	}
+	tierList, err := model.NewWritingFeeTierList(tiers)
+	if err != nil {
+		return nil, fmt.Errorf("model.NewWritingFeeTierList: %w", err)
+	}
+	if err := o.writingFeeTierRepository.Save(ctx, tx, offerItemID, tierList); err != nil {
+		return nil, fmt.Errorf("o.writingFeeTierRepository.Save: %w", err)
+	}
+	return tierList, nil
+}
+
+// defaultWritingFee 新規アサイニーの執筆報酬を返す
+// 執筆報酬が指定されておらずフォロワー数が分かる場合は、フォロワー数帯のデフォルト単価を適用する。どちらも無い場合は 0 とする
+func defaultWritingFee(tiers model.WritingFeeTierList, d dto.Assignee) int {
+	if d.WritingFee != nil {
+		return *d.WritingFee
+	}
+	if d.FollowerCount == nil {
+		return 0
+	}
+	if fee, ok := tiers.WritingFeeFor(*d.FollowerCount); ok {
+		return fee
+	}
+	return 0
+}
+
+// setAssigneeFields 既存のアサイニーに入力を反映する。執筆報酬が指定されていない場合は変更しない
 func setAssigneeFields(assignee *model.Assignee, d *dto.Assignee) error {
-	if err := assignee.SetWritingFee(d.WritingFee); err != nil {
-		return fmt.Errorf("assignee.SetWritingFee: %w", err)
+	if d.WritingFee != nil {
+		if err := assignee.SetWritingFee(*d.WritingFee); err != nil {
+			return fmt.Errorf("assignee.SetWritingFee: %w", err)
+		}
 	}
 
 	assignee.SetStageAll(converter.StageDTOToModel(d.Stage))
@@ -720,7 +1384,15 @@ func (o *offerItemUsecaseImpl) GetOfferItem(ctx context.Context, offerItemID mod
 
 	offerItem, err := o.offerItemRepository.Get(ctx, o.db, offerItemID, false)
 	if err != nil {
-		return nil, fmt.Errorf("o.offerItemRepository.Get: %w", err)
+		if !errors.Is(err, apperr.OfferItemArchivedError) {
+			return nil, fmt.Errorf("o.offerItemRepository.Get: %w", err)
+		}
+		// 退避済みのオファー案件はアーカイブから参照のみ可能とする
+		archive, err := o.offerItemArchiveRepository.Get(ctx, o.db, offerItemID)
+		if err != nil {
+			return nil, fmt.Errorf("o.offerItemArchiveRepository.Get: %w", err)
+		}
+		offerItem = archive.OfferItem()
 	}
 
 	// アイテム情報を付与する
@@ -731,12 +1403,66 @@ func (o *offerItemUsecaseImpl) GetOfferItem(ctx context.Context, offerItemID mod
 	return offerItem, nil
 }
 
+// オファー案件の変更履歴をバージョンの昇順で取得する
+func (o *offerItemUsecaseImpl) ListOfferItemRevisions(ctx context.Context, offerItemID model.OfferItemID) ([]*model.OfferItemRevision, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.ListOfferItemRevisions")
+	defer span.End()
+
+	revisions, err := o.offerItemRepository.ListRevisions(ctx, o.db, offerItemID)
+	if err != nil {
+		return nil, fmt.Errorf("o.offerItemRepository.ListRevisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// GetOfferItemAsOf 指定日時の時点のオファー案件とアンケートを変更履歴から復元する
+// 当時の条件を確認するためのものであり、案件情報はアフィリエイトの現在の情報ではなく保存時の案件情報を使用する
+func (o *offerItemUsecaseImpl) GetOfferItemAsOf(ctx context.Context, offerItemID model.OfferItemID, at time.Time) (*model.OfferItemRevision, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.GetOfferItemAsOf")
+	defer span.End()
+
+	revision, err := o.offerItemRepository.GetRevisionAsOf(ctx, o.db, offerItemID, at)
+	if err != nil {
+		return nil, fmt.Errorf("o.offerItemRepository.GetRevisionAsOf: %w", err)
+	}
+	return revision, nil
+}
+
+// PreviewCommission 税込価格 price の商品が1件売れた場合にブロガーが得る想定報酬を計算する
+func (o *offerItemUsecaseImpl) PreviewCommission(ctx context.Context, offerItemID model.OfferItemID, price model.Price) (*model.CommissionPreview, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.PreviewCommission")
+	defer span.End()
+
+	offerItem, err := o.offerItemRepository.Get(ctx, o.db, offerItemID, false)
+	if err != nil {
+		return nil, fmt.Errorf("o.offerItemRepository.Get: %w", err)
+	}
+
+	// 報酬料率は案件情報から取得する
+	if err = o.offerItemService.AddItemInfo(ctx, model.OfferItemList{offerItem}); err != nil {
+		return nil, fmt.Errorf("o.offerItemService.AddItemInfo: %w", err)
+	}
+
+	preview, err := model.NewCommissionPreview(offerItem, price)
+	if err != nil {
+		return nil, fmt.Errorf("model.NewCommissionPreview: %w", err)
+	}
+	return preview, nil
+}
+
 // オファー案件一覧を取得する
-func (o *offerItemUsecaseImpl) ListOfferItem(ctx context.Context, condition *model.ListCondition) (*model.ListOfferItemResult, error) {
+// statuses が空の場合は全ての状態の案件を取得する
+func (o *offerItemUsecaseImpl) ListOfferItem(ctx context.Context, condition *model.ListCondition, statuses []model.OfferItemStatus) (*model.ListOfferItemResult, error) {
 	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.ListOfferItem")
 	defer span.End()
 
-	result, err := o.offerItemRepository.List(ctx, o.db, condition, true)
+	if err := condition.ValidateOfferItemSorts(); err != nil {
+		return nil, fmt.Errorf("condition.ValidateOfferItemSorts: %w", err)
+	}
+	if condition.CursorMode() {
+		condition.ClampLimit(model.DefaultOfferItemListLimit, model.MaxOfferItemListLimit)
+	}
+	result, err := o.offerItemRepository.List(ctx, o.db, condition, statuses)
 	if err != nil {
 		return nil, fmt.Errorf("o.offerItemRepository.List: %w", err)
 	}
@@ -748,3 +1474,265 @@ func (o *offerItemUsecaseImpl) ListOfferItem(ctx context.Context, condition *mod
 
 	return result, nil
 }
+
+// オファー案件の状態を明示的に変更する(完了・アーカイブ)
+func (o *offerItemUsecaseImpl) ChangeOfferItemStatus(ctx context.Context, offerItemID model.OfferItemID, status model.OfferItemStatus) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.ChangeOfferItemStatus")
+	defer span.End()
+
+	if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+		offerItem, err := o.offerItemRepository.Get(ctx, tx, offerItemID, true)
+		if err != nil {
+			return fmt.Errorf("o.offerItemRepository.Get: %w", err)
+		}
+		before := offerItem.Clone()
+		if err := offerItem.ChangeStatus(status); err != nil {
+			return fmt.Errorf("offerItem.ChangeStatus: %w", err)
+		}
+		if err := o.offerItemRepository.Update(ctx, tx, offerItem); err != nil {
+			return fmt.Errorf("o.offerItemRepository.Update: %w", err)
+		}
+		if err := createOfferItemStatusRevision(ctx, tx, o.offerItemRepository, o.questionnaireRepository, before, offerItem); err != nil {
+			return fmt.Errorf("createOfferItemStatusRevision: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+	return nil
+}
+
+// 下書きのオファー案件を公開する。公開に必要な項目が揃っていない場合は全てのエラーを返す
+func (o *offerItemUsecaseImpl) PublishOfferItem(ctx context.Context, offerItemID model.OfferItemID) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.PublishOfferItem")
+	defer span.End()
+
+	if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+		offerItem, err := o.offerItemRepository.Get(ctx, tx, offerItemID, true)
+		if err != nil {
+			return fmt.Errorf("o.offerItemRepository.Get: %w", err)
+		}
+		// 特単の検証に報酬タイプが必要なため、案件情報を適用してから公開する
+		if err := o.offerItemService.AddItemInfo(ctx, model.OfferItemList{offerItem}); err != nil {
+			return fmt.Errorf("o.offerItemService.AddItemInfo: %w", err)
+		}
+		assigneeCounts, err := o.assigneeRepository.ListCount(ctx, tx, offerItemID)
+		if err != nil {
+			return fmt.Errorf("o.assigneeRepository.ListCount: %w", err)
+		}
+		before := offerItem.Clone()
+		if err := offerItem.Publish(assigneeCounts, time.Now()); err != nil {
+			return fmt.Errorf("offerItem.Publish: %w", err)
+		}
+		if err := o.offerItemRepository.Update(ctx, tx, offerItem); err != nil {
+			return fmt.Errorf("o.offerItemRepository.Update: %w", err)
+		}
+		if err := createOfferItemStatusRevision(ctx, tx, o.offerItemRepository, o.questionnaireRepository, before, offerItem); err != nil {
+			return fmt.Errorf("createOfferItemStatusRevision: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+	return nil
+}
+
+// オファー案件が公開に必要な項目を満たしているか検証し、見つかった全てのエラーを返す
+// 公開できる場合は空のリストを返す
+func (o *offerItemUsecaseImpl) ValidateOfferItem(ctx context.Context, offerItemID model.OfferItemID) (model.ValidationErrors, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.ValidateOfferItem")
+	defer span.End()
+
+	offerItem, err := o.offerItemRepository.Get(ctx, o.db, offerItemID, false)
+	if err != nil {
+		return nil, fmt.Errorf("o.offerItemRepository.Get: %w", err)
+	}
+	if err := o.offerItemService.AddItemInfo(ctx, model.OfferItemList{offerItem}); err != nil {
+		return nil, fmt.Errorf("o.offerItemService.AddItemInfo: %w", err)
+	}
+	return offerItem.Validate(), nil
+}
+
+// スケジュールとアサイニーのステージから導出される状態の案件について、現在の状態に更新する
+// 日付の経過で状態が変わるため、定期実行することを想定している
+func (o *offerItemUsecaseImpl) RefreshOfferItemStatuses(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.RefreshOfferItemStatuses")
+	defer span.End()
+
+	offerItemIDs, err := o.offerItemRepository.ListIDsByStatuses(ctx, o.db, []model.OfferItemStatus{
+		model.OfferItemStatusScheduled,
+		model.OfferItemStatusRecruiting,
+		model.OfferItemStatusRunning,
+		model.OfferItemStatusReviewing,
+		model.OfferItemStatusPaying,
+	})
+	if err != nil {
+		return fmt.Errorf("o.offerItemRepository.ListIDsByStatuses: %w", err)
+	}
+
+	// 1件ずつトランザクションを分け、他の案件の更新を妨げないようにする
+	for _, offerItemID := range offerItemIDs {
+		if err := txhelper.WithTransaction(ctx, o.db, func(tx *sql.Tx) error {
+			offerItem, err := o.offerItemRepository.Get(ctx, tx, offerItemID, true)
+			if err != nil {
+				return fmt.Errorf("o.offerItemRepository.Get: %w", err)
+			}
+			before := offerItem.Clone()
+			changed, err := o.refreshOfferItemStatus(ctx, tx, offerItem, nil)
+			if err != nil {
+				return fmt.Errorf("o.refreshOfferItemStatus: %w", err)
+			}
+			if !changed {
+				return nil
+			}
+			if err := o.offerItemRepository.Update(ctx, tx, offerItem); err != nil {
+				return fmt.Errorf("o.offerItemRepository.Update: %w", err)
+			}
+			if err := createOfferItemStatusRevision(ctx, tx, o.offerItemRepository, o.questionnaireRepository, before, offerItem); err != nil {
+				return fmt.Errorf("createOfferItemStatusRevision: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("txhelper.WithTransaction: %w. OfferItemID: %s", err, offerItemID.String())
+		}
+	}
+	return nil
+}
+
+// refreshOfferItemStatus アサイニーのステージを取得してオファー案件の状態を更新する(永続化は呼び出し元で行う)
+// isClosed が指定された場合は終了の有無を反映し、true の場合は完了に遷移させ、false の場合は完了した案件を導出される状態に戻す
+// 状態が変わった場合は true を返す
+func (o *offerItemUsecaseImpl) refreshOfferItemStatus(ctx context.Context, tx *sql.Tx, offerItem *model.OfferItem, isClosed *bool) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.refreshOfferItemStatus")
+	defer span.End()
+
+	if isClosed != nil && *isClosed && !offerItem.IsClosed() {
+		if err := offerItem.ChangeStatus(model.OfferItemStatusCompleted); err != nil {
+			return false, fmt.Errorf("offerItem.ChangeStatus: %w", err)
+		}
+		return true, nil
+	}
+
+	assigneeCounts, err := o.assigneeRepository.ListCount(ctx, tx, offerItem.ID())
+	if err != nil {
+		return false, fmt.Errorf("o.assigneeRepository.ListCount: %w", err)
+	}
+	if isClosed != nil && !*isClosed && offerItem.Status() == model.OfferItemStatusCompleted {
+		if err := offerItem.Reopen(assigneeCounts, time.Now()); err != nil {
+			return false, fmt.Errorf("offerItem.Reopen: %w", err)
+		}
+		return true, nil
+	}
+	changed, err := offerItem.RefreshStatus(assigneeCounts, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("offerItem.RefreshStatus: %w", err)
+	}
+	return changed, nil
+}
+
+// uploadQuestionnaireImages data URL で指定された質問画像をオブジェクトストレージに保存し、画像URLをオブジェクトキーに置き換える
+// data URL 以外は保存済みのアンケートが参照している画像のみ許可し、GetQuestionnaire で返したURLはオブジェクトキーに戻す
+// エラーの場合も、それまでに保存したオブジェクトのキーを返す
+func (o *offerItemUsecaseImpl) uploadQuestionnaireImages(ctx context.Context, questionnaire *dto.Questionnaire, current *model.Questionnaire) ([]model.ObjectKey, error) {
+	ctx, span := trace.StartSpan(ctx, "offerItemUsecaseImpl.uploadQuestionnaireImages")
+	defer span.End()
+
+	if questionnaire == nil {
+		return nil, nil
+	}
+	var storedImageURLs map[string]struct{}
+	if current != nil {
+		storedImageURLs = current.ImageURLs()
+	}
+	var uploaded []model.ObjectKey
+	for i := range questionnaire.Questions {
+		q := &questionnaire.Questions[i]
+		if q.ImageURL == "" {
+			continue
+		}
+		if !model.IsDataURL(q.ImageURL) {
+			if key, ok := o.objectStorage.KeyFromURL(q.ImageURL); ok {
+				q.ImageURL = key.String()
+			}
+			if _, ok := storedImageURLs[q.ImageURL]; !ok {
+				return uploaded, apperr.OfferItemValidationError.Wrap(errors.New("question image must be a data url or an image of the questionnaire"))
+			}
+			continue
+		}
+		object, err := model.NewImageObjectFromDataURL(model.ObjectKeyPrefixQuestionnaireImage, q.ImageURL)
+		if err != nil {
+			return uploaded, apperr.OfferItemValidationError.Wrap(fmt.Errorf("model.NewImageObjectFromDataURL: %w", err))
+		}
+		if err := o.objectStorage.Put(ctx, *object); err != nil {
+			return uploaded, fmt.Errorf("o.objectStorage.Put: %w", err)
+		}
+		uploaded = append(uploaded, object.Key())
+		q.ImageURL = object.Key().String()
+	}
+	return uploaded, nil
+}
+
+// getQuestionnaireIfExists アンケートを取得する。設定されていない場合は nil を返す
+func getQuestionnaireIfExists(ctx context.Context, tx *sql.Tx, questionnaireRepository repository.QuestionnaireRepository, offerItemID model.OfferItemID) (*model.Questionnaire, error) {
+	q, err := questionnaireRepository.Get(ctx, tx, offerItemID, false)
+	if err != nil {
+		if errors.Is(err, apperr.OfferItemNotFoundError) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("questionnaireRepository.Get: %w", err)
+	}
+	return q, nil
+}
+
+// createOfferItemRevision 更新前後のオファー案件とアンケートから変更履歴を作成する。変更された項目がない場合は作成しない
+// 新規作成の場合は before に nil を指定する
+func createOfferItemRevision(
+	ctx context.Context,
+	tx *sql.Tx,
+	offerItemRepository repository.OfferItemRepository,
+	before *model.OfferItem,
+	beforeQuestionnaire *model.Questionnaire,
+	after *model.OfferItem,
+	afterQuestionnaire *model.Questionnaire,
+) error {
+	// 更新時はリポジトリで取得時のバージョンから1つ進めて保存される
+	version := after.Version()
+	if before != nil {
+		version = before.Version() + 1
+	}
+	revision := model.NewOfferItemRevision(
+		before,
+		beforeQuestionnaire,
+		after,
+		afterQuestionnaire,
+		version,
+		requestmeta.RequestedByFromContext(ctx),
+		time.Now(),
+	)
+	if before != nil && !revision.HasChanges() {
+		return nil
+	}
+	if err := offerItemRepository.CreateRevision(ctx, tx, revision); err != nil {
+		return fmt.Errorf("offerItemRepository.CreateRevision: %w", err)
+	}
+	return nil
+}
+
+// createOfferItemStatusRevision 状態のみを変更した場合の変更履歴を作成する。アンケートは変更されないため、現在のアンケートを前後に使用する
+func createOfferItemStatusRevision(
+	ctx context.Context,
+	tx *sql.Tx,
+	offerItemRepository repository.OfferItemRepository,
+	questionnaireRepository repository.QuestionnaireRepository,
+	before *model.OfferItem,
+	after *model.OfferItem,
+) error {
+	q, err := getQuestionnaireIfExists(ctx, tx, questionnaireRepository, after.ID())
+	if err != nil {
+		return fmt.Errorf("getQuestionnaireIfExists: %w", err)
+	}
+	if err := createOfferItemRevision(ctx, tx, offerItemRepository, before, q, after, q); err != nil {
+		return fmt.Errorf("createOfferItemRevision: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/common/txhelper"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/domain/repository"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"go.opencensus.io/trace"
+)
+
+type PaymentUsecase interface {
+	CreatePaymentBatch(ctx context.Context) (*model.PaymentBatch, error)
+	GetPaymentBatch(ctx context.Context, paymentBatchID model.PaymentBatchID) (*model.PaymentBatch, error)
+	ExportPaymentBatch(ctx context.Context, paymentBatchID model.PaymentBatchID, w io.Writer) error
+	ConfirmPaymentBatch(ctx context.Context, paymentBatchID model.PaymentBatchID) error
+}
+
+func NewPaymentUsecase(
+	db *sql.DB,
+	paymentBatchRepository repository.PaymentBatchRepository,
+	assigneeRepository repository.AssigneeRepository,
+	offerItemRepository repository.OfferItemRepository,
+) PaymentUsecase {
+	return &paymentUsecaseImpl{
+		db:                     db,
+		paymentBatchRepository: paymentBatchRepository,
+		assigneeRepository:     assigneeRepository,
+		offerItemRepository:    offerItemRepository,
+	}
+}
+
+type paymentUsecaseImpl struct {
+	db                     *sql.DB
+	paymentBatchRepository repository.PaymentBatchRepository
+	assigneeRepository     repository.AssigneeRepository
+	offerItemRepository    repository.OfferItemRepository
+}
+
+// CreatePaymentBatch 「支払い中」のアサイニーのうち、未確定の支払いバッチに含まれていないものから支払いバッチを作成する
+func (p *paymentUsecaseImpl) CreatePaymentBatch(ctx context.Context) (*model.PaymentBatch, error) {
+	ctx, span := trace.StartSpan(ctx, "paymentUsecaseImpl.CreatePaymentBatch")
+	defer span.End()
+
+	var paymentBatch *model.PaymentBatch
+	if err := txhelper.WithTransaction(ctx, p.db, func(tx *sql.Tx) error {
+		assignees, err := p.assigneeRepository.ListByStage(ctx, tx, model.StagePaying)
+		if err != nil {
+			return fmt.Errorf("p.assigneeRepository.ListByStage: %w", err)
+		}
+		batched, err := p.paymentBatchRepository.ListUnconfirmedAssigneeIDs(ctx, tx, assignees.IDs())
+		if err != nil {
+			return fmt.Errorf("p.paymentBatchRepository.ListUnconfirmedAssigneeIDs: %w", err)
+		}
+		targets := make(model.AssigneeList, 0, len(assignees))
+		for _, a := range assignees {
+			if _, ok := batched[a.ID()]; !ok {
+				targets = append(targets, a)
+			}
+		}
+
+		paymentBatch, err = p.newPaymentBatch(ctx, tx, targets)
+		if err != nil {
+			return fmt.Errorf("p.newPaymentBatch: %w", err)
+		}
+		if err := p.paymentBatchRepository.Create(ctx, tx, paymentBatch); err != nil {
+			return fmt.Errorf("p.paymentBatchRepository.Create: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+	return paymentBatch, nil
+}
+
+func (p *paymentUsecaseImpl) GetPaymentBatch(ctx context.Context, paymentBatchID model.PaymentBatchID) (*model.PaymentBatch, error) {
+	ctx, span := trace.StartSpan(ctx, "paymentUsecaseImpl.GetPaymentBatch")
+	defer span.End()
+
+	paymentBatch, err := p.paymentBatchRepository.Get(ctx, p.db, paymentBatchID, false)
+	if err != nil {
+		return nil, fmt.Errorf("p.paymentBatchRepository.Get: %w", err)
+	}
+	return paymentBatch, nil
+}
+
+// ExportPaymentBatch 経理システム取り込み用に支払い明細を1行1件のCSVで書き込む
+func (p *paymentUsecaseImpl) ExportPaymentBatch(ctx context.Context, paymentBatchID model.PaymentBatchID, w io.Writer) error {
+	ctx, span := trace.StartSpan(ctx, "paymentUsecaseImpl.ExportPaymentBatch")
+	defer span.End()
+
+	paymentBatch, err := p.paymentBatchRepository.Get(ctx, p.db, paymentBatchID, false)
+	if err != nil {
+		return fmt.Errorf("p.paymentBatchRepository.Get: %w", err)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"payment_batch_id", "offer_item_id", "ameba_id", "writing_fee", "special_amount", "amount"}); err != nil {
+		return fmt.Errorf("cw.Write: %w", err)
+	}
+	for _, item := range paymentBatch.Items() {
+		if err := cw.Write([]string{
+			paymentBatch.ID().String(),
+			item.OfferItemID().String(),
+			item.AmebaID().String(),
+			strconv.Itoa(item.WritingFee()),
+			strconv.Itoa(item.SpecialAmount()),
+			strconv.Itoa(item.Amount()),
+		}); err != nil {
+			return fmt.Errorf("cw.Write: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("cw.Flush: %w", err)
+	}
+	return nil
+}
+
+// ConfirmPaymentBatch 支払いバッチを確定し、含まれるアサイニーを「支払い完了」に変更する
+func (p *paymentUsecaseImpl) ConfirmPaymentBatch(ctx context.Context, paymentBatchID model.PaymentBatchID) error {
+	ctx, span := trace.StartSpan(ctx, "paymentUsecaseImpl.ConfirmPaymentBatch")
+	defer span.End()
+
+	if err := txhelper.WithTransaction(ctx, p.db, func(tx *sql.Tx) error {
+		paymentBatch, err := p.paymentBatchRepository.Get(ctx, tx, paymentBatchID, true)
+		if err != nil {
+			return fmt.Errorf("p.paymentBatchRepository.Get: %w", err)
+		}
+		if err := paymentBatch.Confirm(time.Now()); err != nil {
+			return fmt.Errorf("paymentBatch.Confirm: %w", err)
+		}
+		if err := completePayment(ctx, tx, p.assigneeRepository, paymentBatch); err != nil {
+			return fmt.Errorf("completePayment: %w", err)
+		}
+		if err := p.paymentBatchRepository.Update(ctx, tx, paymentBatch); err != nil {
+			return fmt.Errorf("p.paymentBatchRepository.Update: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("txhelper.WithTransaction: %w", err)
+	}
+	return nil
+}
+
+func (p *paymentUsecaseImpl) newPaymentBatch(ctx context.Context, tx *sql.Tx, assignees model.AssigneeList) (*model.PaymentBatch, error) {
+	if len(assignees) == 0 {
+		return nil, apperr.OfferItemValidationError.Wrap(errors.New("there are no assignees to pay"))
+	}
+	offerItems, err := p.offerItemRepository.BulkGet(ctx, tx, assignees.OfferItemIDs(), true)
+	if err != nil {
+		return nil, fmt.Errorf("p.offerItemRepository.BulkGet: %w", err)
+	}
+	paymentBatch, err := model.NewPaymentBatch(assignees, offerItems, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("model.NewPaymentBatch: %w", err)
+	}
+	return paymentBatch, nil
+}
+
+// completePayment 支払いバッチに含まれるアサイニーを支払いバッチIDとともに「支払い完了」に変更する
+func completePayment(ctx context.Context, tx *sql.Tx, assigneeRepository repository.AssigneeRepository, paymentBatch *model.PaymentBatch) error {
+	for _, assigneeID := range paymentBatch.AssigneeIDs() {
+		assignee, err := assigneeRepository.Get(ctx, tx, assigneeID)
+		if err != nil {
+			return fmt.Errorf("assigneeRepository.Get: %w", err)
+		}
+		if err := assignee.SetStagePaymentCompletedByBatch(paymentBatch.ID()); err != nil {
+			return fmt.Errorf("assignee.SetStagePaymentCompletedByBatch: %w", err)
+		}
+		if err := assigneeRepository.Update(ctx, tx, assignee); err != nil {
+			return fmt.Errorf("assigneeRepository.Update: %w", err)
+		}
+	}
+	return nil
+}
@@ -10,4 +10,5 @@ var WireSet = wire.NewSet(
 	usecase.NewOfferItemUsecase,
 	usecase.NewAssigneeUsecase,
 	usecase.NewExaminationUsecase,
+	usecase.NewPaymentUsecase,
 )
@@ -9,5 +9,8 @@ import (
 
 type AffiliateItemAdapter interface {
 	GetItems(ctx context.Context, itemIdentifier model.ItemIdentifier) (*model.Items, error)
-	BulkGetItems(ctx context.Context, itemIdentifiers model.ItemIdentifiers) (map[model.ItemIdentifier]model.Items, error)
+	// BulkGetItems 案件情報マップを取得する。取得元の障害により取得できなかった案件は unavailable で返す
+	BulkGetItems(ctx context.Context, itemIdentifiers model.ItemIdentifiers) (items map[model.ItemIdentifier]model.Items, unavailable model.ItemIdentifiers, err error)
+	// InvalidateItems キャッシュした案件情報を削除し、次回の取得時に取得元から取得させる
+	InvalidateItems(ctx context.Context, itemIdentifier model.ItemIdentifier) error
 }
@@ -36,12 +36,13 @@ func (m *MockAffiliateItemAdapter) EXPECT() *MockAffiliateItemAdapterMockRecorde
 }
 
 // BulkGetItems mocks base method.
-func (m *MockAffiliateItemAdapter) BulkGetItems(ctx context.Context, itemIdentifiers model.ItemIdentifiers) (map[model.ItemIdentifier]model.Items, error) {
+func (m *MockAffiliateItemAdapter) BulkGetItems(ctx context.Context, itemIdentifiers model.ItemIdentifiers) (map[model.ItemIdentifier]model.Items, model.ItemIdentifiers, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BulkGetItems", ctx, itemIdentifiers)
 	ret0, _ := ret[0].(map[model.ItemIdentifier]model.Items)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(model.ItemIdentifiers)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // BulkGetItems indicates an expected call of BulkGetItems.
@@ -64,3 +65,17 @@ func (mr *MockAffiliateItemAdapterMockRecorder) GetItems(ctx, itemIdentifier int
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItems", reflect.TypeOf((*MockAffiliateItemAdapter)(nil).GetItems), ctx, itemIdentifier)
 }
+
+// InvalidateItems mocks base method.
+func (m *MockAffiliateItemAdapter) InvalidateItems(ctx context.Context, itemIdentifier model.ItemIdentifier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateItems", ctx, itemIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateItems indicates an expected call of InvalidateItems.
+func (mr *MockAffiliateItemAdapterMockRecorder) InvalidateItems(ctx, itemIdentifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateItems", reflect.TypeOf((*MockAffiliateItemAdapter)(nil).InvalidateItems), ctx, itemIdentifier)
+}
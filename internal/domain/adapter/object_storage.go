@@ -0,0 +1,20 @@
+//go:generate go run github.com/golang/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock_$GOPACKAGE
+package adapter
+
+import (
+	"context"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+)
+
+// ObjectStorage 画像などのバイナリを保存するオブジェクトストレージ
+type ObjectStorage interface {
+	Put(ctx context.Context, object model.StorageObject) error
+	// オブジェクトが存在しない場合は apperr.OfferItemStorageObjectNotFoundError を返す
+	Get(ctx context.Context, key model.ObjectKey) (*model.StorageObject, error)
+	Delete(ctx context.Context, key model.ObjectKey) error
+	// URL クライアントがオブジェクトを取得するためのURL。署名付きURLの場合は有効期限がある
+	URL(ctx context.Context, key model.ObjectKey) (string, error)
+	// KeyFromURL URL で発行したURLからオブジェクトキーを取り出す。このストレージのURLでない場合は false を返す
+	KeyFromURL(rawURL string) (model.ObjectKey, bool)
+}
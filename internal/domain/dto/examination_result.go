@@ -28,6 +28,13 @@ type ExaminationResultDTO struct {
 	SNS          *SNS
 }
 
+// ExaminationConfirmationDTO 二重承認時の承認者による審査結果
+type ExaminationConfirmationDTO struct {
+	IsPassed      bool
+	ConfirmerName string
+	Reason        *string
+}
+
 type SNS struct {
 	UserID        *string
 	ScreenshotURL string
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/terui-ryota/offer-item/internal/domain/model"
 	offer_item "github.com/terui-ryota/protofiles/go/offer_item"
 )
 
@@ -29,6 +28,8 @@ type OfferItemDTO struct {
 	NeedsPreliminaryReview bool
 	// 事後審査の有無
 	NeedsAfterReview bool
+	// 審査結果の二重承認が必要か。nilの場合は変更しない
+	RequiresSecondApproval *bool
 	// PRマークやハッシュタグをつけるか(広告主により自動で設置させたくないケースがある)
 	NeedsPRMark bool
 	// 投稿必須フラグ
@@ -65,14 +66,21 @@ type OfferItemDTO struct {
 	IsFailedAfterReviewMailSent bool
 	// 案件が終了したか
 	IsClosed bool
+	// 更新の元にしたオファー案件のバージョン。更新時に現在のバージョンと異なる場合は競合エラーとする
+	Version *int
+	// 下書きとして保存するか。新規作成時のみ有効で、下書きは名前以外が未入力でも保存できる
+	// TODO: protofiles に下書きの項目が追加されたら IsDraft を設定する
+	IsDraft bool
 	// スケジュールリスト
 	Schedules ScheduleList
 	// アサイニーリスト
 	Assignees AssigneeList
 	// メール設定リスト
 	MailSettings MailSettingList
-	// アンケート
+	// アンケート。nil の場合は削除する(回答が存在する場合は論理削除して回答を残す)
 	Questionnaire *Questionnaire
+	// 執筆報酬のデフォルト単価(フォロワー数帯ごと)。nil の場合は変更しない
+	WritingFeeTiers *[]WritingFeeTier
 	// draftedItemInfoは楽天などの商品情報が消されても管理面への影響を与えないために、バックエンドのDBにキャッシュするために使用します
 	DraftedItemInfo *ItemInfo
 }
@@ -97,11 +105,21 @@ func (a AssigneeList) GetAmebaIDs() []string {
 }
 
 type Assignee struct {
-	AmebaID       string
-	Stage         Stage
-	WritingFee    int
+	AmebaID string
+	Stage   Stage
+	// 執筆報酬。nil の場合、新規アサイニーはデフォルト単価を適用し、既存のアサイニーは変更しない
+	WritingFee    *int
 	DeclineReason *string
 	IsDeleted     bool
+	// フォロワー数。新規アサイニーの WritingFee が nil の場合、執筆報酬のデフォルト単価の決定に使用する
+	FollowerCount *int
+}
+
+type WritingFeeTier struct {
+	// この単価が適用される最小フォロワー数
+	MinFollowerCount int
+	// 執筆報酬
+	WritingFee int
 }
 
 type ScheduleList []Schedule
@@ -243,7 +261,7 @@ const (
 	Stage_STAGE_DONE
 )
 
-func SaveOfferItemPBToDTO(offerItem *offer_item.SaveOfferItem) *OfferItemDTO {
+func SaveOfferItemPBToDTO(offerItem *offer_item.SaveOfferItem) (*OfferItemDTO, error) {
 	var dfItemID *string
 	if offerItem.GetDfItemId() != "" {
 		s := offerItem.GetDfItemId()
@@ -270,6 +288,17 @@ func SaveOfferItemPBToDTO(offerItem *offer_item.SaveOfferItem) *OfferItemDTO {
 	fmt.Println("offerItem.GetDraftedItemInfo().GetMinCommission(): ", offerItem.GetDraftedItemInfo().GetMinCommission())
 	fmt.Println("============SaveOfferItemPBToDTO===============")
 
+	var questionnaire *Questionnaire
+	if offerItem.GetOptionalQuestionnaire() != nil {
+		var err error
+		if questionnaire, err = ConvertQuestionnaire(offerItem.GetQuestionnaire()); err != nil {
+			return nil, fmt.Errorf("ConvertQuestionnaire: %w", err)
+		}
+	}
+
+	// TODO: protofiles に二重承認の項目が追加されたら RequiresSecondApproval を設定する
+	// TODO: protofiles に執筆報酬のデフォルト単価の項目が追加されたら WritingFeeTiers を設定する。それまではハンドラーがメタデータから設定する
+	// TODO: protofiles にバージョンの項目が追加されたら Version を設定する。それまではハンドラーがメタデータから設定する
 	return &OfferItemDTO{
 		Name:                              offerItem.GetName(),
 		ID:                                id,
@@ -301,33 +330,7 @@ func SaveOfferItemPBToDTO(offerItem *offer_item.SaveOfferItem) *OfferItemDTO {
 		IsClosed:                          offerItem.GetIsClosed(),
 		Schedules:                         SaveScheduleListPBToDTO(offerItem.GetSchedules()),
 		Assignees:                         SaveAssigneeListPBToDTO(offerItem.GetAssignees()),
-		Questionnaire: func() *Questionnaire {
-			if offerItem.GetOptionalQuestionnaire() == nil {
-				return nil
-			}
-			return &Questionnaire{
-				Description: offerItem.GetQuestionnaire().GetDescription(),
-				Questions: func() []Question {
-					res := make([]Question, 0, len(offerItem.GetQuestionnaire().GetQuestions()))
-					for _, q := range offerItem.GetQuestionnaire().GetQuestions() {
-						res = append(res, Question{
-							ID: func() *string {
-								if q.GetId() == "" {
-									return nil
-								}
-								id := q.GetId()
-								return &id
-							}(),
-							QuestionType: model.QuestionType(q.GetQuestionType()),
-							Title:        q.GetTitle(),
-							ImageURL:     q.GetImageUrl(),
-							Options:      q.GetOptions(),
-						})
-					}
-					return res
-				}(),
-			}
-		}(),
+		Questionnaire:                     questionnaire,
 		DraftedItemInfo: func() *ItemInfo {
 			if offerItem.GetDraftedItemInfo() == nil {
 				return nil
@@ -357,7 +360,7 @@ func SaveOfferItemPBToDTO(offerItem *offer_item.SaveOfferItem) *OfferItemDTO {
 				}(),
 			}
 		}(),
-	}
+	}, nil
 }
 
 func SaveScheduleListPBToDTO(scheduleListPB []*offer_item.SaveSchedule) ScheduleList {
@@ -396,10 +399,19 @@ func SaveAssigneeListPBToDTO(assigneeList []*offer_item.SaveAssignee) AssigneeLi
 			declineReason = &s
 		}
 
+		// protofiles の writing_fee は未指定を区別できないため、0 は未指定として扱う
+		// TODO: protofiles の writing_fee が optional になったら、指定の有無で判定する
+		var writingFee *int
+		if assignee.GetWritingFee() != 0 {
+			v := int(assignee.GetWritingFee())
+			writingFee = &v
+		}
+
+		// TODO: protofiles にフォロワー数の項目が追加されたら FollowerCount を設定する。それまではハンドラーがメタデータから設定する
 		assignees = append(assignees, Assignee{
 			AmebaID:       assignee.AmebaId,
 			Stage:         StagePBToDTO(assignee.GetStage()),
-			WritingFee:    int(assignee.GetWritingFee()),
+			WritingFee:    writingFee,
 			DeclineReason: declineReason,
 			IsDeleted:     assignee.GetIsDeleted(),
 		})
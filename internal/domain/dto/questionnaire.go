@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"fmt"
+
 	"github.com/terui-ryota/offer-item/internal/domain/model"
 	"github.com/terui-ryota/protofiles/go/offer_item"
 )
@@ -16,40 +18,70 @@ type Question struct {
 	Title        string
 	ImageURL     string
 	Options      []string
+	// 回答必須かどうか。nil の場合、新規作成の質問は必須とし、既存の質問は変更しない
+	Required *bool
+	// 数値回答の最小値
+	MinValue *float64
+	// 数値回答の最大値
+	MaxValue *float64
+	// 表示条件
+	DisplayCondition *DisplayCondition
+}
+
+// DisplayCondition 質問の表示条件
+// 既存の質問は QuestionID、同じリクエストで新規作成する質問は Questions 内の位置 QuestionIndex で参照する
+type DisplayCondition struct {
+	QuestionID    *string
+	QuestionIndex *int
+	Option        string
+}
+
+// IsRequired 新規作成する質問が回答必須かどうかを返す。未指定の場合は従来通り必須とする
+func (q Question) IsRequired() bool {
+	return q.Required == nil || *q.Required
 }
 
-func convertQuestionType(pb offer_item.Questionnaire_QuestionType) model.QuestionType {
+func convertQuestionType(pb offer_item.Questionnaire_QuestionType) (model.QuestionType, error) {
 	switch pb {
 	case offer_item.Questionnaire_QUESTION_TYPE_RADIO:
-		return model.QuestionTypeRadio
+		return model.QuestionTypeRadio, nil
 	case offer_item.Questionnaire_QUESTION_TYPE_TEXT:
-		return model.QuestionTypeText
-	default:
-		return model.QuestionTypeUnknown
+		return model.QuestionTypeText, nil
+	}
+	// protofiles に未定義の質問タイプ(複数選択・数値・日付)は数値のまま変換し、どの質問タイプにも該当しない値はエラーにする
+	t := model.NewQuestionType(int(pb))
+	if t == model.QuestionTypeUnknown {
+		return model.QuestionTypeUnknown, fmt.Errorf("unknown question type: %d", pb)
 	}
+	return t, nil
 }
 
-func ConvertQuestionnaire(pb *offer_item.Questionnaire) *Questionnaire {
+// ConvertQuestionnaire アンケートをDTOに変換する。未定義の質問タイプはエラーにする
+// TODO: protofiles に回答必須の項目が追加されたら Required を設定する。それまでは新規作成の質問は必須とし、既存の質問は保存済みの値を維持する
+// TODO: protofiles に表示条件の項目が追加されたら DisplayCondition を設定する。それまでは既存の質問の保存済みの表示条件を維持する
+func ConvertQuestionnaire(pb *offer_item.Questionnaire) (*Questionnaire, error) {
+	qs := make([]Question, 0, len(pb.GetQuestions()))
+	for _, q := range pb.GetQuestions() {
+		questionType, err := convertQuestionType(q.GetQuestionType())
+		if err != nil {
+			return nil, fmt.Errorf("convertQuestionType: %w", err)
+		}
+		qs = append(qs, Question{
+			ID: func() *string {
+				if q.GetId() == "" {
+					return nil
+				}
+				id := q.GetId()
+				return &id
+			}(),
+			QuestionType: questionType,
+			Title:        q.GetTitle(),
+			ImageURL:     q.GetImageUrl(),
+			Options:      q.GetOptions(),
+		})
+	}
 	return &Questionnaire{
 		Description: pb.GetDescription(),
-		Questions: func() []Question {
-			qs := make([]Question, 0, len(pb.GetQuestions()))
-			for _, q := range pb.GetQuestions() {
-				qs = append(qs, Question{
-					ID: func() *string {
-						if q.GetId() == "" {
-							return nil
-						}
-						id := q.GetId()
-						return &id
-					}(),
-					QuestionType: convertQuestionType(q.GetQuestionType()),
-					Title:        q.GetTitle(),
-					ImageURL:     q.GetImageUrl(),
-					Options:      q.GetOptions(),
-				})
-			}
-			return qs
-		}(),
-	}
+		Questions:   qs,
+	}, nil
 }
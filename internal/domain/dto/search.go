@@ -1,9 +1,120 @@
 package dto
 
-import "github.com/terui-ryota/offer-item/internal/domain/model"
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+)
+
+// SearchOfferItemCriteria オファー案件の検索条件。指定した条件は全て AND で組み合わせる
+// nil または空の条件は絞り込みに使用しない
 type SearchOfferItemCriteria struct {
+	// 名前・商品の特徴・注意事項・参考情報の全文検索のキーワード
+	// 空白区切りで指定した語を全て含む案件に絞り込み、ソート設定が無い場合は関連度の高い順に並べる
+	Query         *string
 	NameContains  *string
 	ItemIDEqual   *model.ItemID
 	DfItemIDEqual *model.DFItemID
+	// 指定した状態のいずれかに一致する。空の場合は全ての状態を対象とする
+	StatusIn        []model.OfferItemStatus
+	PostTargetEqual *model.PostTarget
+	Flags           OfferItemFlagCriteria
+	ScheduleRanges  []ScheduleRangeCriteria
+	AssigneeAmebaID *model.AmebaID
+	AssigneeStages  []AssigneeStageCountCriteria
+	CreatedAtFrom   *time.Time
+	CreatedAtTo     *time.Time
+}
+
+// 全文検索の語の最小文字数。ngram パーサーのトークンの長さ (ngram_token_size) より短い語はインデックスで検索できない
+const MinSearchQueryTermLength = 2
+
+// QueryTerms 全文検索のキーワードを空白で区切り、重複を除いた語のリストを返す
+func (c *SearchOfferItemCriteria) QueryTerms() []string {
+	if c.Query == nil {
+		return nil
+	}
+	// 全角スペースも区切りとして扱う
+	fields := strings.FieldsFunc(*c.Query, unicode.IsSpace)
+	terms := make([]string, 0, len(fields))
+	seen := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		if _, ok := seen[f]; ok {
+			continue
+		}
+		seen[f] = struct{}{}
+		terms = append(terms, f)
+	}
+	return terms
+}
+
+// OfferItemFlagCriteria オファー案件の真偽値の項目ごとの検索条件
+type OfferItemFlagCriteria struct {
+	HasSample              *bool
+	NeedsPreliminaryReview *bool
+	NeedsAfterReview       *bool
+	RequiresSecondApproval *bool
+	NeedsPRMark            *bool
+	PostRequired           *bool
+	HasLottery             *bool
+	HasCoupon              *bool
+	HasSpecialCommission   *bool
+}
+
+// ScheduleRangeCriteria 指定した種別のスケジュールの開始日・終了日の範囲。From, To はいずれも指定日時を含む
+type ScheduleRangeCriteria struct {
+	ScheduleType  model.ScheduleType
+	StartDateFrom *time.Time
+	StartDateTo   *time.Time
+	EndDateFrom   *time.Time
+	EndDateTo     *time.Time
+}
+
+// AssigneeStageCountCriteria 指定したステージのアサイニー数の範囲
+// 例えば審査中のアサイニーがいる案件は Stage: StageExamination, Min: 1 で指定する
+type AssigneeStageCountCriteria struct {
+	Stage model.Stage
+	Min   int
+	// nil の場合は上限を設けない
+	Max *int
+}
+
+// Validate 全文検索の語の長さと、範囲指定の条件の開始と終了が逆転していないか確認する
+func (c *SearchOfferItemCriteria) Validate() error {
+	for _, term := range c.QueryTerms() {
+		if utf8.RuneCountInString(term) < MinSearchQueryTermLength {
+			return fmt.Errorf("query term must be at least %d characters: %s", MinSearchQueryTermLength, term)
+		}
+	}
+	if err := validateTimeRange("createdAt", c.CreatedAtFrom, c.CreatedAtTo); err != nil {
+		return err
+	}
+	for _, r := range c.ScheduleRanges {
+		if err := validateTimeRange(fmt.Sprintf("schedule %d startDate", r.ScheduleType.Int()), r.StartDateFrom, r.StartDateTo); err != nil {
+			return err
+		}
+		if err := validateTimeRange(fmt.Sprintf("schedule %d endDate", r.ScheduleType.Int()), r.EndDateFrom, r.EndDateTo); err != nil {
+			return err
+		}
+	}
+	for _, s := range c.AssigneeStages {
+		if s.Min < 0 {
+			return fmt.Errorf("assignee stage %d min must be greater than or equal to 0: %d", s.Stage.Int(), s.Min)
+		}
+		if s.Max != nil && *s.Max < s.Min {
+			return fmt.Errorf("assignee stage %d max must be greater than or equal to min: %d, %d", s.Stage.Int(), s.Min, *s.Max)
+		}
+	}
+	return nil
+}
+
+func validateTimeRange(name string, from, to *time.Time) error {
+	if from != nil && to != nil && from.After(*to) {
+		return fmt.Errorf("%s from must be before to: %s, %s", name, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+	return nil
 }
@@ -33,6 +33,10 @@ type Assignee struct {
 	shippingData []string
 	// 発送した商品のJANコード
 	janCode *string
+	// 支払い完了時の支払いバッチID
+	paymentBatchID *PaymentBatchID
+	// 取得後に行われた執筆報酬の変更(未保存の監査ログ)
+	writingFeeChanges []WritingFeeChange
 }
 
 func NewAssignee(
@@ -64,15 +68,17 @@ func NewAssigneeFromRepository(
 	stage Stage,
 	declineReason *string,
 	createdAt time.Time,
+	paymentBatchID *PaymentBatchID,
 ) *Assignee {
 	return &Assignee{
-		id:            id,
-		offerItemID:   offerItemID,
-		amebaID:       amebaID,
-		writingFee:    writingFee,
-		stage:         stage,
-		declineReason: declineReason,
-		createdAt:     createdAt,
+		id:             id,
+		offerItemID:    offerItemID,
+		amebaID:        amebaID,
+		writingFee:     writingFee,
+		stage:          stage,
+		declineReason:  declineReason,
+		createdAt:      createdAt,
+		paymentBatchID: paymentBatchID,
 	}
 }
 
@@ -107,14 +113,38 @@ func NewLotteryResult(isPassedLottery bool, shippingData []string, janCode *stri
 	}
 }
 
+// SetWritingFee 執筆報酬を変更し、変更履歴を記録する
+// 「支払い中」以降のステージでは支払い金額が確定しているため変更できない
 func (a *Assignee) SetWritingFee(v int) error {
 	if v < 0 {
 		return apperr.OfferItemValidationError.Wrap(errors.New("writingFee must be greater than 0"))
 	}
+	if v == a.writingFee {
+		return nil
+	}
+	if a.IsWritingFeeLocked() {
+		return apperr.OfferItemValidationError.Wrap(errors.New("writingFee cannot be changed after StagePaying"))
+	}
+	a.writingFeeChanges = append(a.writingFeeChanges, WritingFeeChange{
+		previousWritingFee: a.writingFee,
+		writingFee:         v,
+		changedAt:          time.Now(),
+	})
 	a.writingFee = v
 	return nil
 }
 
+// ClearWritingFeeChanges 保存済みの執筆報酬の変更履歴を破棄する
+// 同じアサイニーを続けて保存した場合に変更履歴が重複して記録されないよう、保存後に呼び出す
+func (a *Assignee) ClearWritingFeeChanges() {
+	a.writingFeeChanges = nil
+}
+
+// IsWritingFeeLocked 執筆報酬が変更できないステージかどうか
+func (a *Assignee) IsWritingFeeLocked() bool {
+	return a.stage >= StagePaying
+}
+
 // ステージを「抽選」から「発送」に変更する
 func (a *Assignee) SetStageShipment() error {
 	if a.Stage() != StageLottery {
@@ -208,6 +238,16 @@ func (a *Assignee) SetStagePaymentCompleted() error {
 	return nil
 }
 
+// 支払いバッチの確定により「支払い完了」に変更する
+// 監査のため、支払いを行った支払いバッチIDを保持する
+func (a *Assignee) SetStagePaymentCompletedByBatch(paymentBatchID PaymentBatchID) error {
+	if err := a.SetStagePaymentCompleted(); err != nil {
+		return err
+	}
+	a.paymentBatchID = &paymentBatchID
+	return nil
+}
+
 // ステージを「発送」から「下書き提出」もしくは「記事提出」に変更する
 func (a *Assignee) FinishedShipment(needsPreliminaryReview bool) error {
 	if a.Stage() != StageShipment {
@@ -287,6 +327,62 @@ func (a *Assignee) SetStageAll(s Stage) {
 // AssigneeList アサイニーリスト
 type AssigneeList []*Assignee
 
+// IDs アサイニーIDのリストを返す
+func (al AssigneeList) IDs() []AssigneeID {
+	res := make([]AssigneeID, 0, len(al))
+	for _, a := range al {
+		res = append(res, a.id)
+	}
+	return res
+}
+
+// OfferItemIDs 重複を除いたオファー案件IDのリストを返す
+func (al AssigneeList) OfferItemIDs() []OfferItemID {
+	res := make([]OfferItemID, 0, len(al))
+	seen := make(map[OfferItemID]struct{}, len(al))
+	for _, a := range al {
+		if _, ok := seen[a.offerItemID]; ok {
+			continue
+		}
+		seen[a.offerItemID] = struct{}{}
+		res = append(res, a.offerItemID)
+	}
+	return res
+}
+
+const (
+	// アサイニー一覧の 1 ページあたりのデフォルトの取得件数
+	DefaultAssigneeListLimit = 100
+	// アサイニー一覧の 1 ページあたりの最大取得件数
+	MaxAssigneeListLimit = 1000
+)
+
+// ListAssigneeResult アサイニー一覧のページ取得結果
+type ListAssigneeResult struct {
+	assignees  AssigneeList
+	listResult *ListResult
+}
+
+func NewListAssigneeResult(assignees AssigneeList, totalCount int) (*ListAssigneeResult, error) {
+	listResult, err := NewListResult(len(assignees), totalCount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListAssigneeResult{
+		assignees:  assignees,
+		listResult: listResult,
+	}, nil
+}
+
+func (l *ListAssigneeResult) Assignees() AssigneeList {
+	return l.assignees
+}
+
+func (l *ListAssigneeResult) ListResult() *ListResult {
+	return l.listResult
+}
+
 // アサイニーID
 type AssigneeID string
 
@@ -333,3 +429,28 @@ const (
 	StagePaymentCompleted              // 支払い完了
 	StageDone                          // 終了(辞退の場合も含む)
 )
+
+var stageLabels = map[Stage]string{
+	StageBeforeInvitation: "参加募集前",
+	StageInvitation:       "参加募集",
+	StageLottery:          "抽選",
+	StageLotteryLost:      "抽選落ち",
+	StageShipment:         "発送",
+	StageDraftSubmission:  "下書き提出",
+	StagePreExamination:   "下書き審査",
+	StagePreReexamination: "下書き再審査",
+	StageArticlePosting:   "記事提出",
+	StageExamination:      "記事審査",
+	StageReexamination:    "記事再審査",
+	StagePaying:           "支払い中",
+	StagePaymentCompleted: "支払い完了",
+	StageDone:             "終了",
+}
+
+// Label ステージの表示名を返す
+func (s Stage) Label() string {
+	if l, ok := stageLabels[s]; ok {
+		return l
+	}
+	return "不明"
+}
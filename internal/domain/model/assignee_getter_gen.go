@@ -30,3 +30,9 @@ func (a *Assignee) ShippingData() []string {
 func (a *Assignee) JanCode() *string {
 	return a.janCode
 }
+func (a *Assignee) PaymentBatchID() *PaymentBatchID {
+	return a.paymentBatchID
+}
+func (a *Assignee) WritingFeeChanges() []WritingFeeChange {
+	return a.writingFeeChanges
+}
@@ -246,3 +246,70 @@ func TestAssignee_ChangeStageByEntrySubmission(t *testing.T) {
 		})
 	}
 }
+
+func TestAssignee_SetWritingFee(t *testing.T) {
+	tests := []struct {
+		name            string
+		stage           Stage
+		writingFee      int
+		wantErr         bool
+		wantChangeCount int
+	}{
+		{
+			name:            "正常系。記事提出の場合は変更でき、変更履歴が記録される",
+			stage:           StageArticlePosting,
+			writingFee:      5000,
+			wantChangeCount: 1,
+		},
+		{
+			name:            "正常系。同じ値の場合は変更履歴が記録されない",
+			stage:           StageArticlePosting,
+			writingFee:      3000,
+			wantChangeCount: 0,
+		},
+		{
+			name:            "正常系。支払い中でも同じ値であればエラーにならない",
+			stage:           StagePaying,
+			writingFee:      3000,
+			wantChangeCount: 0,
+		},
+		{
+			name:       "異常系。支払い中の場合は変更できない",
+			stage:      StagePaying,
+			writingFee: 5000,
+			wantErr:    true,
+		},
+		{
+			name:       "異常系。支払い完了の場合は変更できない",
+			stage:      StagePaymentCompleted,
+			writingFee: 5000,
+			wantErr:    true,
+		},
+		{
+			name:       "異常系。負の値",
+			stage:      StageArticlePosting,
+			writingFee: -1,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Assignee{
+				writingFee: 3000,
+				stage:      tt.stage,
+			}
+			if err := a.SetWritingFee(tt.writingFee); (err != nil) != tt.wantErr {
+				t.Errorf("SetWritingFee() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if a.WritingFee() != tt.writingFee {
+				t.Errorf("WritingFee() = %v, want %v", a.WritingFee(), tt.writingFee)
+			}
+			if len(a.WritingFeeChanges()) != tt.wantChangeCount {
+				t.Errorf("len(WritingFeeChanges()) = %v, want %v", len(a.WritingFeeChanges()), tt.wantChangeCount)
+			}
+		})
+	}
+}
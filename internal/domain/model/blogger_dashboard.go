@@ -0,0 +1,155 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// ダッシュボードでのアサインの分類
+type DashboardGroup int
+
+const (
+	DashboardGroupUnknown      DashboardGroup = iota // 不明
+	DashboardGroupActionNeeded                       // ブロガーの対応が必要
+	DashboardGroupWaiting                            // 抽選・発送・審査・支払いなどの運営の対応待ち
+	DashboardGroupDone                               // 終了
+)
+
+// ブロガーが行う必要のある対応
+type DashboardAction int
+
+const (
+	DashboardActionNone             DashboardAction = iota // 対応不要
+	DashboardActionAnswerInvitation                        // 参加募集への回答
+	DashboardActionSubmitDraft                             // 下書き提出
+	DashboardActionFixDraft                                // 下書きの修正
+	DashboardActionSubmitArticle                           // 記事提出(再審査での再提出を含む)
+)
+
+type dashboardStage struct {
+	group  DashboardGroup
+	action DashboardAction
+	// 次の締め切りとするスケジュール。締め切りが無い場合は ScheduleTypeUnknown
+	scheduleType ScheduleType
+}
+
+var dashboardStages = map[Stage]dashboardStage{
+	StageBeforeInvitation: {DashboardGroupWaiting, DashboardActionNone, ScheduleTypeInvitation},
+	StageInvitation:       {DashboardGroupActionNeeded, DashboardActionAnswerInvitation, ScheduleTypeInvitation},
+	StageLottery:          {DashboardGroupWaiting, DashboardActionNone, ScheduleTypeLottery},
+	StageLotteryLost:      {DashboardGroupDone, DashboardActionNone, ScheduleTypeUnknown},
+	StageShipment:         {DashboardGroupWaiting, DashboardActionNone, ScheduleTypeShipment},
+	StageDraftSubmission:  {DashboardGroupActionNeeded, DashboardActionSubmitDraft, ScheduleTypeDraftSubmission},
+	StagePreExamination:   {DashboardGroupWaiting, DashboardActionNone, ScheduleTypePreExamination},
+	StagePreReexamination: {DashboardGroupActionNeeded, DashboardActionFixDraft, ScheduleTypeDraftSubmission},
+	StageArticlePosting:   {DashboardGroupActionNeeded, DashboardActionSubmitArticle, ScheduleTypeArticlePosting},
+	StageExamination:      {DashboardGroupWaiting, DashboardActionNone, ScheduleTypeExamination},
+	StageReexamination:    {DashboardGroupActionNeeded, DashboardActionSubmitArticle, ScheduleTypeArticlePosting},
+	StagePaying:           {DashboardGroupWaiting, DashboardActionNone, ScheduleTypePayment},
+	StagePaymentCompleted: {DashboardGroupDone, DashboardActionNone, ScheduleTypeUnknown},
+	StageDone:             {DashboardGroupDone, DashboardActionNone, ScheduleTypeUnknown},
+}
+
+// DashboardAssignment ダッシュボードに表示するアサイン
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=DashboardAssignment
+type DashboardAssignment struct {
+	// アサイニー
+	assignee *Assignee
+	// オファー案件
+	offerItem *OfferItem
+	// 分類
+	group DashboardGroup
+	// ブロガーが行う必要のある対応
+	action DashboardAction
+	// 現在のステージに対応するスケジュールの終了日。未設定の場合は nil
+	nextDeadline *time.Time
+	// 最新の審査の再審査理由。審査が無い場合や理由が無い場合は nil
+	latestReviewReason *string
+}
+
+// BloggerDashboard ブロガーのアサインを対応の要否で分類したダッシュボード
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=BloggerDashboard
+type BloggerDashboard struct {
+	// アメーバID
+	amebaID AmebaID
+	// ブロガーの対応が必要なアサイン。締め切りの近い順
+	actionNeeded []*DashboardAssignment
+	// 運営の対応待ちのアサイン。締め切りの近い順
+	waiting []*DashboardAssignment
+	// 終了したアサイン
+	done []*DashboardAssignment
+	// 支払い中のアサインの支払い予定金額の合計
+	pendingPaymentAmount int
+}
+
+// NewBloggerDashboard アサイニーと、アサイニーのオファー案件・最新の審査からダッシュボードを生成する
+// オファー案件が存在しないアサイニー(アーカイブ済みなど)は含めない
+func NewBloggerDashboard(amebaID AmebaID, assignees AssigneeList, offerItems map[OfferItemID]*OfferItem, latestExaminations map[AssigneeID]*Examination) *BloggerDashboard {
+	d := &BloggerDashboard{
+		amebaID:      amebaID,
+		actionNeeded: make([]*DashboardAssignment, 0),
+		waiting:      make([]*DashboardAssignment, 0),
+		done:         make([]*DashboardAssignment, 0),
+	}
+	for _, a := range assignees {
+		offerItem, ok := offerItems[a.offerItemID]
+		if !ok {
+			continue
+		}
+		stage, ok := dashboardStages[a.stage]
+		if !ok {
+			continue
+		}
+
+		assignment := &DashboardAssignment{
+			assignee:     a,
+			offerItem:    offerItem,
+			group:        stage.group,
+			action:       stage.action,
+			nextDeadline: scheduleEndDate(offerItem, stage.scheduleType),
+		}
+		if e, ok := latestExaminations[a.id]; ok {
+			assignment.latestReviewReason = e.reason
+		}
+
+		switch stage.group {
+		case DashboardGroupActionNeeded:
+			d.actionNeeded = append(d.actionNeeded, assignment)
+		case DashboardGroupWaiting:
+			d.waiting = append(d.waiting, assignment)
+		case DashboardGroupDone:
+			d.done = append(d.done, assignment)
+		}
+		if a.stage == StagePaying {
+			d.pendingPaymentAmount += a.writingFee + paymentSpecialAmount(offerItem)
+		}
+	}
+	sortByNextDeadline(d.actionNeeded)
+	sortByNextDeadline(d.waiting)
+	return d
+}
+
+func scheduleEndDate(offerItem *OfferItem, scheduleType ScheduleType) *time.Time {
+	if scheduleType == ScheduleTypeUnknown {
+		return nil
+	}
+	for _, s := range offerItem.schedules {
+		if s.scheduleType == scheduleType {
+			return s.endDate
+		}
+	}
+	return nil
+}
+
+// 締め切りの近い順に並べる。締め切りが無いアサインは最後にする
+func sortByNextDeadline(assignments []*DashboardAssignment) {
+	sort.SliceStable(assignments, func(i, j int) bool {
+		a, b := assignments[i].nextDeadline, assignments[j].nextDeadline
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+}
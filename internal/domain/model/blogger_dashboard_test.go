@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBloggerDashboard(t *testing.T) {
+	day := func(d int) *time.Time {
+		v := time.Date(2026, 10, d, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	reason := "画像を差し替えてください"
+	offerItems := map[OfferItemID]*OfferItem{
+		"offer_item_1": {id: "offer_item_1", schedules: ScheduleList{
+			{scheduleType: ScheduleTypeInvitation, endDate: day(25)},
+			{scheduleType: ScheduleTypeDraftSubmission, endDate: day(28)},
+		}},
+		"offer_item_2": {id: "offer_item_2", schedules: ScheduleList{
+			{scheduleType: ScheduleTypeDraftSubmission, endDate: day(21)},
+			{scheduleType: ScheduleTypeArticlePosting, endDate: day(30)},
+		}},
+		"offer_item_3": {id: "offer_item_3", hasSpecialCommission: true, specialAmount: 500},
+	}
+	assignees := AssigneeList{
+		{id: "assignee_1", offerItemID: "offer_item_1", stage: StageInvitation},
+		{id: "assignee_2", offerItemID: "offer_item_2", stage: StagePreReexamination},
+		{id: "assignee_3", offerItemID: "offer_item_3", stage: StagePaying, writingFee: 3000},
+		{id: "assignee_4", offerItemID: "offer_item_1", stage: StageArticlePosting},
+		{id: "assignee_5", offerItemID: "offer_item_2", stage: StageDone},
+		// アーカイブ済みなどで取得できなかったオファー案件は含めない
+		{id: "assignee_6", offerItemID: "offer_item_archived", stage: StagePaying, writingFee: 1000},
+	}
+	latestExaminations := map[AssigneeID]*Examination{
+		"assignee_2": {assigneeID: "assignee_2", reason: &reason},
+	}
+
+	got := NewBloggerDashboard("ameba_id", assignees, offerItems, latestExaminations)
+
+	ids := func(assignments []*DashboardAssignment) []AssigneeID {
+		res := make([]AssigneeID, 0, len(assignments))
+		for _, a := range assignments {
+			res = append(res, a.Assignee().ID())
+		}
+		return res
+	}
+	// 締め切りの近い順に並び、締め切りが無いアサインは最後になる
+	assert.Equal(t, []AssigneeID{"assignee_2", "assignee_1", "assignee_4"}, ids(got.ActionNeeded()))
+	assert.Equal(t, []AssigneeID{"assignee_3"}, ids(got.Waiting()))
+	assert.Equal(t, []AssigneeID{"assignee_5"}, ids(got.Done()))
+
+	fixDraft := got.ActionNeeded()[0]
+	assert.Equal(t, DashboardActionFixDraft, fixDraft.Action())
+	assert.Equal(t, day(21), fixDraft.NextDeadline())
+	assert.Equal(t, &reason, fixDraft.LatestReviewReason())
+	assert.Equal(t, DashboardActionAnswerInvitation, got.ActionNeeded()[1].Action())
+	assert.Nil(t, got.ActionNeeded()[2].NextDeadline())
+
+	assert.Equal(t, 3500, got.PendingPaymentAmount())
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+)
+
+// 1件あたりの想定報酬
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=CommissionPreview
+type CommissionPreview struct {
+	offerItemID OfferItemID
+	// 報酬タイプ
+	commissionType CommissionType
+	// 税抜き価格
+	priceWithoutTax int64
+	// 最低報酬(円)
+	minReward int64
+	// 最高報酬(円)
+	maxReward int64
+	// 特単が適用されているか
+	isSpecialCommission bool
+}
+
+// NewCommissionPreview 案件の報酬と特単から、税込価格 price の商品が1件売れた場合の想定報酬を計算する
+// 定率の場合は税抜き価格に料率(%)を掛けて円未満を切り捨てる。定額の場合は価格に関わらず報酬額とする
+// 特単が設定されている場合は、通常の報酬の代わりに特単料率または特単金額を適用する
+func NewCommissionPreview(offerItem *OfferItem, price Price) (*CommissionPreview, error) {
+	if price < 0 {
+		return nil, apperr.OfferItemValidationError.Wrap(errors.New("price must be greater than or equal to 0"))
+	}
+	item := offerItem.item
+	if item == nil || item.minCommissionRate == nil || item.maxCommissionRate == nil {
+		return nil, fmt.Errorf("commission of item is not set. OfferItemID: %s", offerItem.id)
+	}
+
+	priceWithoutTax := price.WithoutTax()
+	res := &CommissionPreview{
+		offerItemID:     offerItem.id,
+		commissionType:  item.minCommissionRate.commissionType,
+		priceWithoutTax: priceWithoutTax,
+	}
+	switch {
+	case offerItem.hasSpecialCommission && offerItem.specialRate > 0:
+		res.commissionType = CommissionTypeFixedRate
+		res.minReward = rateReward(priceWithoutTax, offerItem.specialRate)
+		res.maxReward = res.minReward
+		res.isSpecialCommission = true
+	case offerItem.hasSpecialCommission && offerItem.specialAmount > 0:
+		res.commissionType = CommissionTypeFixedAmount
+		res.minReward = int64(offerItem.specialAmount)
+		res.maxReward = res.minReward
+		res.isSpecialCommission = true
+	default:
+		minReward, err := item.minCommissionRate.reward(priceWithoutTax)
+		if err != nil {
+			return nil, fmt.Errorf("minCommissionRate.reward: %w", err)
+		}
+		maxReward, err := item.maxCommissionRate.reward(priceWithoutTax)
+		if err != nil {
+			return nil, fmt.Errorf("maxCommissionRate.reward: %w", err)
+		}
+		res.minReward = minReward
+		res.maxReward = maxReward
+	}
+	return res, nil
+}
+
+// reward 税抜き価格に対する1件あたりの報酬を計算する
+func (c *Commission) reward(priceWithoutTax int64) (int64, error) {
+	switch c.commissionType {
+	case CommissionTypeFixedRate:
+		return rateReward(priceWithoutTax, float64(c.calculatedRate)), nil
+	case CommissionTypeFixedAmount:
+		return int64(math.Floor(float64(c.calculatedRate))), nil
+	default:
+		return 0, apperr.OfferItemValidationError.Wrap(fmt.Errorf("commission type is not supported: %d", c.commissionType))
+	}
+}
+
+// rateReward 料率(%)から報酬を計算する
+// 浮動小数点の誤差で切り捨て結果がずれないよう、料率を小数第4位までの整数に丸めてから計算する
+func rateReward(priceWithoutTax int64, rate float64) int64 {
+	scaledRate := int64(math.Round(rate * 1e4))
+	return priceWithoutTax * scaledRate / 1e6
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCommissionPreview(t *testing.T) {
+	fixedRateItem := &Item{
+		minCommissionRate: &Commission{commissionType: CommissionTypeFixedRate, calculatedRate: 2},
+		maxCommissionRate: &Commission{commissionType: CommissionTypeFixedRate, calculatedRate: 4.5},
+	}
+	fixedAmountItem := &Item{
+		minCommissionRate: &Commission{commissionType: CommissionTypeFixedAmount, calculatedRate: 300},
+		maxCommissionRate: &Commission{commissionType: CommissionTypeFixedAmount, calculatedRate: 500},
+	}
+	tests := []struct {
+		name      string
+		offerItem *OfferItem
+		price     Price
+		want      *CommissionPreview
+		wantErr   bool
+	}{
+		{
+			name:      "正常系。定率の場合は税抜き価格に料率を掛ける",
+			offerItem: &OfferItem{id: "offer_item_id", item: fixedRateItem},
+			price:     11000,
+			want: &CommissionPreview{
+				offerItemID:     "offer_item_id",
+				commissionType:  CommissionTypeFixedRate,
+				priceWithoutTax: 10000,
+				minReward:       200,
+				maxReward:       450,
+			},
+		},
+		{
+			name:      "正常系。定額の場合は価格に関わらず報酬額",
+			offerItem: &OfferItem{id: "offer_item_id", item: fixedAmountItem},
+			price:     11000,
+			want: &CommissionPreview{
+				offerItemID:     "offer_item_id",
+				commissionType:  CommissionTypeFixedAmount,
+				priceWithoutTax: 10000,
+				minReward:       300,
+				maxReward:       500,
+			},
+		},
+		{
+			name:      "正常系。特単料率が設定されている場合は特単料率を適用する",
+			offerItem: &OfferItem{id: "offer_item_id", item: fixedRateItem, hasSpecialCommission: true, specialRate: 0.29},
+			price:     1100000,
+			want: &CommissionPreview{
+				offerItemID:         "offer_item_id",
+				commissionType:      CommissionTypeFixedRate,
+				priceWithoutTax:     1000000,
+				minReward:           2900,
+				maxReward:           2900,
+				isSpecialCommission: true,
+			},
+		},
+		{
+			name:      "正常系。特単金額が設定されている場合は特単金額を適用する",
+			offerItem: &OfferItem{id: "offer_item_id", item: fixedAmountItem, hasSpecialCommission: true, specialAmount: 1000},
+			price:     11000,
+			want: &CommissionPreview{
+				offerItemID:         "offer_item_id",
+				commissionType:      CommissionTypeFixedAmount,
+				priceWithoutTax:     10000,
+				minReward:           1000,
+				maxReward:           1000,
+				isSpecialCommission: true,
+			},
+		},
+		{
+			name:      "異常系。案件の報酬が設定されていない",
+			offerItem: &OfferItem{id: "offer_item_id", item: &Item{}},
+			price:     11000,
+			wantErr:   true,
+		},
+		{
+			name:      "異常系。価格が負の値",
+			offerItem: &OfferItem{id: "offer_item_id", item: fixedRateItem},
+			price:     -1,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCommissionPreview(tt.offerItem, tt.price)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
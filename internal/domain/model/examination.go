@@ -28,6 +28,12 @@ type Examination struct {
 	entryType EntryType
 	// 記事提出数
 	entrySubmissionCount uint
+	// 審査結果。未審査の場合はnil
+	isPassed *bool
+	// 審査ステータス
+	status ExaminationStatus
+	// 二重承認時の承認者名
+	confirmerName *string
 }
 
 type ExaminationList []*Examination
@@ -87,6 +93,9 @@ func NewExaminationFromRepository(
 	assigneeID AssigneeID,
 	entryType EntryType,
 	entrySubmissionCount uint,
+	isPassed *bool,
+	status ExaminationStatus,
+	confirmerName *string,
 ) *Examination {
 	return &Examination{
 		id:                   id,
@@ -98,6 +107,9 @@ func NewExaminationFromRepository(
 		assigneeID:           assigneeID,
 		entryType:            entryType,
 		entrySubmissionCount: entrySubmissionCount,
+		isPassed:             isPassed,
+		status:               status,
+		confirmerName:        confirmerName,
 	}
 }
 
@@ -139,5 +151,76 @@ func (e *Examination) SetExaminationResult(isPassed bool, examinerName string, r
 	}
 	e.examinerName = &examinerName
 	e.reason = reason
+	e.isPassed = &isPassed
+	e.status = ExaminationStatusConfirmed
 	return nil
 }
+
+// 審査ステータス
+type ExaminationStatus int
+
+func (s ExaminationStatus) Int() int {
+	return int(s)
+}
+
+const (
+	ExaminationStatusUnknown             ExaminationStatus = iota // 不明(未審査)
+	ExaminationStatusPendingConfirmation                          // 承認待ち
+	ExaminationStatusConfirmed                                    // 確定
+)
+
+// SetPendingExaminationResult 二重承認が必要なオファー案件の審査結果を承認待ちとして設定する
+// 承認者が ConfirmExaminationResult を呼び出すまで審査結果は確定しない
+// 承認待ちの審査結果は、同じ審査者のみが再設定できる
+func (e *Examination) SetPendingExaminationResult(isPassed bool, examinerName string, reason *string) error {
+	if examinerName == "" {
+		// 承認者が審査者と異なることを確認する為、二重承認の場合は審査者名を必須とする
+		return apperr.OfferItemValidationError.Wrap(errors.New("examinerName is required"))
+	}
+	// 別の審査者が上書きすると、一次審査者を承認者として承認できてしまう
+	if e.IsPendingConfirmation() && (e.examinerName == nil || *e.examinerName != examinerName) {
+		return apperr.OfferItemValidationError.Wrap(errors.New("pending examination result can only be re-uploaded by the same examiner"))
+	}
+	if err := e.SetExaminationResult(isPassed, examinerName, reason); err != nil {
+		return err
+	}
+	e.status = ExaminationStatusPendingConfirmation
+	e.confirmerName = nil
+	return nil
+}
+
+// ConfirmExaminationResult 承認待ちの審査結果を承認、または覆して確定する
+// isPassed が一次審査の結果と異なる場合は審査結果を覆す
+func (e *Examination) ConfirmExaminationResult(isPassed bool, confirmerName string, reason *string) error {
+	if e.status != ExaminationStatusPendingConfirmation {
+		return apperr.OfferItemValidationError.Wrap(errors.New("examination is not pending confirmation"))
+	}
+	if confirmerName == "" {
+		return apperr.OfferItemValidationError.Wrap(errors.New("confirmerName is required"))
+	}
+	// 一次審査者と同じ人が承認することはできない
+	if e.examinerName != nil && *e.examinerName == confirmerName {
+		return apperr.OfferItemValidationError.Wrap(errors.New("confirmer must be different from examiner"))
+	}
+
+	// 審査結果を覆す場合
+	if e.isPassed == nil || *e.isPassed != isPassed {
+		if !isPassed {
+			// 否認に覆す場合は、理由は必須
+			if reason == nil || *reason == "" {
+				return apperr.OfferItemValidationError.Wrap(errors.New("reason is required"))
+			}
+		}
+		e.isPassed = &isPassed
+		e.reason = reason
+	}
+
+	e.confirmerName = &confirmerName
+	e.status = ExaminationStatusConfirmed
+	return nil
+}
+
+// IsPendingConfirmation 承認待ちかどうか
+func (e *Examination) IsPendingConfirmation() bool {
+	return e.status == ExaminationStatusPendingConfirmation
+}
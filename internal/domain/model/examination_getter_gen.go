@@ -28,3 +28,12 @@ func (e *Examination) EntryType() EntryType {
 func (e *Examination) EntrySubmissionCount() uint {
 	return e.entrySubmissionCount
 }
+func (e *Examination) IsPassed() *bool {
+	return e.isPassed
+}
+func (e *Examination) Status() ExaminationStatus {
+	return e.status
+}
+func (e *Examination) ConfirmerName() *string {
+	return e.confirmerName
+}
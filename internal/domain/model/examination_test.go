@@ -3,6 +3,7 @@ package model
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/volatiletech/null/v8"
 )
 
@@ -111,3 +112,264 @@ func TestExamination_SetExaminationResult(t *testing.T) {
 		})
 	}
 }
+
+func TestExamination_SetPendingExaminationResult(t *testing.T) {
+	type args struct {
+		isPassed     bool
+		examinerName string
+		reason       *string
+	}
+	pending := func() *Examination {
+		return &Examination{status: ExaminationStatusPendingConfirmation, isPassed: null.BoolFrom(true).Ptr(), examinerName: null.StringFrom("サイバー太郎").Ptr()}
+	}
+	tests := []struct {
+		name        string
+		examination func() *Examination
+		args        args
+		wantStatus  ExaminationStatus
+		wantErr     bool
+	}{
+		{
+			name: "正常系。 isPassed=true",
+			args: args{
+				isPassed:     true,
+				examinerName: "サイバー太郎",
+				reason:       nil,
+			},
+			wantStatus: ExaminationStatusPendingConfirmation,
+			wantErr:    false,
+		},
+		{
+			name: "正常系。 isPassed=false",
+			args: args{
+				isPassed:     false,
+				examinerName: "サイバー太郎",
+				reason:       null.StringFrom("xxxな理由でNG").Ptr(),
+			},
+			wantStatus: ExaminationStatusPendingConfirmation,
+			wantErr:    false,
+		},
+		{
+			name: "異常系。 審査者が空文字。承認者と審査者が異なることを確認できない",
+			args: args{
+				isPassed:     true,
+				examinerName: "",
+				reason:       nil,
+			},
+			wantStatus: ExaminationStatusUnknown,
+			wantErr:    true,
+		},
+		{
+			name: "異常系。 isPassed=false x NG理由が nil",
+			args: args{
+				isPassed:     false,
+				examinerName: "サイバー太郎",
+				reason:       nil,
+			},
+			wantStatus: ExaminationStatusUnknown,
+			wantErr:    true,
+		},
+		{
+			name:        "正常系。 承認待ちの審査結果を同じ審査者が再設定する",
+			examination: pending,
+			args: args{
+				isPassed:     false,
+				examinerName: "サイバー太郎",
+				reason:       null.StringFrom("xxxな理由でNG").Ptr(),
+			},
+			wantStatus: ExaminationStatusPendingConfirmation,
+			wantErr:    false,
+		},
+		{
+			name:        "異常系。 承認待ちの審査結果を別の審査者が再設定する",
+			examination: pending,
+			args: args{
+				isPassed:     true,
+				examinerName: "サイバー花子",
+				reason:       nil,
+			},
+			wantStatus: ExaminationStatusPendingConfirmation,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Examination{}
+			if tt.examination != nil {
+				e = tt.examination()
+			}
+			if err := e.SetPendingExaminationResult(tt.args.isPassed, tt.args.examinerName, tt.args.reason); (err != nil) != tt.wantErr {
+				t.Errorf("Examination.SetPendingExaminationResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.wantStatus, e.status)
+		})
+	}
+}
+
+func TestExamination_ConfirmExaminationResult(t *testing.T) {
+	type fields struct {
+		examinerName *string
+		reason       *string
+		isPassed     *bool
+		status       ExaminationStatus
+	}
+	type args struct {
+		isPassed      bool
+		confirmerName string
+		reason        *string
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		args         args
+		wantIsPassed *bool
+		wantReason   *string
+		wantStatus   ExaminationStatus
+		wantErr      bool
+	}{
+		{
+			name: "正常系。 合格を承認",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				isPassed:     null.BoolFrom(true).Ptr(),
+				status:       ExaminationStatusPendingConfirmation,
+			},
+			args: args{
+				isPassed:      true,
+				confirmerName: "サイバー花子",
+			},
+			wantIsPassed: null.BoolFrom(true).Ptr(),
+			wantStatus:   ExaminationStatusConfirmed,
+			wantErr:      false,
+		},
+		{
+			name: "正常系。 不合格を承認。一次審査の理由が引き継がれる",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				reason:       null.StringFrom("xxxな理由でNG").Ptr(),
+				isPassed:     null.BoolFrom(false).Ptr(),
+				status:       ExaminationStatusPendingConfirmation,
+			},
+			args: args{
+				isPassed:      false,
+				confirmerName: "サイバー花子",
+			},
+			wantIsPassed: null.BoolFrom(false).Ptr(),
+			wantReason:   null.StringFrom("xxxな理由でNG").Ptr(),
+			wantStatus:   ExaminationStatusConfirmed,
+			wantErr:      false,
+		},
+		{
+			name: "正常系。 合格を不合格に覆す",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				isPassed:     null.BoolFrom(true).Ptr(),
+				status:       ExaminationStatusPendingConfirmation,
+			},
+			args: args{
+				isPassed:      false,
+				confirmerName: "サイバー花子",
+				reason:        null.StringFrom("yyyな理由でNG").Ptr(),
+			},
+			wantIsPassed: null.BoolFrom(false).Ptr(),
+			wantReason:   null.StringFrom("yyyな理由でNG").Ptr(),
+			wantStatus:   ExaminationStatusConfirmed,
+			wantErr:      false,
+		},
+		{
+			name: "正常系。 不合格を合格に覆す",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				reason:       null.StringFrom("xxxな理由でNG").Ptr(),
+				isPassed:     null.BoolFrom(false).Ptr(),
+				status:       ExaminationStatusPendingConfirmation,
+			},
+			args: args{
+				isPassed:      true,
+				confirmerName: "サイバー花子",
+			},
+			wantIsPassed: null.BoolFrom(true).Ptr(),
+			wantReason:   nil,
+			wantStatus:   ExaminationStatusConfirmed,
+			wantErr:      false,
+		},
+		{
+			name: "異常系。 合格を不合格に覆す x NG理由が nil",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				isPassed:     null.BoolFrom(true).Ptr(),
+				status:       ExaminationStatusPendingConfirmation,
+			},
+			args: args{
+				isPassed:      false,
+				confirmerName: "サイバー花子",
+			},
+			wantIsPassed: null.BoolFrom(true).Ptr(),
+			wantStatus:   ExaminationStatusPendingConfirmation,
+			wantErr:      true,
+		},
+		{
+			name: "異常系。 承認者が一次審査者と同じ",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				isPassed:     null.BoolFrom(true).Ptr(),
+				status:       ExaminationStatusPendingConfirmation,
+			},
+			args: args{
+				isPassed:      true,
+				confirmerName: "サイバー太郎",
+			},
+			wantIsPassed: null.BoolFrom(true).Ptr(),
+			wantStatus:   ExaminationStatusPendingConfirmation,
+			wantErr:      true,
+		},
+		{
+			name: "異常系。 承認者が空文字",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				isPassed:     null.BoolFrom(true).Ptr(),
+				status:       ExaminationStatusPendingConfirmation,
+			},
+			args: args{
+				isPassed:      true,
+				confirmerName: "",
+			},
+			wantIsPassed: null.BoolFrom(true).Ptr(),
+			wantStatus:   ExaminationStatusPendingConfirmation,
+			wantErr:      true,
+		},
+		{
+			name: "異常系。 承認待ちではない",
+			fields: fields{
+				examinerName: null.StringFrom("サイバー太郎").Ptr(),
+				isPassed:     null.BoolFrom(true).Ptr(),
+				status:       ExaminationStatusConfirmed,
+			},
+			args: args{
+				isPassed:      true,
+				confirmerName: "サイバー花子",
+			},
+			wantIsPassed: null.BoolFrom(true).Ptr(),
+			wantStatus:   ExaminationStatusConfirmed,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Examination{
+				examinerName: tt.fields.examinerName,
+				reason:       tt.fields.reason,
+				isPassed:     tt.fields.isPassed,
+				status:       tt.fields.status,
+			}
+			if err := e.ConfirmExaminationResult(tt.args.isPassed, tt.args.confirmerName, tt.args.reason); (err != nil) != tt.wantErr {
+				t.Errorf("Examination.ConfirmExaminationResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.wantIsPassed, e.isPassed)
+			assert.Equal(t, tt.wantStatus, e.status)
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantReason, e.reason)
+			}
+		})
+	}
+}
@@ -167,6 +167,39 @@ func NewDraftedItemInfoFromRepository(
 	}
 }
 
+// ToItem 案件情報を取得できない場合に、下書きの案件情報から案件を生成する
+func (i *ItemInfo) ToItem(id ItemID, isDF bool) (*Item, error) {
+	urls, err := i.platformURLs()
+	if err != nil {
+		return nil, fmt.Errorf("i.platformURLs: %w", err)
+	}
+	item, err := NewItem(id, i.imageURL, i.name, i.minCommission, i.maxCommission, urls, false, i.contentName, false, isDF)
+	if err != nil {
+		return nil, fmt.Errorf("NewItem: %w", err)
+	}
+	return item, nil
+}
+
+// ToDFItem 案件情報を取得できない場合に、下書きの案件情報から DF 案件を生成する
+func (i *ItemInfo) ToDFItem(id DFItemID) (*DFItem, error) {
+	urls, err := i.platformURLs()
+	if err != nil {
+		return nil, fmt.Errorf("i.platformURLs: %w", err)
+	}
+	return NewDFItem(id, i.imageURL, i.name, i.minCommission, i.maxCommission, urls), nil
+}
+
+func (i *ItemInfo) platformURLs() ([]*PlatformURL, error) {
+	if i.url == "" {
+		return nil, nil
+	}
+	url, err := NewPlatformURL(PlatformTypeAll, i.url)
+	if err != nil {
+		return nil, fmt.Errorf("NewPlatformURL: %w", err)
+	}
+	return []*PlatformURL{url}, nil
+}
+
 func NewItemByItemID(id ItemID) (*Item, error) {
 	if len(id) == 0 {
 		return nil, errors.New("ID should not be empty.")
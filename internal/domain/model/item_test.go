@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestItemInfo_ToItem(t *testing.T) {
+	commission, err := NewCommission(CommissionTypeFixedRate, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := NewDraftedItemInfoFromRepository("offer-item-1", "商品名", "楽天株式会社", "https://example.com/image.png", "https://example.com/item", commission, commission)
+
+	item, err := info.ToItem("RK000001", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !item.Exists() || item.ID() != "RK000001" || item.Name() != "商品名" || item.ContentName() != "楽天株式会社" || !item.IsDF() {
+		t.Errorf("ToItem() = %+v", item)
+	}
+	if len(item.Urls()) != 1 || item.Urls()[0].URL() != "https://example.com/item" {
+		t.Errorf("ToItem().Urls() = %+v", item.Urls())
+	}
+
+	dfItem, err := info.ToDFItem("shop:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dfItem.Exists() || dfItem.ID() != "shop:1" || dfItem.Img() != "https://example.com/image.png" || dfItem.MaxCommissionRate() != commission {
+		t.Errorf("ToDFItem() = %+v", dfItem)
+	}
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// LegacyImageColumn オブジェクトストレージへの移行前に、画像データを直接保存していたカラム
+type LegacyImageColumn int
+
+const (
+	LegacyImageColumnQuestionnaireQuestionImage LegacyImageColumn = iota + 1
+	LegacyImageColumnQuestionnaireQuestionHistoryImage
+	LegacyImageColumnExaminationSNSScreenshot
+)
+
+// LegacyImageColumns 移行対象の全てのカラム
+var LegacyImageColumns = []LegacyImageColumn{
+	LegacyImageColumnQuestionnaireQuestionImage,
+	LegacyImageColumnQuestionnaireQuestionHistoryImage,
+	LegacyImageColumnExaminationSNSScreenshot,
+}
+
+func (c LegacyImageColumn) String() string {
+	switch c {
+	case LegacyImageColumnQuestionnaireQuestionImage:
+		return "questionnaire_question.image"
+	case LegacyImageColumnQuestionnaireQuestionHistoryImage:
+		return "questionnaire_question_history.image"
+	case LegacyImageColumnExaminationSNSScreenshot:
+		return "examination.sns_screenshot_url"
+	default:
+		return "unknown"
+	}
+}
+
+// ObjectKeyPrefix 移行後のオブジェクトキーの接頭辞
+func (c LegacyImageColumn) ObjectKeyPrefix() string {
+	if c == LegacyImageColumnExaminationSNSScreenshot {
+		return ObjectKeyPrefixSNSScreenshot
+	}
+	return ObjectKeyPrefixQuestionnaireImage
+}
+
+// IsObjectKey カラムの値が移行後のオブジェクトキーかどうか。オブジェクトキー以外の値は移行前のデータとして扱う
+func (c LegacyImageColumn) IsObjectKey(value string) bool {
+	return strings.HasPrefix(value, c.ObjectKeyPrefix()+"/")
+}
+
+// LegacyImage DBに直接保存された移行前の画像データ
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=LegacyImage
+type LegacyImage struct {
+	column LegacyImageColumn
+	// 保存されている行のID
+	id string
+	// data URL または画像のバイナリ
+	value []byte
+}
+
+func NewLegacyImageFromRepository(column LegacyImageColumn, id string, value []byte) *LegacyImage {
+	return &LegacyImage{
+		column: column,
+		id:     id,
+		value:  value,
+	}
+}
+
+// ToImageObject 保存されている値をデコードし、オブジェクトストレージに保存する画像オブジェクトを生成する
+// data URL の場合は指定されたコンテンツタイプ、バイナリの場合はデータから判定したコンテンツタイプを使用する
+func (i *LegacyImage) ToImageObject() (*StorageObject, error) {
+	if IsDataURL(string(i.value)) {
+		o, err := NewImageObjectFromDataURL(i.column.ObjectKeyPrefix(), string(i.value))
+		if err != nil {
+			return nil, fmt.Errorf("NewImageObjectFromDataURL: %w", err)
+		}
+		return o, nil
+	}
+	o, err := NewImageObject(i.column.ObjectKeyPrefix(), http.DetectContentType(i.value), i.value)
+	if err != nil {
+		return nil, fmt.Errorf("NewImageObject: %w", err)
+	}
+	return o, nil
+}
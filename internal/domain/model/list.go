@@ -1,13 +1,20 @@
 package model
 
-import "errors"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
 
 // リスト取得結果
 type ListResult struct {
 	// 取得データ数
 	count int
-	// データ総数
+	// データ総数。総数を取得しない条件の場合は 0
 	totalCount int
+	// 次のページのカーソル。次のページが無い場合は空文字
+	nextCursor string
 }
 
 func NewListResult(count, totalCount int) (*ListResult, error) {
@@ -32,6 +39,18 @@ func (l *ListResult) TotalCount() int {
 	return l.totalCount
 }
 
+func (l *ListResult) NextCursor() string {
+	return l.nextCursor
+}
+
+func (l *ListResult) SetNextCursor(cursor *Cursor) {
+	if cursor == nil {
+		l.nextCursor = ""
+		return
+	}
+	l.nextCursor = cursor.Encode()
+}
+
 // リスト取得条件
 type ListCondition struct {
 	// 読み飛ばしデータ数
@@ -40,6 +59,12 @@ type ListCondition struct {
 	limit int
 	// ソート設定リスト
 	sorts []*Sort
+	// カーソルによるページ送りを行うか。true の場合 offset は使用しない
+	cursorMode bool
+	// 前のページの最後のデータの位置。nil の場合は先頭のページを取得する
+	cursor *Cursor
+	// データ総数を取得するか
+	withTotalCount bool
 }
 
 func NewListCondition(offset, limit int, sorts []*Sort) (*ListCondition, error) {
@@ -51,9 +76,47 @@ func NewListCondition(offset, limit int, sorts []*Sort) (*ListCondition, error)
 	}
 
 	return &ListCondition{
-		offset: offset,
-		limit:  limit,
-		sorts:  sorts,
+		offset:         offset,
+		limit:          limit,
+		sorts:          sorts,
+		withTotalCount: true,
+	}, nil
+}
+
+// カーソルによるページ送りのリスト取得条件を生成する
+// ソート設定の先頭のカラムと ID の組をソートキーとするため、ソート設定は 1 つまでとする
+// cursorToken が空の場合は先頭のページを取得する
+func NewCursorListCondition(limit int, sorts []*Sort, cursorToken string, withTotalCount bool) (*ListCondition, error) {
+	if limit < 0 {
+		return nil, errors.New("Limit should not be less than 0.")
+	}
+	if len(sorts) > 1 {
+		return nil, errors.New("Sorts should not have more than one element with cursor.")
+	}
+
+	var cursor *Cursor
+	if cursorToken != "" {
+		c, err := DecodeCursor(cursorToken)
+		if err != nil {
+			return nil, fmt.Errorf("DecodeCursor: %w", err)
+		}
+		// ソート条件が変わるとカーソルの位置が意味を持たなくなるため、カーソル生成時と同じ条件のみ受け付ける
+		orderBy, desc := "", false
+		if len(sorts) > 0 {
+			orderBy, desc = sorts[0].OrderBy(), sorts[0].Desc()
+		}
+		if c.OrderBy() != orderBy || c.Desc() != desc {
+			return nil, errors.New("Cursor does not match the sort condition.")
+		}
+		cursor = c
+	}
+
+	return &ListCondition{
+		limit:          limit,
+		sorts:          sorts,
+		cursorMode:     true,
+		cursor:         cursor,
+		withTotalCount: withTotalCount,
 	}, nil
 }
 
@@ -71,10 +134,32 @@ func (l *ListCondition) Limit() int {
 	return l.limit
 }
 
+// 取得上限数が指定されていない場合はデフォルト値を、上限を超える場合は上限値を設定する
+func (l *ListCondition) ClampLimit(defaultLimit, maxLimit int) {
+	if l.limit == 0 {
+		l.limit = defaultLimit
+	}
+	if l.limit > maxLimit {
+		l.limit = maxLimit
+	}
+}
+
 func (l *ListCondition) Sorts() []*Sort {
 	return l.sorts
 }
 
+func (l *ListCondition) CursorMode() bool {
+	return l.cursorMode
+}
+
+func (l *ListCondition) Cursor() *Cursor {
+	return l.cursor
+}
+
+func (l *ListCondition) WithTotalCount() bool {
+	return l.withTotalCount
+}
+
 // ソート設定
 type Sort struct {
 	// カラム名
@@ -101,3 +186,77 @@ func (s *Sort) OrderBy() string {
 func (s *Sort) Desc() bool {
 	return s.desc
 }
+
+// ページ送りのカーソル
+// 前のページの最後のデータのソートキーの値と ID を保持し、クライアントには中身を意識させない文字列として渡す
+type Cursor struct {
+	// ソートキーのカラム名。ソート設定が無い場合は空文字
+	orderBy string
+	// 降順フラグ
+	desc bool
+	// ソートキーの値
+	value string
+	// データの ID
+	id string
+}
+
+type cursorJSON struct {
+	OrderBy string `json:"o,omitempty"`
+	Desc    bool   `json:"d,omitempty"`
+	Value   string `json:"v,omitempty"`
+	ID      string `json:"i"`
+}
+
+func NewCursor(orderBy string, desc bool, value, id string) (*Cursor, error) {
+	if len(id) == 0 {
+		return nil, errors.New("ID should not be empty.")
+	}
+
+	return &Cursor{
+		orderBy: orderBy,
+		desc:    desc,
+		value:   value,
+		id:      id,
+	}, nil
+}
+
+// クライアントから受け取った文字列をカーソルに変換する
+func DecodeCursor(token string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("base64.RawURLEncoding.DecodeString: %w", err)
+	}
+	var c cursorJSON
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	return NewCursor(c.OrderBy, c.Desc, c.Value, c.ID)
+}
+
+// クライアントに渡す文字列に変換する
+func (c *Cursor) Encode() string {
+	// string と bool のみのため Marshal は失敗しない
+	b, _ := json.Marshal(cursorJSON{
+		OrderBy: c.orderBy,
+		Desc:    c.desc,
+		Value:   c.value,
+		ID:      c.id,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func (c *Cursor) OrderBy() string {
+	return c.orderBy
+}
+
+func (c *Cursor) Desc() bool {
+	return c.desc
+}
+
+func (c *Cursor) Value() string {
+	return c.value
+}
+
+func (c *Cursor) ID() string {
+	return c.id
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCursorListCondition(t *testing.T) {
+	createdAtDesc, _ := NewSort("created_at", true)
+	createdAtAsc, _ := NewSort("created_at", false)
+	name, _ := NewSort("name", false)
+	cursor, _ := NewCursor("created_at", true, "t:2026-10-19T00:00:00Z", "offer_item_id")
+
+	tests := []struct {
+		name        string
+		sorts       []*Sort
+		cursorToken string
+		wantCursor  *Cursor
+		wantErr     bool
+	}{
+		{
+			name:  "正常系。 先頭のページ",
+			sorts: []*Sort{createdAtDesc},
+		},
+		{
+			name:        "正常系。 カーソルを指定",
+			sorts:       []*Sort{createdAtDesc},
+			cursorToken: cursor.Encode(),
+			wantCursor:  cursor,
+		},
+		{
+			name:        "異常系。 カーソル生成時とソート順が異なる",
+			sorts:       []*Sort{createdAtAsc},
+			cursorToken: cursor.Encode(),
+			wantErr:     true,
+		},
+		{
+			name:        "異常系。 カーソル生成時とソート設定が異なる",
+			cursorToken: cursor.Encode(),
+			wantErr:     true,
+		},
+		{
+			name:    "異常系。 ソート設定が複数",
+			sorts:   []*Sort{createdAtDesc, name},
+			wantErr: true,
+		},
+		{
+			name:        "異常系。 不正なカーソル",
+			sorts:       []*Sort{createdAtDesc},
+			cursorToken: "invalid cursor",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewCursorListCondition(10, tt.sorts, tt.cursorToken, false)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, got.CursorMode())
+			assert.False(t, got.WithTotalCount())
+			assert.Equal(t, tt.wantCursor, got.Cursor())
+		})
+	}
+}
+
+func TestListCondition_ClampLimit(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{name: "未指定", limit: 0, want: 100},
+		{name: "上限以下", limit: 30, want: 30},
+		{name: "上限超過", limit: 5000, want: 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition, err := NewCursorListCondition(tt.limit, nil, "", true)
+			assert.NoError(t, err)
+			condition.ClampLimit(100, 1000)
+			assert.Equal(t, tt.want, condition.Limit())
+		})
+	}
+}
+
+func TestListCondition_ValidateOfferItemSorts(t *testing.T) {
+	tests := []struct {
+		name    string
+		orderBy string
+		wantErr bool
+	}{
+		{name: "次の締め切り", orderBy: "next_deadline"},
+		{name: "アサイニーのソートキー", orderBy: "ameba_id", wantErr: true},
+		{name: "存在しないソートキー", orderBy: "updated_at", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, _ := NewSort(tt.orderBy, false)
+			condition, _ := NewListCondition(0, 10, []*Sort{sort})
+			err := condition.ValidateOfferItemSorts()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
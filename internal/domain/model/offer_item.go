@@ -34,6 +34,8 @@ type OfferItem struct {
 	needsPreliminaryReview bool
 	// 事後審査の有無
 	needsAfterReview bool
+	// 審査結果の確定に別の審査者による承認(二重承認)が必要か
+	requiresSecondApproval bool
 	// PRマークやハッシュタグをつけるか(広告主により自動で設置させたくないケースがある)
 	needsPRMark bool
 	// 投稿必須フラグ
@@ -68,8 +70,10 @@ type OfferItem struct {
 	isPassedAfterReviewMailSent bool
 	// メール設定: 事後審査不合格通知
 	isFailedAfterReviewMailSent bool
-	// 案件が終了しているかどうか
-	isClosed bool
+	// ライフサイクル状態
+	status OfferItemStatus
+	// 楽観的排他制御のためのバージョン。更新のたびに1つ進む
+	version int
 	// 作成日時
 	createdAt time.Time
 	// スケジュールリスト
@@ -120,6 +124,7 @@ func NewOfferItem(
 	hasSample bool,
 	needsPreliminaryReview bool,
 	needsAfterReview bool,
+	requiresSecondApproval bool,
 	needsPRMark bool,
 	postRequired bool,
 	postTarget PostTarget,
@@ -137,34 +142,10 @@ func NewOfferItem(
 	isArticlePostMailSent bool,
 	isPassedAfterReviewMailSent bool,
 	isFailedAfterReviewMailSent bool,
-	isClosed bool,
+	status OfferItemStatus,
 	scheduleList ScheduleList,
 	draftedItemInfo *ItemInfo,
 ) (*OfferItem, error) {
-	if name == "" {
-		return nil, errors.New("name is required")
-	}
-	if productFeatures == "" {
-		return nil, errors.New("productFeatures is required")
-	}
-	if cautionaryPoints == "" {
-		return nil, errors.New("cautionaryPoints is required")
-	}
-	if referenceInfo == "" {
-		return nil, errors.New("referenceInfo is required")
-	}
-	if otherInfo == "" {
-		return nil, errors.New("otherInfo is required")
-	}
-	if item == nil {
-		return nil, errors.New("item is required")
-	}
-	if specialRate < 0 {
-		return nil, errors.New("specialRate must be greater than 0")
-	}
-	if specialAmount < 0 {
-		return nil, errors.New("specialAmount must be greater than 0")
-	}
 	var bannerID *BannerID
 	if couponBannerID != nil {
 		b, err := NewBannerID(*couponBannerID)
@@ -174,68 +155,11 @@ func NewOfferItem(
 		bannerID = b
 	}
 
-	if hasSpecialCommission {
-		// 特単の有無がtrueの場合、特単料率または特単金額のどちらかが必須
-		if specialRate <= 0 && specialAmount <= 0 {
-			return nil, errors.New("specialRate or specialAmount is required")
-		}
-		// 特単料率と特単金額は片方のみ設定可能
-		if specialRate > 0 && specialAmount > 0 {
-			return nil, errors.New("specialRate and specialAmount cannot be set at the same time")
-		}
-
-		// 特単料率が設定されている場合、報酬タイプが定率であることを確認
-		if item.MinCommissionRate().CommissionType() == CommissionTypeFixedRate && specialAmount > 0 {
-			return nil, errors.New("specialAmount cannot be set when commissionType is CommissionTypeFixedRate")
-		}
-
-		// 特単金額が設定されている場合、報酬タイプが定額であることを確認
-		if item.MinCommissionRate().CommissionType() == CommissionTypeFixedAmount && specialRate > 0 {
-			return nil, errors.New("specialRate cannot be set when commissionType is CommissionTypeFixedAmount")
-		}
-	} else {
-		// 特単の有無がfalseの場合、特単料率と特単金額は設定不可
-		if specialRate > 0 || specialAmount > 0 {
-			return nil, errors.New("specialRate and specialAmount cannot be set when hasSpecialCommission is false")
-		}
-	}
-
-	scheduleMap := map[ScheduleType]*Schedule{}
 	for _, schedule := range scheduleList {
 		schedule.offerItemID = offerItemID
-		scheduleMap[schedule.scheduleType] = schedule
 	}
 
-	// 「参加募集」「記事投稿」「支払い」は必須の為設定されているか確認する
-	for _, mustScheduleType := range MustScheduleTypeValues() {
-		if scheduleMap[mustScheduleType] == nil {
-			return nil, apperr.OfferItemValidationError.Wrap(errors.New("mustScheduleType is required"))
-		}
-	}
-
-	// スケジュールタイプが不正な値の場合はエラー
-	if len(scheduleMap) > len(ScheduleTypeValues()) || len(scheduleMap) < len(MustScheduleTypeValues()) {
-		return nil, apperr.OfferItemValidationError.Wrap(errors.New("scheduleType is invalid"))
-	}
-
-	var DFItemID *DFItemID
-	if dfItem.Exists() {
-		tmpDFItemID := dfItem.ID()
-		DFItemID = &tmpDFItemID
-	}
-
-	// TODO: bannerIDは今後複数対応を行う
-	var bannerIDs []BannerID
-	if couponBannerID != nil && *couponBannerID != "" {
-		bannerIDs = append(bannerIDs, BannerID(*couponBannerID))
-	}
-
-	PickInfo, err := NewPickInfo(item.ID(), DFItemID, bannerIDs)
-	if err != nil {
-		return nil, fmt.Errorf("model.NewPickInfo: %w", err)
-	}
-
-	return &OfferItem{
+	o := &OfferItem{
 		id:                                offerItemID,
 		name:                              name,
 		item:                              item,
@@ -246,6 +170,7 @@ func NewOfferItem(
 		hasSample:                         hasSample,
 		needsPreliminaryReview:            needsPreliminaryReview,
 		needsAfterReview:                  needsAfterReview,
+		requiresSecondApproval:            requiresSecondApproval,
 		needsPRMark:                       needsPRMark,
 		postRequired:                      postRequired,
 		postTarget:                        postTarget,
@@ -263,10 +188,46 @@ func NewOfferItem(
 		isArticlePostMailSent:             isArticlePostMailSent,
 		isPassedAfterReviewMailSent:       isPassedAfterReviewMailSent,
 		isFailedAfterReviewMailSent:       isFailedAfterReviewMailSent,
-		isClosed:                          isClosed,
+		status:                            status,
+		version:                           1,
 		schedules:                         scheduleList,
 		draftedItemInfo:                   draftedItemInfo,
-		pickInfo:                          PickInfo,
+	}
+	if errs := o.Validate(); len(errs) > 0 {
+		return nil, apperr.OfferItemValidationError.Wrap(errs)
+	}
+
+	var DFItemID *DFItemID
+	if dfItem.Exists() {
+		tmpDFItemID := dfItem.ID()
+		DFItemID = &tmpDFItemID
+	}
+
+	// TODO: bannerIDは今後複数対応を行う
+	var bannerIDs []BannerID
+	if couponBannerID != nil && *couponBannerID != "" {
+		bannerIDs = append(bannerIDs, BannerID(*couponBannerID))
+	}
+
+	pickInfo, err := NewPickInfo(item.ID(), DFItemID, bannerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("model.NewPickInfo: %w", err)
+	}
+	o.pickInfo = pickInfo
+	return o, nil
+}
+
+// NewDraftOfferItem 下書きのオファー案件を生成する。名前以外は後から設定でき、公開時に全ての項目を検証する
+func NewDraftOfferItem(offerItemID OfferItemID, name string) (*OfferItem, error) {
+	if name == "" {
+		return nil, apperr.OfferItemValidationError.Wrap(errors.New("name is required"))
+	}
+	return &OfferItem{
+		id:      offerItemID,
+		name:    name,
+		dfItem:  &DFItem{},
+		status:  OfferItemStatusDraft,
+		version: 1,
 	}, nil
 }
 
@@ -281,6 +242,7 @@ func NewOfferItemFromRepository(
 	hasSample,
 	needsPreliminaryReview,
 	needsAfterReview,
+	requiresSecondApproval,
 	needsPRMark,
 	postRequired,
 	hasCoupon,
@@ -298,7 +260,8 @@ func NewOfferItemFromRepository(
 	isArticlePostMailSent,
 	isPassedAfterReviewMailSent bool,
 	isFailedAfterReviewMailSent bool,
-	isClosed bool,
+	status OfferItemStatus,
+	version int,
 	createdAt time.Time,
 	schedules ScheduleList,
 	draftedItemInfo *ItemInfo,
@@ -316,6 +279,7 @@ func NewOfferItemFromRepository(
 		hasLottery:                        hasLottery,
 		needsPreliminaryReview:            needsPreliminaryReview,
 		needsAfterReview:                  needsAfterReview,
+		requiresSecondApproval:            requiresSecondApproval,
 		needsPRMark:                       needsPRMark,
 		postRequired:                      postRequired,
 		postTarget:                        postTarget,
@@ -332,7 +296,8 @@ func NewOfferItemFromRepository(
 		isArticlePostMailSent:             isArticlePostMailSent,
 		isPassedAfterReviewMailSent:       isPassedAfterReviewMailSent,
 		isFailedAfterReviewMailSent:       isFailedAfterReviewMailSent,
-		isClosed:                          isClosed,
+		status:                            status,
+		version:                           version,
 		createdAt:                         createdAt,
 		schedules:                         schedules,
 		draftedItemInfo:                   draftedItemInfo,
@@ -367,37 +332,8 @@ func (o *OfferItem) SetHasSample(v bool) {
 }
 
 func (o *OfferItem) SetSpecialCommission(hasSpecialCommission bool, specialRate float64, specialAmount int, commissionType CommissionType) error {
-	if specialRate < 0 {
-		return errors.New("specialRate must be greater than 0")
-	}
-	if specialAmount < 0 {
-		return errors.New("specialAmount must be greater than 0")
-	}
-
-	if hasSpecialCommission {
-		// 特単の有無がtrueの場合、特単料率または特単金額のどちらかが必須
-		if specialRate <= 0 && specialAmount <= 0 {
-			return errors.New("specialRate or specialAmount is required")
-		}
-		// 特単料率と特単金額は片方のみ設定可能
-		if specialRate > 0 && specialAmount > 0 {
-			return errors.New("specialRate and specialAmount cannot be set at the same time")
-		}
-
-		// 特単料率が設定されている場合、報酬タイプが定率であることを確認
-		if commissionType == CommissionTypeFixedRate && specialAmount > 0 {
-			return errors.New("specialAmount cannot be set when commissionType is CommissionTypeFixedRate")
-		}
-
-		// 特単金額が設定されている場合、報酬タイプが定額であることを確認
-		if commissionType == CommissionTypeFixedAmount && specialRate > 0 {
-			return errors.New("specialRate cannot be set when commissionType is CommissionTypeFixedAmount")
-		}
-	} else {
-		// 特単の有無がfalseの場合、特単料率と特単金額は設定不可
-		if specialRate > 0 || specialAmount > 0 {
-			return errors.New("specialRate and specialAmount cannot be set when hasSpecialCommission is false")
-		}
+	if err := validateSpecialCommission(hasSpecialCommission, specialRate, specialAmount, commissionType); err != nil {
+		return err
 	}
 
 	o.hasSpecialCommission = hasSpecialCommission
@@ -414,6 +350,10 @@ func (o *OfferItem) SetNeedsAfterReview(v bool) {
 	o.needsAfterReview = v
 }
 
+func (o *OfferItem) SetRequiresSecondApproval(v bool) {
+	o.requiresSecondApproval = v
+}
+
 func (o *OfferItem) SetNeedsPRMark(v bool) {
 	o.needsPRMark = v
 }
@@ -450,7 +390,8 @@ func (o *OfferItem) SetHasLottery(v bool) {
 }
 
 func (o *OfferItem) SetProductFeatures(v string) error {
-	if v == "" {
+	// 下書きの場合は未入力でもよい(公開時に検証する)
+	if v == "" && !o.IsDraft() {
 		return errors.New("productFeatures is required")
 	}
 	o.productFeatures = v
@@ -458,7 +399,7 @@ func (o *OfferItem) SetProductFeatures(v string) error {
 }
 
 func (o *OfferItem) SetCautionaryPoints(v string) error {
-	if v == "" {
+	if v == "" && !o.IsDraft() {
 		return errors.New("cautionaryPoints is required")
 	}
 	o.cautionaryPoints = v
@@ -466,7 +407,7 @@ func (o *OfferItem) SetCautionaryPoints(v string) error {
 }
 
 func (o *OfferItem) SetReferenceInfo(v string) error {
-	if v == "" {
+	if v == "" && !o.IsDraft() {
 		return errors.New("referenceInfo is required")
 	}
 	o.referenceInfo = v
@@ -474,7 +415,7 @@ func (o *OfferItem) SetReferenceInfo(v string) error {
 }
 
 func (o *OfferItem) SetOtherInfo(v string) error {
-	if v == "" {
+	if v == "" && !o.IsDraft() {
 		return errors.New("otherInfo is required")
 	}
 	o.otherInfo = v
@@ -509,10 +450,6 @@ func (o *OfferItem) SetIsFailedAfterReviewMailSent(v bool) {
 	o.isFailedAfterReviewMailSent = v
 }
 
-func (o *OfferItem) SetIsClosed(v bool) {
-	o.isClosed = v
-}
-
 func (o *OfferItem) SetDraftedItemInfo(name, contentName, imageURL, url string, minCommission, maxCommission *Commission, offerItemID OfferItemID) error {
 
 	fmt.Println("=======================-")
@@ -553,6 +490,10 @@ type OfferItemList []*OfferItem
 func (oil OfferItemList) ItemIdentifiers() ItemIdentifiers {
 	itemIdentifierMap := make(map[ItemIdentifier]struct{})
 	for _, offerItem := range oil {
+		// 下書きの場合は案件が未設定のことがある
+		if offerItem.item == nil {
+			continue
+		}
 		var dfItemID DFItemID
 		if offerItem.dfItem != nil {
 			dfItemID = offerItem.dfItem.ID()
@@ -578,11 +519,20 @@ func (oi OfferItemID) String() string {
 }
 
 // オファー案件検索結果
+const (
+	// カーソルによるページ送りでのオファー案件一覧の 1 ページあたりのデフォルトの取得件数
+	DefaultOfferItemListLimit = 50
+	// カーソルによるページ送りでのオファー案件一覧の 1 ページあたりの最大取得件数
+	MaxOfferItemListLimit = 500
+)
+
 type ListOfferItemResult struct {
 	// オファー案件リスト
 	offerItems OfferItemList
 	// リスト取得結果
 	listResult *ListResult
+	// 全文検索で検索語に一致した箇所。全文検索以外の場合は nil
+	highlights map[OfferItemID][]*SearchHighlight
 }
 
 func NewListOfferItemResult(offerItems OfferItemList, totalCount int) (*ListOfferItemResult, error) {
@@ -605,6 +555,18 @@ func (o *ListOfferItemResult) ListResult() *ListResult {
 	return o.listResult
 }
 
+// SetHighlights 取得したオファー案件ごとに、検索語に一致した箇所を設定する
+func (o *ListOfferItemResult) SetHighlights(terms []string) {
+	o.highlights = make(map[OfferItemID][]*SearchHighlight, len(o.offerItems))
+	for _, offerItem := range o.offerItems {
+		o.highlights[offerItem.ID()] = NewSearchHighlights(offerItem, terms)
+	}
+}
+
+func (o *ListOfferItemResult) Highlights(offerItemID OfferItemID) []*SearchHighlight {
+	return o.highlights[offerItemID]
+}
+
 // GetIDs オファー案件の ID の一覧を取得する
 func (oil OfferItemList) GetIDs() OfferItemIDList {
 	list := make(OfferItemIDList, 0, len(oil))
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// OfferItemArchiveFormatVersion 現在のアーカイブの形式のバージョン
+// 形式を変更する場合はバージョンを上げ、過去のバージョンのアーカイブも再取り込みできるようにする
+const OfferItemArchiveFormatVersion = 1
+
+// OfferItemArchive 完了したオファー案件の集約をオブジェクトストレージに退避したもの
+// 退避したオファー案件は参照のみ可能で、更新する場合は再取り込みする必要がある
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=OfferItemArchive
+type OfferItemArchive struct {
+	offerItemID OfferItemID
+	// アーカイブの形式のバージョン
+	formatVersion int
+	// アーカイブを保存したオブジェクトのキー
+	objectKey ObjectKey
+	// 退避した操作者。バッチ処理による退避の場合は空文字
+	archivedBy string
+	archivedAt time.Time
+	// 退避したオファー案件
+	offerItem *OfferItem
+	// 退避したアンケート。アンケートが設定されていない場合は nil
+	questionnaire *Questionnaire
+}
+
+func NewOfferItemArchiveFromRepository(
+	offerItemID OfferItemID,
+	formatVersion int,
+	objectKey ObjectKey,
+	archivedBy string,
+	archivedAt time.Time,
+	offerItem *OfferItem,
+	questionnaire *Questionnaire,
+) *OfferItemArchive {
+	return &OfferItemArchive{
+		offerItemID:   offerItemID,
+		formatVersion: formatVersion,
+		objectKey:     objectKey,
+		archivedBy:    archivedBy,
+		archivedAt:    archivedAt,
+		offerItem:     offerItem,
+		questionnaire: questionnaire,
+	}
+}
+
+// NewOfferItemArchiveObjectKey アーカイブを保存するオブジェクトのキーを生成する
+func NewOfferItemArchiveObjectKey(offerItemID OfferItemID, formatVersion int) ObjectKey {
+	return ObjectKey(fmt.Sprintf("%s/%s/v%d.ndjson", ObjectKeyPrefixOfferItemArchive, offerItemID, formatVersion))
+}
+
+// MarkArchived アーカイブに退避するため、完了したオファー案件をアーカイブの状態にする
+// 状態が変わった場合は true を返す。完了またはアーカイブ以外の状態の場合はエラーとする
+func (o *OfferItem) MarkArchived() (bool, error) {
+	if o.status == OfferItemStatusArchived {
+		return false, nil
+	}
+	if err := o.ChangeStatus(OfferItemStatusArchived); err != nil {
+		return false, fmt.Errorf("o.ChangeStatus: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// OfferItemRevision オファー案件の更新ごとの変更履歴
+// 変更された項目に加え、更新後のオファー案件とアンケートを保持し、任意の時点の条件を復元できるようにする
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=OfferItemRevision
+type OfferItemRevision struct {
+	offerItemID OfferItemID
+	// 更新後のオファー案件のバージョン
+	version int
+	// 変更された項目
+	changes []FieldChange
+	// 更新した操作者。バッチ処理による更新の場合は空文字
+	changedBy string
+	// 更新日時
+	changedAt time.Time
+	// 更新後のオファー案件
+	offerItem *OfferItem
+	// 更新後のアンケート。アンケートが設定されていない場合は nil
+	questionnaire *Questionnaire
+}
+
+// NewOfferItemRevision 更新前後のオファー案件とアンケートを比較して変更履歴を生成する
+// 新規作成の場合は before に nil を指定し、未設定の状態からの変更として記録する
+func NewOfferItemRevision(
+	before *OfferItem,
+	beforeQuestionnaire *Questionnaire,
+	after *OfferItem,
+	afterQuestionnaire *Questionnaire,
+	version int,
+	changedBy string,
+	changedAt time.Time,
+) *OfferItemRevision {
+	if before == nil {
+		before = &OfferItem{}
+	}
+	changes := before.Diff(after)
+	changes = append(changes, DiffQuestionnaire(beforeQuestionnaire, afterQuestionnaire)...)
+	return &OfferItemRevision{
+		offerItemID:   after.id,
+		version:       version,
+		changes:       changes,
+		changedBy:     changedBy,
+		changedAt:     changedAt,
+		offerItem:     after,
+		questionnaire: afterQuestionnaire,
+	}
+}
+
+func NewOfferItemRevisionFromRepository(
+	offerItemID OfferItemID,
+	version int,
+	changes []FieldChange,
+	changedBy string,
+	changedAt time.Time,
+	offerItem *OfferItem,
+	questionnaire *Questionnaire,
+) *OfferItemRevision {
+	return &OfferItemRevision{
+		offerItemID:   offerItemID,
+		version:       version,
+		changes:       changes,
+		changedBy:     changedBy,
+		changedAt:     changedAt,
+		offerItem:     offerItem,
+		questionnaire: questionnaire,
+	}
+}
+
+// HasChanges 変更された項目があるかどうか
+func (r *OfferItemRevision) HasChanges() bool {
+	return len(r.changes) > 0
+}
+
+// DiffQuestionnaire 更新前後のアンケートを比較し、値が異なる項目を返す
+// 質問は questionnaire.question.<質問ID> の項目名で定義を比較する
+func DiffQuestionnaire(before, after *Questionnaire) []FieldChange {
+	var changes []FieldChange
+	description := func(q *Questionnaire) string {
+		if q == nil {
+			return ""
+		}
+		return q.description
+	}
+	if b, a := description(before), description(after); b != a {
+		changes = append(changes, FieldChange{field: "questionnaire.description", setter: "SetDescription", before: b, after: a})
+	}
+
+	beforeQuestions, afterQuestions := questionStrings(before), questionStrings(after)
+	ids := make([]QuestionID, 0, len(beforeQuestions)+len(afterQuestions))
+	for id := range beforeQuestions {
+		ids = append(ids, id)
+	}
+	for id := range afterQuestions {
+		if _, ok := beforeQuestions[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		if beforeQuestions[id] != afterQuestions[id] {
+			changes = append(changes, FieldChange{field: fmt.Sprintf("questionnaire.question.%s", id), setter: "SetQuestions", before: beforeQuestions[id], after: afterQuestions[id]})
+		}
+	}
+	return changes
+}
+
+func questionStrings(q *Questionnaire) map[QuestionID]string {
+	if q == nil {
+		return map[QuestionID]string{}
+	}
+	float := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return fmt.Sprint(*v)
+	}
+	res := make(map[QuestionID]string, len(q.questions))
+	for _, question := range q.questions {
+		var condition string
+		if question.displayCondition != nil {
+			condition = fmt.Sprintf("%s=%s", question.displayCondition.questionID, question.displayCondition.option)
+		}
+		res[question.id] = fmt.Sprintf("%d|%s|%s|%s|%t|%s|%s|%s",
+			question.questionType, question.title, question.imageURL, strings.Join(question.options, ","),
+			question.required, float(question.minValue), float(question.maxValue), condition)
+	}
+	return res
+}
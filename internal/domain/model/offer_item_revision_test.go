@@ -0,0 +1,110 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOfferItemRevision(t *testing.T) {
+	changedAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	start := changedAt.AddDate(0, 0, 1)
+	end := changedAt.AddDate(0, 0, 7)
+	current := &OfferItem{
+		id:      "offer_item_id",
+		name:    "name",
+		version: 2,
+		status:  OfferItemStatusRecruiting,
+		schedules: ScheduleList{
+			{scheduleType: ScheduleTypeInvitation, startDate: &start, endDate: &start},
+		},
+	}
+	questionnaire := &Questionnaire{
+		offerItemID: "offer_item_id",
+		description: "description",
+		questions: []Question{
+			{id: "q1", questionType: QuestionTypeCheckbox, title: "title", options: []string{"a", "b"}},
+		},
+	}
+	changedQuestionnaire := &Questionnaire{
+		offerItemID: "offer_item_id",
+		description: "description",
+		questions: []Question{
+			{id: "q1", questionType: QuestionTypeCheckbox, title: "changed", options: []string{"a", "b"}},
+			{id: "q2", questionType: QuestionTypeText, title: "title"},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		before              *OfferItem
+		beforeQuestionnaire *Questionnaire
+		modify              func(o *OfferItem)
+		afterQuestionnaire  *Questionnaire
+		wantFields          []string
+		wantSetters         []string
+	}{
+		{
+			name:                "オファー案件とスケジュールの変更",
+			before:              current,
+			beforeQuestionnaire: questionnaire,
+			modify: func(o *OfferItem) {
+				o.name = "changed"
+				o.schedules[0].endDate = &end
+			},
+			afterQuestionnaire: questionnaire,
+			wantFields:         []string{"name", "schedule.1"},
+			wantSetters:        []string{"SetName", "SetSchedules"},
+		},
+		{
+			name:                "アンケートの変更",
+			before:              current,
+			beforeQuestionnaire: questionnaire,
+			modify:              func(o *OfferItem) {},
+			afterQuestionnaire:  changedQuestionnaire,
+			wantFields:          []string{"questionnaire.question.q1", "questionnaire.question.q2"},
+			wantSetters:         []string{"SetQuestions", "SetQuestions"},
+		},
+		{
+			name:                "アンケートの削除",
+			before:              current,
+			beforeQuestionnaire: questionnaire,
+			modify:              func(o *OfferItem) {},
+			wantFields:          []string{"questionnaire.description", "questionnaire.question.q1"},
+			wantSetters:         []string{"SetDescription", "SetQuestions"},
+		},
+		{
+			name:                "変更がない場合",
+			before:              current,
+			beforeQuestionnaire: questionnaire,
+			modify:              func(o *OfferItem) {},
+			afterQuestionnaire:  questionnaire,
+		},
+		{
+			name:        "新規作成は未設定の状態からの変更とする",
+			modify:      func(o *OfferItem) {},
+			wantFields:  []string{"name", "status", "schedule.1"},
+			wantSetters: []string{"SetName", "ChangeStatus", "SetSchedules"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after := current.Clone()
+			tt.modify(after)
+			r := NewOfferItemRevision(tt.before, tt.beforeQuestionnaire, after, tt.afterQuestionnaire, 3, "operator", changedAt)
+
+			var fields, setters []string
+			for _, c := range r.Changes() {
+				fields = append(fields, c.Field())
+				setters = append(setters, c.Setter())
+			}
+			assert.Equal(t, tt.wantFields, fields)
+			assert.Equal(t, tt.wantSetters, setters)
+			assert.Equal(t, len(tt.wantFields) > 0, r.HasChanges())
+			assert.Equal(t, OfferItemID("offer_item_id"), r.OfferItemID())
+			assert.Equal(t, "operator", r.ChangedBy())
+			assert.Same(t, after, r.OfferItem())
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+)
+
+// OfferItemStatus オファー案件のライフサイクル状態
+// 下書き・完了・アーカイブは明示的に設定し、それ以外はスケジュールとアサイニーのステージから導出する
+type OfferItemStatus int
+
+const (
+	OfferItemStatusUnknown    OfferItemStatus = iota // 不明
+	OfferItemStatusDraft                             // 下書き
+	OfferItemStatusScheduled                         // 募集開始前
+	OfferItemStatusRecruiting                        // 参加募集中
+	OfferItemStatusRunning                           // 実施中
+	OfferItemStatusReviewing                         // 審査中
+	OfferItemStatusPaying                            // 支払い中
+	OfferItemStatusCompleted                         // 完了
+	OfferItemStatusArchived                          // アーカイブ
+)
+
+func OfferItemStatusValues() []OfferItemStatus {
+	return []OfferItemStatus{
+		OfferItemStatusDraft,
+		OfferItemStatusScheduled,
+		OfferItemStatusRecruiting,
+		OfferItemStatusRunning,
+		OfferItemStatusReviewing,
+		OfferItemStatusPaying,
+		OfferItemStatusCompleted,
+		OfferItemStatusArchived,
+	}
+}
+
+var offerItemStatusLabels = map[OfferItemStatus]string{
+	OfferItemStatusDraft:      "下書き",
+	OfferItemStatusScheduled:  "募集開始前",
+	OfferItemStatusRecruiting: "参加募集中",
+	OfferItemStatusRunning:    "実施中",
+	OfferItemStatusReviewing:  "審査中",
+	OfferItemStatusPaying:     "支払い中",
+	OfferItemStatusCompleted:  "完了",
+	OfferItemStatusArchived:   "アーカイブ",
+}
+
+// 状態ごとに遷移可能な状態
+// 導出される状態同士はスケジュールの変更で前後することがあるため、相互に遷移可能とする
+// 完了は終了を取り消した場合に導出される状態へ戻る
+var offerItemStatusTransitions = map[OfferItemStatus][]OfferItemStatus{
+	OfferItemStatusDraft:      derivedOfferItemStatuses(),
+	OfferItemStatusScheduled:  append(derivedOfferItemStatuses(), OfferItemStatusCompleted),
+	OfferItemStatusRecruiting: append(derivedOfferItemStatuses(), OfferItemStatusCompleted),
+	OfferItemStatusRunning:    append(derivedOfferItemStatuses(), OfferItemStatusCompleted),
+	OfferItemStatusReviewing:  append(derivedOfferItemStatuses(), OfferItemStatusCompleted),
+	OfferItemStatusPaying:     append(derivedOfferItemStatuses(), OfferItemStatusCompleted),
+	OfferItemStatusCompleted:  append(derivedOfferItemStatuses(), OfferItemStatusArchived),
+	OfferItemStatusArchived:   {},
+}
+
+func derivedOfferItemStatuses() []OfferItemStatus {
+	return []OfferItemStatus{
+		OfferItemStatusScheduled,
+		OfferItemStatusRecruiting,
+		OfferItemStatusRunning,
+		OfferItemStatusReviewing,
+		OfferItemStatusPaying,
+	}
+}
+
+func (s OfferItemStatus) Int() int {
+	return int(s)
+}
+
+// Label 状態の表示名を返す
+func (s OfferItemStatus) Label() string {
+	if l, ok := offerItemStatusLabels[s]; ok {
+		return l
+	}
+	return "不明"
+}
+
+func (s OfferItemStatus) String() string {
+	return s.Label()
+}
+
+func (s OfferItemStatus) IsValid() bool {
+	_, ok := offerItemStatusLabels[s]
+	return ok
+}
+
+// IsDerived スケジュールとアサイニーのステージから導出される状態かどうか
+func (s OfferItemStatus) IsDerived() bool {
+	return s >= OfferItemStatusScheduled && s <= OfferItemStatusPaying
+}
+
+// IsClosed 案件が終了している状態かどうか
+func (s OfferItemStatus) IsClosed() bool {
+	return s == OfferItemStatusCompleted || s == OfferItemStatusArchived
+}
+
+// CanTransitionTo 指定した状態へ遷移可能かどうか
+func (s OfferItemStatus) CanTransitionTo(next OfferItemStatus) bool {
+	for _, v := range offerItemStatusTransitions[s] {
+		if v == next {
+			return true
+		}
+	}
+	return false
+}
+
+// DeriveOfferItemStatus スケジュールとアサイニーのステージごとの人数から、現在の状態を導出する
+// 参加募集期間までは参加募集スケジュールで判定し、それ以降は最も手前のステージにいるアサイニーで判定する
+// 進行中のアサイニーがいない場合は記事投稿・審査スケジュールで判定する
+func DeriveOfferItemStatus(schedules ScheduleList, assigneeCounts []AssigneeCount, now time.Time) OfferItemStatus {
+	if invitation, ok := schedules.GetByScheduleType(ScheduleTypeInvitation); ok {
+		if invitation.startDate != nil && now.Before(*invitation.startDate) {
+			return OfferItemStatusScheduled
+		}
+		if invitation.endDate != nil && !now.After(*invitation.endDate) {
+			return OfferItemStatusRecruiting
+		}
+	}
+
+	counts := make(map[OfferItemStatus]int)
+	for _, c := range assigneeCounts {
+		switch c.stage {
+		case StageInvitation, StageLottery, StageShipment, StageDraftSubmission, StagePreExamination, StagePreReexamination, StageArticlePosting:
+			counts[OfferItemStatusRunning] += c.count
+		case StageExamination, StageReexamination:
+			counts[OfferItemStatusReviewing] += c.count
+		case StagePaying:
+			counts[OfferItemStatusPaying] += c.count
+		}
+	}
+	for _, s := range []OfferItemStatus{OfferItemStatusRunning, OfferItemStatusReviewing, OfferItemStatusPaying} {
+		if counts[s] > 0 {
+			return s
+		}
+	}
+
+	if articlePosting, ok := schedules.GetByScheduleType(ScheduleTypeArticlePosting); ok {
+		if articlePosting.endDate != nil && !now.After(*articlePosting.endDate) {
+			return OfferItemStatusRunning
+		}
+	}
+	if examination, ok := schedules.GetByScheduleType(ScheduleTypeExamination); ok {
+		if examination.endDate != nil && !now.After(*examination.endDate) {
+			return OfferItemStatusReviewing
+		}
+	}
+	return OfferItemStatusPaying
+}
+
+// ChangeStatus 状態を明示的に変更する
+// 導出される状態は明示的に設定できないため、RefreshStatus を使用する
+func (o *OfferItem) ChangeStatus(next OfferItemStatus) error {
+	if next.IsDerived() {
+		return apperr.OfferItemValidationError.Wrap(fmt.Errorf("status %s is derived from schedules and assignee stages", next))
+	}
+	return o.transitionTo(next)
+}
+
+// Publish 下書きの案件を公開し、スケジュールとアサイニーのステージから導出した状態にする
+// 公開に必要な項目を全て検証し、エラーがある場合はまとめて返す
+func (o *OfferItem) Publish(assigneeCounts []AssigneeCount, now time.Time) error {
+	if o.status != OfferItemStatusDraft {
+		return apperr.OfferItemValidationError.Wrap(errors.New("only draft offer item can be published"))
+	}
+	if errs := o.Validate(); len(errs) > 0 {
+		return apperr.OfferItemValidationError.Wrap(errs)
+	}
+	return o.transitionTo(DeriveOfferItemStatus(o.schedules, assigneeCounts, now))
+}
+
+// RefreshStatus 導出される状態の場合、スケジュールとアサイニーのステージから状態を更新する
+// 明示的に設定された状態(下書き・完了・アーカイブ)は変更しない。状態が変わった場合は true を返す
+func (o *OfferItem) RefreshStatus(assigneeCounts []AssigneeCount, now time.Time) (bool, error) {
+	if !o.status.IsDerived() {
+		return false, nil
+	}
+	next := DeriveOfferItemStatus(o.schedules, assigneeCounts, now)
+	if next == o.status {
+		return false, nil
+	}
+	if err := o.transitionTo(next); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Reopen 完了した案件の終了を取り消し、スケジュールとアサイニーのステージから導出した状態に戻す
+// アーカイブした案件は取り消せない
+func (o *OfferItem) Reopen(assigneeCounts []AssigneeCount, now time.Time) error {
+	if o.status != OfferItemStatusCompleted {
+		return apperr.OfferItemValidationError.Wrap(fmt.Errorf("only completed offer item can be reopened: %s", o.status))
+	}
+	return o.transitionTo(DeriveOfferItemStatus(o.schedules, assigneeCounts, now))
+}
+
+// IsClosed 案件が終了しているかどうか
+func (o *OfferItem) IsClosed() bool {
+	return o.status.IsClosed()
+}
+
+func (o *OfferItem) transitionTo(next OfferItemStatus) error {
+	if !o.status.CanTransitionTo(next) {
+		return apperr.OfferItemValidationError.Wrap(fmt.Errorf("cannot transition status from %s to %s", o.status, next))
+	}
+	o.status = next
+	return nil
+}
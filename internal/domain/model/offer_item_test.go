@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
 	"github.com/volatiletech/null/v8"
 )
 
@@ -38,7 +39,7 @@ func TestOfferItem_SetCoupon(t *testing.T) {
 		isArticlePostMailSent             bool
 		isPassedAfterReviewMailSent       bool
 		isFailedAfterReviewMailSent       bool
-		isClosed                          bool
+		status                            OfferItemStatus
 		createdAt                         time.Time
 		schedules                         ScheduleList
 	}
@@ -145,7 +146,7 @@ func TestOfferItem_SetCoupon(t *testing.T) {
 				isArticlePostMailSent:             tt.fields.isArticlePostMailSent,
 				isPassedAfterReviewMailSent:       tt.fields.isPassedAfterReviewMailSent,
 				isFailedAfterReviewMailSent:       tt.fields.isFailedAfterReviewMailSent,
-				isClosed:                          tt.fields.isClosed,
+				status:                            tt.fields.status,
 				createdAt:                         tt.fields.createdAt,
 				schedules:                         tt.fields.schedules,
 			}
@@ -157,3 +158,407 @@ func TestOfferItem_SetCoupon(t *testing.T) {
 		})
 	}
 }
+
+func TestDeriveOfferItemStatus(t *testing.T) {
+	date := func(day int) *time.Time {
+		d := time.Date(2024, 6, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	schedules := ScheduleList{
+		{scheduleType: ScheduleTypeInvitation, startDate: date(1), endDate: date(7)},
+		{scheduleType: ScheduleTypeArticlePosting, startDate: date(10), endDate: date(20)},
+		{scheduleType: ScheduleTypeExamination, startDate: date(21), endDate: date(25)},
+		{scheduleType: ScheduleTypePayment, endDate: date(30)},
+	}
+	tests := []struct {
+		name           string
+		assigneeCounts []AssigneeCount
+		now            time.Time
+		want           OfferItemStatus
+	}{
+		{
+			name: "参加募集開始前",
+			now:  date(1).Add(-time.Second),
+			want: OfferItemStatusScheduled,
+		},
+		{
+			name: "参加募集期間中はアサイニーのステージに関わらず参加募集中",
+			assigneeCounts: []AssigneeCount{
+				{stage: StageExamination, count: 1},
+			},
+			now:  *date(7),
+			want: OfferItemStatusRecruiting,
+		},
+		{
+			name: "最も手前のステージのアサイニーで判定する",
+			assigneeCounts: []AssigneeCount{
+				{stage: StageArticlePosting, count: 1},
+				{stage: StageExamination, count: 2},
+				{stage: StagePaying, count: 3},
+			},
+			now:  *date(21),
+			want: OfferItemStatusRunning,
+		},
+		{
+			name: "記事審査中のアサイニーがいれば審査中",
+			assigneeCounts: []AssigneeCount{
+				{stage: StageReexamination, count: 1},
+				{stage: StagePaying, count: 3},
+			},
+			now:  *date(21),
+			want: OfferItemStatusReviewing,
+		},
+		{
+			name: "支払い中のアサイニーのみであれば支払い中",
+			assigneeCounts: []AssigneeCount{
+				{stage: StagePaying, count: 3},
+				{stage: StageDone, count: 1},
+			},
+			now:  *date(15),
+			want: OfferItemStatusPaying,
+		},
+		{
+			name: "進行中のアサイニーがいない場合は記事投稿スケジュールで判定する",
+			assigneeCounts: []AssigneeCount{
+				{stage: StageLotteryLost, count: 1},
+			},
+			now:  *date(15),
+			want: OfferItemStatusRunning,
+		},
+		{
+			name: "進行中のアサイニーがいない場合は審査スケジュールで判定する",
+			now:  *date(25),
+			want: OfferItemStatusReviewing,
+		},
+		{
+			name: "全てのスケジュールが終了している場合は支払い中",
+			now:  *date(26),
+			want: OfferItemStatusPaying,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DeriveOfferItemStatus(schedules, tt.assigneeCounts, tt.now))
+		})
+	}
+}
+
+func TestOfferItem_ChangeStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  OfferItemStatus
+		next    OfferItemStatus
+		wantErr bool
+	}{
+		{
+			name:   "正常系。実施中から完了",
+			status: OfferItemStatusRunning,
+			next:   OfferItemStatusCompleted,
+		},
+		{
+			name:   "正常系。完了からアーカイブ",
+			status: OfferItemStatusCompleted,
+			next:   OfferItemStatusArchived,
+		},
+		{
+			name:    "異常系。導出される状態は明示的に設定できない",
+			status:  OfferItemStatusRunning,
+			next:    OfferItemStatusPaying,
+			wantErr: true,
+		},
+		{
+			name:    "異常系。下書きから完了",
+			status:  OfferItemStatusDraft,
+			next:    OfferItemStatusCompleted,
+			wantErr: true,
+		},
+		{
+			name:    "異常系。完了済み",
+			status:  OfferItemStatusCompleted,
+			next:    OfferItemStatusCompleted,
+			wantErr: true,
+		},
+		{
+			name:    "異常系。アーカイブから完了",
+			status:  OfferItemStatusArchived,
+			next:    OfferItemStatusCompleted,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &OfferItem{status: tt.status}
+			err := o.ChangeStatus(tt.next)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.status, o.status)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.next, o.status)
+		})
+	}
+}
+
+func TestOfferItem_RefreshStatus(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := now.AddDate(0, 0, 7)
+	schedules := ScheduleList{
+		{scheduleType: ScheduleTypeInvitation, startDate: &now, endDate: &end},
+	}
+	tests := []struct {
+		name        string
+		status      OfferItemStatus
+		want        OfferItemStatus
+		wantChanged bool
+	}{
+		{
+			name:        "導出される状態は更新する",
+			status:      OfferItemStatusScheduled,
+			want:        OfferItemStatusRecruiting,
+			wantChanged: true,
+		},
+		{
+			name:   "状態が変わらない場合",
+			status: OfferItemStatusRecruiting,
+			want:   OfferItemStatusRecruiting,
+		},
+		{
+			name:   "下書きは更新しない",
+			status: OfferItemStatusDraft,
+			want:   OfferItemStatusDraft,
+		},
+		{
+			name:   "完了は更新しない",
+			status: OfferItemStatusCompleted,
+			want:   OfferItemStatusCompleted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &OfferItem{status: tt.status, schedules: schedules}
+			changed, err := o.RefreshStatus(nil, now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantChanged, changed)
+			assert.Equal(t, tt.want, o.status)
+		})
+	}
+}
+
+func TestOfferItem_Reopen(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := now.AddDate(0, 0, 7)
+	schedules := ScheduleList{
+		{scheduleType: ScheduleTypeInvitation, startDate: &now, endDate: &end},
+	}
+	tests := []struct {
+		name    string
+		status  OfferItemStatus
+		want    OfferItemStatus
+		wantErr bool
+	}{
+		{
+			name:   "正常系。完了から導出される状態に戻す",
+			status: OfferItemStatusCompleted,
+			want:   OfferItemStatusRecruiting,
+		},
+		{
+			name:    "異常系。アーカイブは取り消せない",
+			status:  OfferItemStatusArchived,
+			want:    OfferItemStatusArchived,
+			wantErr: true,
+		},
+		{
+			name:    "異常系。終了していない",
+			status:  OfferItemStatusRunning,
+			want:    OfferItemStatusRunning,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &OfferItem{status: tt.status, schedules: schedules}
+			err := o.Reopen(nil, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, o.status)
+		})
+	}
+}
+
+func TestOfferItem_Validate(t *testing.T) {
+	date := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	validSchedules := ScheduleList{
+		{scheduleType: ScheduleTypeInvitation, startDate: &date, endDate: &date},
+		{scheduleType: ScheduleTypeArticlePosting, startDate: &date, endDate: &date},
+		{scheduleType: ScheduleTypePayment, endDate: &date},
+	}
+	validOfferItem := func() *OfferItem {
+		return &OfferItem{
+			name:             "name",
+			item:             &Item{id: "item_id", minCommissionRate: &Commission{commissionType: CommissionTypeFixedRate, calculatedRate: 2}},
+			productFeatures:  "productFeatures",
+			cautionaryPoints: "cautionaryPoints",
+			referenceInfo:    "referenceInfo",
+			otherInfo:        "otherInfo",
+			schedules:        validSchedules,
+			status:           OfferItemStatusDraft,
+			draftedItemInfo:  &ItemInfo{},
+			pickInfo:         &PickInfo{itemID: "item_id"},
+		}
+	}
+	tests := []struct {
+		name     string
+		modify   func(o *OfferItem)
+		wantMsgs []string
+	}{
+		{
+			name:   "正常系。全ての項目が揃っている",
+			modify: func(o *OfferItem) {},
+		},
+		{
+			name: "異常系。全てのエラーをまとめて返す",
+			modify: func(o *OfferItem) {
+				o.productFeatures = ""
+				o.otherInfo = ""
+				o.item = nil
+				o.draftedItemInfo = nil
+				o.pickInfo = nil
+				o.schedules = validSchedules[:1]
+			},
+			wantMsgs: []string{
+				"productFeatures is required",
+				"otherInfo is required",
+				"item is required",
+				"draftedItemInfo is required",
+				"pickInfo is required",
+				"mustScheduleType is required",
+			},
+		},
+		{
+			name: "異常系。定率の案件に特単金額",
+			modify: func(o *OfferItem) {
+				o.hasSpecialCommission = true
+				o.specialAmount = 100
+			},
+			wantMsgs: []string{
+				"specialAmount cannot be set when commissionType is CommissionTypeFixedRate",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := validOfferItem()
+			tt.modify(o)
+			errs := o.Validate()
+			if len(tt.wantMsgs) == 0 {
+				assert.Empty(t, errs)
+				return
+			}
+			assert.Equal(t, tt.wantMsgs, errs.Messages())
+		})
+	}
+}
+
+func TestNewDraftOfferItem(t *testing.T) {
+	t.Run("正常系。名前のみで作成でき、必須項目を未入力のまま設定できる", func(t *testing.T) {
+		o, err := NewDraftOfferItem("offer_item_id", "name")
+		assert.NoError(t, err)
+		assert.True(t, o.IsDraft())
+		assert.NoError(t, o.SetProductFeatures(""))
+		assert.NoError(t, o.SetSchedules(ScheduleList{{scheduleType: ScheduleTypeInvitation}}))
+
+		// 公開時は全ての項目を検証する
+		err = o.Publish(nil, time.Now())
+		assert.Error(t, err)
+		assert.True(t, o.IsDraft())
+	})
+	t.Run("異常系。名前が未入力", func(t *testing.T) {
+		_, err := NewDraftOfferItem("offer_item_id", "")
+		assert.Error(t, err)
+	})
+	t.Run("異常系。公開済みの案件は必須項目を空にできない", func(t *testing.T) {
+		o := &OfferItem{status: OfferItemStatusRunning}
+		assert.Error(t, o.SetProductFeatures(""))
+		assert.Error(t, o.SetSchedules(ScheduleList{{scheduleType: ScheduleTypeInvitation}}))
+	})
+}
+
+func TestOfferItem_CheckVersion(t *testing.T) {
+	current := &OfferItem{
+		id:      "offer_item_id",
+		name:    "name",
+		version: 4,
+	}
+	revisions := []*OfferItemRevision{
+		{version: 1, changes: []FieldChange{{field: "name"}, {field: "productFeatures"}}},
+		{version: 4, changes: []FieldChange{{field: "name"}}},
+		{version: 2, changes: []FieldChange{{field: "schedule.1"}}},
+		{version: 3, changes: []FieldChange{{field: "name"}, {field: "questionnaire.description"}}},
+	}
+
+	tests := []struct {
+		name            string
+		expectedVersion int
+		revisions       []*OfferItemRevision
+		wantFields      []string
+	}{
+		{
+			name:            "正常系。バージョンが一致する",
+			expectedVersion: 4,
+			revisions:       revisions,
+		},
+		{
+			name:            "異常系。指定したバージョンより後の変更履歴で変更された項目をバージョン順に返す",
+			expectedVersion: 1,
+			revisions:       revisions,
+			wantFields:      []string{"schedule.1", "name", "questionnaire.description"},
+		},
+		{
+			name:            "異常系。直前のバージョンを指定した場合は最新の変更履歴の項目のみ返す",
+			expectedVersion: 3,
+			revisions:       revisions,
+			wantFields:      []string{"name"},
+		},
+		{
+			name:            "異常系。変更履歴がない場合は項目を含めない",
+			expectedVersion: 2,
+			wantFields:      []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := current.CheckVersion(tt.expectedVersion, tt.revisions)
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, apperr.OfferItemVersionConflictError)
+			var conflict *VersionConflict
+			assert.ErrorAs(t, err, &conflict)
+			assert.Equal(t, tt.wantFields, conflict.ChangedFields())
+		})
+	}
+}
+
+func TestOfferItem_Clone(t *testing.T) {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 7)
+	current := &OfferItem{
+		id:   "offer_item_id",
+		name: "name",
+		schedules: ScheduleList{
+			{scheduleType: ScheduleTypeInvitation, startDate: &start, endDate: &start},
+		},
+	}
+	cloned := current.Clone()
+	cloned.name = "changed"
+	cloned.schedules[0].endDate = &end
+
+	// 複製先での変更は元のオファー案件に影響しない
+	assert.Equal(t, "name", current.name)
+	assert.Equal(t, start, *current.schedules[0].endDate)
+}
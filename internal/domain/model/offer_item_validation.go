@@ -0,0 +1,144 @@
+package model
+
+import (
+	"errors"
+	"strings"
+)
+
+// ValidationErrors 複数のバリデーションエラー
+// 最初のエラーで打ち切らずに、入力の問題をまとめて返すために使用する
+type ValidationErrors []error
+
+func (e ValidationErrors) Error() string {
+	return strings.Join(e.Messages(), "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	return e
+}
+
+// Messages エラーメッセージの一覧を返す
+func (e ValidationErrors) Messages() []string {
+	res := make([]string, 0, len(e))
+	for _, err := range e {
+		res = append(res, err.Error())
+	}
+	return res
+}
+
+// IsDraft 下書きかどうか
+// 下書きの間は名前以外の必須項目を未入力のまま保存でき、公開時に Validate で全ての項目を検証する
+func (o *OfferItem) IsDraft() bool {
+	return o.status == OfferItemStatusDraft
+}
+
+// Validate 公開に必要な項目を全て検証し、見つかった全てのエラーを返す
+func (o *OfferItem) Validate() ValidationErrors {
+	var errs ValidationErrors
+	if o.name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	if o.productFeatures == "" {
+		errs = append(errs, errors.New("productFeatures is required"))
+	}
+	if o.cautionaryPoints == "" {
+		errs = append(errs, errors.New("cautionaryPoints is required"))
+	}
+	if o.referenceInfo == "" {
+		errs = append(errs, errors.New("referenceInfo is required"))
+	}
+	if o.otherInfo == "" {
+		errs = append(errs, errors.New("otherInfo is required"))
+	}
+
+	var commissionType CommissionType
+	if o.item == nil {
+		errs = append(errs, errors.New("item is required"))
+	} else if o.item.minCommissionRate != nil {
+		commissionType = o.item.minCommissionRate.commissionType
+	}
+	// 一覧・詳細の表示に使用するため、公開時には設定されている必要がある
+	if o.draftedItemInfo == nil {
+		errs = append(errs, errors.New("draftedItemInfo is required"))
+	}
+	if o.pickInfo == nil {
+		errs = append(errs, errors.New("pickInfo is required"))
+	}
+	if err := validateSpecialCommission(o.hasSpecialCommission, o.specialRate, o.specialAmount, commissionType); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateSchedules(o.schedules); err != nil {
+		errs = append(errs, err)
+	}
+	if !o.status.IsValid() {
+		errs = append(errs, errors.New("status is invalid"))
+	}
+	return errs
+}
+
+func validateSpecialCommission(hasSpecialCommission bool, specialRate float64, specialAmount int, commissionType CommissionType) error {
+	if specialRate < 0 {
+		return errors.New("specialRate must be greater than 0")
+	}
+	if specialAmount < 0 {
+		return errors.New("specialAmount must be greater than 0")
+	}
+
+	if !hasSpecialCommission {
+		// 特単の有無がfalseの場合、特単料率と特単金額は設定不可
+		if specialRate > 0 || specialAmount > 0 {
+			return errors.New("specialRate and specialAmount cannot be set when hasSpecialCommission is false")
+		}
+		return nil
+	}
+
+	// 特単の有無がtrueの場合、特単料率または特単金額のどちらかが必須
+	if specialRate <= 0 && specialAmount <= 0 {
+		return errors.New("specialRate or specialAmount is required")
+	}
+	// 特単料率と特単金額は片方のみ設定可能
+	if specialRate > 0 && specialAmount > 0 {
+		return errors.New("specialRate and specialAmount cannot be set at the same time")
+	}
+	// 特単料率が設定されている場合、報酬タイプが定率であることを確認
+	if commissionType == CommissionTypeFixedRate && specialAmount > 0 {
+		return errors.New("specialAmount cannot be set when commissionType is CommissionTypeFixedRate")
+	}
+	// 特単金額が設定されている場合、報酬タイプが定額であることを確認
+	if commissionType == CommissionTypeFixedAmount && specialRate > 0 {
+		return errors.New("specialRate cannot be set when commissionType is CommissionTypeFixedAmount")
+	}
+	return nil
+}
+
+// validateSchedules スケジュールタイプの重複がなく、必須のスケジュールが揃っていることを検証する
+func validateSchedules(schedules ScheduleList) error {
+	scheduleMap, err := scheduleMapByType(schedules)
+	if err != nil {
+		return err
+	}
+	// 「参加募集」「記事投稿」「支払い」は必須の為設定されているか確認する
+	for _, mustScheduleType := range MustScheduleTypeValues() {
+		if scheduleMap[mustScheduleType] == nil {
+			return errors.New("mustScheduleType is required")
+		}
+	}
+
+	// スケジュールタイプが不正な値の場合はエラー
+	if len(scheduleMap) > len(ScheduleTypeValues()) {
+		return errors.New("scheduleType is invalid")
+	}
+	return nil
+}
+
+func scheduleMapByType(schedules ScheduleList) (map[ScheduleType]*Schedule, error) {
+	scheduleMap := make(map[ScheduleType]*Schedule, len(schedules))
+	for _, s := range schedules {
+		if _, ok := scheduleMap[s.scheduleType]; ok {
+			// スケジュールタイプでユニークになる
+			return nil, errors.New("scheduleType is duplicated")
+		}
+		scheduleMap[s.scheduleType] = s
+	}
+	return scheduleMap, nil
+}
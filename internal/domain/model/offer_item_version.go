@@ -0,0 +1,208 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+)
+
+// FieldChange 項目ごとの変更内容
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=FieldChange
+type FieldChange struct {
+	// 項目名
+	field string
+	// 項目を変更したメソッド
+	setter string
+	// 変更前の値
+	before string
+	// 変更後の値
+	after string
+}
+
+func NewFieldChangeFromRepository(field, setter, before, after string) FieldChange {
+	return FieldChange{
+		field:  field,
+		setter: setter,
+		before: before,
+		after:  after,
+	}
+}
+
+// オファー案件の比較対象の項目と、その項目を変更するメソッド、値を文字列で取り出す関数
+var offerItemDiffFields = []struct {
+	field  string
+	setter string
+	value  func(o *OfferItem) string
+}{
+	{"name", "SetName", func(o *OfferItem) string { return o.name }},
+	{"itemID", "SetItem", func(o *OfferItem) string {
+		if o.item == nil {
+			return ""
+		}
+		return o.item.id.String()
+	}},
+	{"dfItemID", "SetDFItem", func(o *OfferItem) string {
+		if o.dfItem == nil {
+			return ""
+		}
+		return o.dfItem.id.String()
+	}},
+	{"couponBannerID", "SetCoupon", func(o *OfferItem) string {
+		if o.couponBannerID == nil {
+			return ""
+		}
+		return o.couponBannerID.String()
+	}},
+	{"specialRate", "SetSpecialCommission", func(o *OfferItem) string { return fmt.Sprint(o.specialRate) }},
+	{"specialAmount", "SetSpecialCommission", func(o *OfferItem) string { return fmt.Sprint(o.specialAmount) }},
+	{"hasSample", "SetHasSample", func(o *OfferItem) string { return fmt.Sprint(o.hasSample) }},
+	{"needsPreliminaryReview", "SetNeedsPreliminaryReview", func(o *OfferItem) string { return fmt.Sprint(o.needsPreliminaryReview) }},
+	{"needsAfterReview", "SetNeedsAfterReview", func(o *OfferItem) string { return fmt.Sprint(o.needsAfterReview) }},
+	{"requiresSecondApproval", "SetRequiresSecondApproval", func(o *OfferItem) string { return fmt.Sprint(o.requiresSecondApproval) }},
+	{"needsPRMark", "SetNeedsPRMark", func(o *OfferItem) string { return fmt.Sprint(o.needsPRMark) }},
+	{"postRequired", "SetPostRequired", func(o *OfferItem) string { return fmt.Sprint(o.postRequired) }},
+	{"hasLottery", "SetHasLottery", func(o *OfferItem) string { return fmt.Sprint(o.hasLottery) }},
+	{"postTarget", "SetPostTarget", func(o *OfferItem) string { return fmt.Sprint(int(o.postTarget)) }},
+	{"hasCoupon", "SetCoupon", func(o *OfferItem) string { return fmt.Sprint(o.hasCoupon) }},
+	{"hasSpecialCommission", "SetSpecialCommission", func(o *OfferItem) string { return fmt.Sprint(o.hasSpecialCommission) }},
+	{"productFeatures", "SetProductFeatures", func(o *OfferItem) string { return o.productFeatures }},
+	{"cautionaryPoints", "SetCautionaryPoints", func(o *OfferItem) string { return o.cautionaryPoints }},
+	{"referenceInfo", "SetReferenceInfo", func(o *OfferItem) string { return o.referenceInfo }},
+	{"otherInfo", "SetOtherInfo", func(o *OfferItem) string { return o.otherInfo }},
+	{"isInvitationMailSent", "SetIsInvitationMailSent", func(o *OfferItem) string { return fmt.Sprint(o.isInvitationMailSent) }},
+	{"isOfferDetailMailSent", "SetIsOfferDetailMailSent", func(o *OfferItem) string { return fmt.Sprint(o.isOfferDetailMailSent) }},
+	{"isPassedPreliminaryReviewMailSent", "SetIsPassedPreliminaryReviewMailSent", func(o *OfferItem) string { return fmt.Sprint(o.isPassedPreliminaryReviewMailSent) }},
+	{"isFailedPreliminaryReviewMailSent", "SetIsFailedPreliminaryReviewMailSent", func(o *OfferItem) string { return fmt.Sprint(o.isFailedPreliminaryReviewMailSent) }},
+	{"isArticlePostMailSent", "SetIsArticlePostMailSent", func(o *OfferItem) string { return fmt.Sprint(o.isArticlePostMailSent) }},
+	{"isPassedAfterReviewMailSent", "SetIsPassedAfterReviewMailSent", func(o *OfferItem) string { return fmt.Sprint(o.isPassedAfterReviewMailSent) }},
+	{"isFailedAfterReviewMailSent", "SetIsFailedAfterReviewMailSent", func(o *OfferItem) string { return fmt.Sprint(o.isFailedAfterReviewMailSent) }},
+	{"status", "ChangeStatus", func(o *OfferItem) string { return fmt.Sprint(o.status.Int()) }},
+	{"draftedItemInfo", "SetDraftedItemInfo", func(o *OfferItem) string {
+		i := o.draftedItemInfo
+		if i == nil {
+			return ""
+		}
+		return fmt.Sprintf("%s|%s|%s|%s|%s|%s", i.name, i.contentName, i.imageURL, i.url, commissionString(i.minCommission), commissionString(i.maxCommission))
+	}},
+}
+
+func commissionString(c *Commission) string {
+	if c == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d:%v", c.commissionType, c.calculatedRate)
+}
+
+// Diff 変更後のオファー案件と比較し、値が異なる項目を返す
+// スケジュールは schedule.<スケジュールタイプ> の項目名で開始日・終了日を比較する
+func (o *OfferItem) Diff(after *OfferItem) []FieldChange {
+	var changes []FieldChange
+	for _, f := range offerItemDiffFields {
+		if b, a := f.value(o), f.value(after); b != a {
+			changes = append(changes, FieldChange{field: f.field, setter: f.setter, before: b, after: a})
+		}
+	}
+
+	before, afterSchedules := scheduleStrings(o.schedules), scheduleStrings(after.schedules)
+	types := make([]ScheduleType, 0, len(before)+len(afterSchedules))
+	for t := range before {
+		types = append(types, t)
+	}
+	for t := range afterSchedules {
+		if _, ok := before[t]; !ok {
+			types = append(types, t)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	for _, t := range types {
+		if before[t] != afterSchedules[t] {
+			changes = append(changes, FieldChange{field: fmt.Sprintf("schedule.%d", t.Int()), setter: "SetSchedules", before: before[t], after: afterSchedules[t]})
+		}
+	}
+	return changes
+}
+
+func scheduleStrings(schedules ScheduleList) map[ScheduleType]string {
+	format := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	res := make(map[ScheduleType]string, len(schedules))
+	for _, s := range schedules {
+		res[s.scheduleType] = format(s.startDate) + "/" + format(s.endDate)
+	}
+	return res
+}
+
+// Clone 更新内容の比較のため、オファー案件を複製する
+// スケジュールとDF案件は値を複製し、複製先での変更が元のオファー案件に影響しないようにする
+func (o *OfferItem) Clone() *OfferItem {
+	c := *o
+	if o.dfItem != nil {
+		dfItem := *o.dfItem
+		c.dfItem = &dfItem
+	}
+	c.schedules = make(ScheduleList, 0, len(o.schedules))
+	for _, s := range o.schedules {
+		copied := *s
+		c.schedules = append(c.schedules, &copied)
+	}
+	return &c
+}
+
+// VersionConflict オファー案件が他の操作で更新されていたことを表す
+type VersionConflict struct {
+	offerItemID     OfferItemID
+	expectedVersion int
+	currentVersion  int
+	// 更新の元にしたバージョンより後の更新で変更された項目
+	changedFields []string
+}
+
+func (c *VersionConflict) Error() string {
+	return fmt.Sprintf("offer item %s was updated: version %d is expected but current version is %d. changed fields: [%s]",
+		c.offerItemID, c.expectedVersion, c.currentVersion, strings.Join(c.changedFields, ", "))
+}
+
+func (c *VersionConflict) ChangedFields() []string {
+	return c.changedFields
+}
+
+// CheckVersion 更新の元にしたバージョンが現在のバージョンと一致するか確認する
+// 一致しない場合は、revisions のうち expectedVersion より後、現在のバージョン以前の変更履歴で変更された項目を含む競合エラーを返す
+func (o *OfferItem) CheckVersion(expectedVersion int, revisions []*OfferItemRevision) error {
+	if expectedVersion == o.version {
+		return nil
+	}
+	conflict := &VersionConflict{
+		offerItemID:     o.id,
+		expectedVersion: expectedVersion,
+		currentVersion:  o.version,
+		changedFields:   []string{},
+	}
+	sorted := make([]*OfferItemRevision, len(revisions))
+	copy(sorted, revisions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].version < sorted[j].version
+	})
+	seen := make(map[string]struct{})
+	for _, r := range sorted {
+		if r.version <= expectedVersion || r.version > o.version {
+			continue
+		}
+		for _, c := range r.changes {
+			if _, ok := seen[c.field]; ok {
+				continue
+			}
+			seen[c.field] = struct{}{}
+			conflict.changedFields = append(conflict.changedFields, c.field)
+		}
+	}
+	return apperr.OfferItemVersionConflictError.Wrap(conflict)
+}
@@ -33,6 +33,9 @@ func (o *OfferItem) NeedsPreliminaryReview() bool {
 func (o *OfferItem) NeedsAfterReview() bool {
 	return o.needsAfterReview
 }
+func (o *OfferItem) RequiresSecondApproval() bool {
+	return o.requiresSecondApproval
+}
 func (o *OfferItem) NeedsPRMark() bool {
 	return o.needsPRMark
 }
@@ -84,8 +87,11 @@ func (o *OfferItem) IsPassedAfterReviewMailSent() bool {
 func (o *OfferItem) IsFailedAfterReviewMailSent() bool {
 	return o.isFailedAfterReviewMailSent
 }
-func (o *OfferItem) IsClosed() bool {
-	return o.isClosed
+func (o *OfferItem) Status() OfferItemStatus {
+	return o.status
+}
+func (o *OfferItem) Version() int {
+	return o.version
 }
 func (o *OfferItem) CreatedAt() time.Time {
 	return o.createdAt
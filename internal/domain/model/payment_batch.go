@@ -0,0 +1,177 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"github.com/terui-ryota/offer-item/pkg/id"
+)
+
+type PaymentBatchID string
+
+func (p PaymentBatchID) String() string {
+	return string(p)
+}
+
+type PaymentItemID string
+
+func (p PaymentItemID) String() string {
+	return string(p)
+}
+
+// 支払いバッチステータス
+type PaymentBatchStatus int
+
+func (s PaymentBatchStatus) Int() int {
+	return int(s)
+}
+
+const (
+	PaymentBatchStatusUnknown   PaymentBatchStatus = iota // 不明
+	PaymentBatchStatusCreated                             // 作成済み(未確定)
+	PaymentBatchStatusConfirmed                           // 確定(支払い完了)
+)
+
+// 支払いバッチ
+// 作成時点の「支払い中」のアサイニーと支払い金額をスナップショットとして保持する
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=PaymentBatch
+type PaymentBatch struct {
+	// 支払いバッチID
+	id PaymentBatchID
+	// ステータス
+	status PaymentBatchStatus
+	// 支払い明細
+	items []PaymentItem
+	// 支払い総額
+	totalAmount int
+	// 確定日時
+	confirmedAt *time.Time
+	// 作成日時
+	createdAt time.Time
+}
+
+// 支払い明細
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=PaymentItem
+type PaymentItem struct {
+	// 支払い明細ID
+	id PaymentItemID
+	// 依頼案件ID
+	offerItemID OfferItemID
+	// アサイニーID
+	assigneeID AssigneeID
+	// アメーバID
+	amebaID AmebaID
+	// 執筆報酬
+	writingFee int
+	// 特単金額
+	specialAmount int
+	// 支払い金額(執筆報酬 + 特単金額)
+	amount int
+}
+
+// 支払い金額に含める特単金額を返す
+func paymentSpecialAmount(offerItem *OfferItem) int {
+	if offerItem.hasSpecialCommission {
+		return offerItem.specialAmount
+	}
+	return 0
+}
+
+// NewPaymentBatch 「支払い中」のアサイニーから支払いバッチを作成する
+// 特単は金額で設定されている場合のみ支払い金額に含める(料率の特単はアフィリエイト報酬として支払われる)
+func NewPaymentBatch(assignees AssigneeList, offerItems map[OfferItemID]*OfferItem, now time.Time) (*PaymentBatch, error) {
+	if len(assignees) == 0 {
+		return nil, apperr.OfferItemValidationError.Wrap(errors.New("assignees to pay are required"))
+	}
+	items := make([]PaymentItem, 0, len(assignees))
+	var totalAmount int
+	for _, a := range assignees {
+		if a.stage != StagePaying {
+			return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("stage must be StagePaying. AssigneeID: %s", a.id))
+		}
+		offerItem, ok := offerItems[a.offerItemID]
+		if !ok {
+			return nil, fmt.Errorf("offer item is not found. OfferItemID: %s", a.offerItemID)
+		}
+		specialAmount := paymentSpecialAmount(offerItem)
+		item := PaymentItem{
+			id:            PaymentItemID(id.New()),
+			offerItemID:   a.offerItemID,
+			assigneeID:    a.id,
+			amebaID:       a.amebaID,
+			writingFee:    a.writingFee,
+			specialAmount: specialAmount,
+			amount:        a.writingFee + specialAmount,
+		}
+		items = append(items, item)
+		totalAmount += item.amount
+	}
+	return &PaymentBatch{
+		id:          PaymentBatchID(id.New()),
+		status:      PaymentBatchStatusCreated,
+		items:       items,
+		totalAmount: totalAmount,
+		createdAt:   now,
+	}, nil
+}
+
+func NewPaymentBatchFromRepository(
+	id PaymentBatchID,
+	status PaymentBatchStatus,
+	items []PaymentItem,
+	totalAmount int,
+	confirmedAt *time.Time,
+	createdAt time.Time,
+) *PaymentBatch {
+	return &PaymentBatch{
+		id:          id,
+		status:      status,
+		items:       items,
+		totalAmount: totalAmount,
+		confirmedAt: confirmedAt,
+		createdAt:   createdAt,
+	}
+}
+
+func NewPaymentItemFromRepository(
+	id PaymentItemID,
+	offerItemID OfferItemID,
+	assigneeID AssigneeID,
+	amebaID AmebaID,
+	writingFee int,
+	specialAmount int,
+	amount int,
+) PaymentItem {
+	return PaymentItem{
+		id:            id,
+		offerItemID:   offerItemID,
+		assigneeID:    assigneeID,
+		amebaID:       amebaID,
+		writingFee:    writingFee,
+		specialAmount: specialAmount,
+		amount:        amount,
+	}
+}
+
+// Confirm 支払いバッチを確定する
+func (p *PaymentBatch) Confirm(now time.Time) error {
+	if p.status != PaymentBatchStatusCreated {
+		return apperr.OfferItemValidationError.Wrap(errors.New("payment batch is already confirmed"))
+	}
+	p.status = PaymentBatchStatusConfirmed
+	p.confirmedAt = &now
+	return nil
+}
+
+// AssigneeIDs 支払い対象のアサイニーIDを返す
+func (p *PaymentBatch) AssigneeIDs() []AssigneeID {
+	res := make([]AssigneeID, 0, len(p.items))
+	for _, item := range p.items {
+		res = append(res, item.assigneeID)
+	}
+	return res
+}
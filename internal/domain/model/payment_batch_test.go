@@ -0,0 +1,103 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPaymentBatch(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	offerItems := map[OfferItemID]*OfferItem{
+		"offer_item_1": {id: "offer_item_1"},
+		"offer_item_2": {id: "offer_item_2", hasSpecialCommission: true, specialAmount: 500},
+	}
+	tests := []struct {
+		name            string
+		assignees       AssigneeList
+		wantAmounts     []int
+		wantTotalAmount int
+		wantErr         bool
+	}{
+		{
+			name: "正常系。 特単金額がある場合は支払い金額に含める",
+			assignees: AssigneeList{
+				{id: "assignee_1", offerItemID: "offer_item_1", amebaID: "ameba_1", writingFee: 3000, stage: StagePaying},
+				{id: "assignee_2", offerItemID: "offer_item_2", amebaID: "ameba_2", writingFee: 2000, stage: StagePaying},
+			},
+			wantAmounts:     []int{3000, 2500},
+			wantTotalAmount: 5500,
+		},
+		{
+			name:      "異常系。 アサイニーが空",
+			assignees: AssigneeList{},
+			wantErr:   true,
+		},
+		{
+			name: "異常系。 支払い中ではないアサイニーが含まれている",
+			assignees: AssigneeList{
+				{id: "assignee_1", offerItemID: "offer_item_1", amebaID: "ameba_1", writingFee: 3000, stage: StagePaymentCompleted},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 オファー案件が存在しない",
+			assignees: AssigneeList{
+				{id: "assignee_1", offerItemID: "offer_item_3", amebaID: "ameba_1", writingFee: 3000, stage: StagePaying},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPaymentBatch(tt.assignees, offerItems, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, PaymentBatchStatusCreated, got.Status())
+			assert.Equal(t, now, got.CreatedAt())
+			assert.Equal(t, tt.wantTotalAmount, got.TotalAmount())
+			amounts := make([]int, 0, len(got.Items()))
+			for _, item := range got.Items() {
+				amounts = append(amounts, item.Amount())
+			}
+			assert.Equal(t, tt.wantAmounts, amounts)
+			assert.Equal(t, tt.assignees.IDs(), got.AssigneeIDs())
+		})
+	}
+}
+
+func TestPaymentBatch_Confirm(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		status  PaymentBatchStatus
+		wantErr bool
+	}{
+		{
+			name:   "正常系",
+			status: PaymentBatchStatusCreated,
+		},
+		{
+			name:    "異常系。 確定済み",
+			status:  PaymentBatchStatusConfirmed,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &PaymentBatch{status: tt.status}
+			err := p.Confirm(now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, PaymentBatchStatusConfirmed, p.Status())
+			assert.Equal(t, &now, p.ConfirmedAt())
+		})
+	}
+}
@@ -19,3 +19,18 @@ func (q *Question) ImageURL() string {
 func (q *Question) Options() []string {
 	return q.options
 }
+func (q *Question) Required() bool {
+	return q.required
+}
+func (q *Question) MinValue() *float64 {
+	return q.minValue
+}
+func (q *Question) MaxValue() *float64 {
+	return q.maxValue
+}
+func (q *Question) DisplayCondition() *DisplayCondition {
+	return q.displayCondition
+}
+func (q *Question) Version() int {
+	return q.version
+}
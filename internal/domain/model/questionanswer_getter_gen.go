@@ -13,3 +13,9 @@ func (q *QuestionAnswer) OfferItemID() OfferItemID {
 func (q *QuestionAnswer) Content() string {
 	return q.content
 }
+func (q *QuestionAnswer) SelectedOptions() []string {
+	return q.selectedOptions
+}
+func (q *QuestionAnswer) QuestionVersion() int {
+	return q.questionVersion
+}
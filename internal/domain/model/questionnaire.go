@@ -1,7 +1,12 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/terui-ryota/offer-item/pkg/id"
 )
@@ -11,6 +16,8 @@ type Questionnaire struct {
 	offerItemID OfferItemID
 	description string
 	questions   []Question
+	// 回答済みの質問を変更・削除した際に残す変更前の質問(過去バージョン)
+	questionHistories []Question
 }
 
 func (q *Questionnaire) SetDescription(v string) error {
@@ -19,6 +26,9 @@ func (q *Questionnaire) SetDescription(v string) error {
 }
 
 func (q *Questionnaire) SetQuestions(v []Question) error {
+	if err := validateDisplayConditions(v); err != nil {
+		return fmt.Errorf("validateDisplayConditions: %w", err)
+	}
 	q.questions = v
 	return nil
 }
@@ -45,12 +55,113 @@ func NewQuestionnaireFromRepository(
 	offerItemID OfferItemID,
 	description string,
 	questions []Question,
+	questionHistories []Question,
 ) *Questionnaire {
 	return &Questionnaire{
-		offerItemID: offerItemID,
-		description: description,
-		questions:   questions,
+		offerItemID:       offerItemID,
+		description:       description,
+		questions:         questions,
+		questionHistories: questionHistories,
+	}
+}
+
+// ReviseQuestions 質問を更新する。回答済みの質問の定義が変更された場合はバージョンを上げ、
+// 変更前の質問を過去バージョンとして残す。回答済みの質問が削除された場合も同様に残す
+func (q *Questionnaire) ReviseQuestions(v []Question, answeredQuestionIDs map[QuestionID]struct{}) error {
+	current := make(map[QuestionID]Question, len(q.questions))
+	for _, question := range q.questions {
+		current[question.id] = question
+	}
+	histories := make([]Question, 0)
+	revised := make(map[QuestionID]struct{}, len(v))
+	for i := range v {
+		revised[v[i].id] = struct{}{}
+		before, ok := current[v[i].id]
+		if !ok {
+			continue
+		}
+		if _, ok := answeredQuestionIDs[before.id]; !ok || v[i].hasSameDefinition(before) {
+			continue
+		}
+		histories = append(histories, before)
+		v[i].version = before.version + 1
+	}
+	for _, before := range q.questions {
+		if _, ok := revised[before.id]; ok {
+			continue
+		}
+		if _, ok := answeredQuestionIDs[before.id]; ok {
+			histories = append(histories, before)
+		}
+	}
+	if err := q.SetQuestions(v); err != nil {
+		return fmt.Errorf("q.SetQuestions: %w", err)
 	}
+	q.questionHistories = append(q.questionHistories, histories...)
+	return nil
+}
+
+// QuestionVersions 回答の集計・出力の対象となる全バージョンの質問
+// 現在の質問を表示順に並べ、各質問の後ろに過去バージョンを新しい順に並べる。削除された質問の過去バージョンは最後に並べる
+func (q *Questionnaire) QuestionVersions() []Question {
+	historiesMap := make(map[QuestionID][]Question, len(q.questionHistories))
+	deletedIDs := make([]QuestionID, 0)
+	current := make(map[QuestionID]struct{}, len(q.questions))
+	for _, question := range q.questions {
+		current[question.id] = struct{}{}
+	}
+	for _, h := range q.questionHistories {
+		if _, ok := historiesMap[h.id]; !ok {
+			if _, ok := current[h.id]; !ok {
+				deletedIDs = append(deletedIDs, h.id)
+			}
+		}
+		historiesMap[h.id] = append(historiesMap[h.id], h)
+	}
+
+	res := make([]Question, 0, len(q.questions)+len(q.questionHistories))
+	appendHistories := func(id QuestionID) {
+		histories := historiesMap[id]
+		sort.SliceStable(histories, func(i, j int) bool {
+			return histories[i].version > histories[j].version
+		})
+		res = append(res, histories...)
+	}
+	for _, question := range q.questions {
+		res = append(res, question)
+		appendHistories(question.id)
+	}
+	for _, id := range deletedIDs {
+		appendHistories(id)
+	}
+	return res
+}
+
+// ImageURLs 全バージョンの質問に保存されている画像(オブジェクトキー・移行前の画像データ・外部のURL)
+func (q *Questionnaire) ImageURLs() map[string]struct{} {
+	res := make(map[string]struct{})
+	for _, question := range q.QuestionVersions() {
+		if question.imageURL != "" {
+			res[question.imageURL] = struct{}{}
+		}
+	}
+	return res
+}
+
+// ResolveImageURLs 質問画像のオブジェクトキーを resolve で取得したURLに置き換える
+// 移行前の画像データや外部のURLはそのまま返す
+func (q *Questionnaire) ResolveImageURLs(resolve func(key ObjectKey) (string, error)) error {
+	for i := range q.questions {
+		if !LegacyImageColumnQuestionnaireQuestionImage.IsObjectKey(q.questions[i].imageURL) {
+			continue
+		}
+		u, err := resolve(ObjectKey(q.questions[i].imageURL))
+		if err != nil {
+			return fmt.Errorf("resolve: %w", err)
+		}
+		q.questions[i].imageURL = u
+	}
+	return nil
 }
 
 func validateQuestions(questions []Question) error {
@@ -67,17 +178,70 @@ func validateQuestions(questions []Question) error {
 	if len(m) != len(questions) {
 		return fmt.Errorf("question title must be unique in questions: %+v", questions)
 	}
+	if err := validateDisplayConditions(questions); err != nil {
+		return fmt.Errorf("validateDisplayConditions: %w", err)
+	}
+	return nil
+}
+
+// validateDisplayConditions 表示条件の参照先が単一選択の質問・選択肢であること、参照が循環していないことを確認する
+func validateDisplayConditions(questions []Question) error {
+	questionMap := make(map[QuestionID]Question, len(questions))
+	for _, q := range questions {
+		questionMap[q.id] = q
+	}
+	for _, q := range questions {
+		if q.displayCondition == nil {
+			continue
+		}
+		parent, ok := questionMap[q.displayCondition.questionID]
+		if !ok {
+			return fmt.Errorf("display condition refers to unknown question: %s", q.displayCondition.questionID)
+		}
+		if parent.questionType != QuestionTypeRadio {
+			return fmt.Errorf("display condition must refer to radio question: %s", parent.id)
+		}
+		if !contains(parent.options, q.displayCondition.option) {
+			return fmt.Errorf("display condition refers to unknown option: %s, %s", parent.id, q.displayCondition.option)
+		}
+	}
+	// 表示条件は各質問につき1つのため、参照を辿って同じ質問に戻るかどうかで循環を検出する
+	for _, q := range questions {
+		visited := map[QuestionID]struct{}{q.id: {}}
+		for current := q; current.displayCondition != nil; {
+			current = questionMap[current.displayCondition.questionID]
+			if _, ok := visited[current.id]; ok {
+				return fmt.Errorf("display conditions must not be cyclic: %s", q.id)
+			}
+			visited[current.id] = struct{}{}
+		}
+	}
 	return nil
 }
 
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
 type QuestionType int
 
 const (
-	QuestionTypeUnknown QuestionType = iota
-	QuestionTypeRadio
-	QuestionTypeText
+	QuestionTypeUnknown  QuestionType = iota
+	QuestionTypeRadio                 // 単一選択
+	QuestionTypeText                  // 自由記述
+	QuestionTypeCheckbox              // 複数選択
+	QuestionTypeNumber                // 数値
+	QuestionTypeDate                  // 日付
 )
 
+// 日付回答のフォーマット
+const QuestionAnswerDateLayout = "2006-01-02"
+
 type QuestionID string
 
 func NewQuestionType(i int) QuestionType {
@@ -86,6 +250,12 @@ func NewQuestionType(i int) QuestionType {
 		return QuestionTypeRadio
 	case int(QuestionTypeText):
 		return QuestionTypeText
+	case int(QuestionTypeCheckbox):
+		return QuestionTypeCheckbox
+	case int(QuestionTypeNumber):
+		return QuestionTypeNumber
+	case int(QuestionTypeDate):
+		return QuestionTypeDate
 	default:
 		return QuestionTypeUnknown
 	}
@@ -103,6 +273,37 @@ type Question struct {
 	title        string
 	imageURL     string
 	options      []string
+	// 回答必須かどうか
+	required bool
+	// 数値回答の最小値。数値以外の質問タイプでは nil
+	minValue *float64
+	// 数値回答の最大値。数値以外の質問タイプでは nil
+	maxValue *float64
+	// 表示条件。nil の場合は常に表示する
+	displayCondition *DisplayCondition
+	// 質問のバージョン。回答済みの質問の定義を変更するたびに 1 ずつ増える
+	version int
+}
+
+// DisplayCondition 質問の表示条件。参照先の単一選択の質問で option が選択された場合のみ表示する
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=DisplayCondition
+type DisplayCondition struct {
+	questionID QuestionID
+	option     string
+}
+
+func NewDisplayCondition(questionID QuestionID, option string) (*DisplayCondition, error) {
+	if questionID == "" {
+		return nil, fmt.Errorf("questionID must not be empty")
+	}
+	if option == "" {
+		return nil, fmt.Errorf("option must not be empty")
+	}
+	return &DisplayCondition{
+		questionID: questionID,
+		option:     option,
+	}, nil
 }
 
 func (q *Question) SetOptions(questionType QuestionType, options ...string) error {
@@ -124,12 +325,33 @@ func (q *Question) SetImageURL(v string) error {
 	return nil
 }
 
+func (q *Question) SetRequired(v bool) {
+	q.required = v
+}
+
+func (q *Question) SetDisplayCondition(v *DisplayCondition) {
+	q.displayCondition = v
+}
+
+// SetNumberRange 数値回答の範囲を設定する。SetOptions で質問タイプを設定した後に呼び出すこと
+func (q *Question) SetNumberRange(minValue, maxValue *float64) error {
+	if err := validateNumberRange(q.questionType, minValue, maxValue); err != nil {
+		return fmt.Errorf("validateNumberRange: %w", err)
+	}
+	q.minValue = minValue
+	q.maxValue = maxValue
+	return nil
+}
+
 func NewQuestion(
 	offerItemID OfferItemID,
 	questionType QuestionType,
 	title,
 	imageURL string,
 	options []string,
+	required bool,
+	minValue,
+	maxValue *float64,
 ) (*Question, error) {
 	if questionType == QuestionTypeUnknown {
 		return nil, fmt.Errorf("questionType must not be unknown")
@@ -144,6 +366,9 @@ func NewQuestion(
 	if err := validateOptions(questionType, options); err != nil {
 		return nil, fmt.Errorf("validateOptions: %w", err)
 	}
+	if err := validateNumberRange(questionType, minValue, maxValue); err != nil {
+		return nil, fmt.Errorf("validateNumberRange: %w", err)
+	}
 	return &Question{
 		id:           QuestionID(id.New()),
 		offerItemID:  offerItemID,
@@ -151,6 +376,10 @@ func NewQuestion(
 		title:        title,
 		imageURL:     imageURL,
 		options:      options,
+		required:     required,
+		minValue:     minValue,
+		maxValue:     maxValue,
+		version:      1,
 	}, nil
 }
 
@@ -161,20 +390,53 @@ func NewQuestionFromRepository(
 	title,
 	imageURL string,
 	options []string,
+	required bool,
+	minValue,
+	maxValue *float64,
+	displayCondition *DisplayCondition,
+	version int,
 ) *Question {
 	return &Question{
-		id:           id,
-		offerItemID:  offerItemID,
-		questionType: questionType,
-		title:        title,
-		imageURL:     imageURL,
-		options:      options,
+		id:               id,
+		offerItemID:      offerItemID,
+		questionType:     questionType,
+		title:            title,
+		imageURL:         imageURL,
+		options:          options,
+		required:         required,
+		minValue:         minValue,
+		maxValue:         maxValue,
+		displayCondition: displayCondition,
+		version:          version,
 	}
 }
 
+// hasSameDefinition 回答の解釈に影響する項目(質問タイプ・タイトル・選択肢・数値の範囲)が同じかどうか
+func (q *Question) hasSameDefinition(other Question) bool {
+	if q.questionType != other.questionType || q.title != other.title {
+		return false
+	}
+	if len(q.options) != len(other.options) {
+		return false
+	}
+	for i := range q.options {
+		if q.options[i] != other.options[i] {
+			return false
+		}
+	}
+	return equalFloat64Ptr(q.minValue, other.minValue) && equalFloat64Ptr(q.maxValue, other.maxValue)
+}
+
+func equalFloat64Ptr(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
 func validateOptions(t QuestionType, options []string) error {
 	switch t {
-	case QuestionTypeRadio:
+	case QuestionTypeRadio, QuestionTypeCheckbox:
 		if len(options) == 0 {
 			return fmt.Errorf("len of options must not be 0, type: %d", t)
 		}
@@ -190,7 +452,7 @@ func validateOptions(t QuestionType, options []string) error {
 		}
 
 		return nil
-	case QuestionTypeText:
+	case QuestionTypeText, QuestionTypeNumber, QuestionTypeDate:
 		if len(options) != 0 {
 			return fmt.Errorf("len of options must be 0, type: %d", t)
 		}
@@ -200,41 +462,114 @@ func validateOptions(t QuestionType, options []string) error {
 	}
 }
 
+func validateNumberRange(t QuestionType, minValue, maxValue *float64) error {
+	if t != QuestionTypeNumber {
+		if minValue != nil || maxValue != nil {
+			return fmt.Errorf("minValue and maxValue can be set only for number type, type: %d", t)
+		}
+		return nil
+	}
+	if minValue != nil && maxValue != nil && *minValue > *maxValue {
+		return fmt.Errorf("minValue must be less than or equal to maxValue: %v, %v", *minValue, *maxValue)
+	}
+	return nil
+}
+
 //go:generate go run github.com/terui-ryota/gen-getter -type=QuestionAnswer
 type QuestionAnswer struct {
 	assigneeID  AssigneeID
 	questionID  QuestionID
 	offerItemID OfferItemID
-	content     string
+	// 回答内容。複数選択の場合は空文字
+	content string
+	// 複数選択の回答。複数選択以外の場合は nil
+	selectedOptions []string
+	// 回答時の質問のバージョン
+	questionVersion int
 }
 
+// NewQuestionAnswers アンケートの回答を生成する
+// 複数選択の回答は選択肢の JSON 配列文字列(例: ["A","B"])で受け取る
+// 任意回答の質問は未回答(キーなし、または空文字)を許容し、回答を生成しない
+// 表示条件を満たさない質問は回答不要とし、回答が送られても保存しない
 func NewQuestionAnswers(
 	assigneeID AssigneeID,
 	questionnaire Questionnaire,
 	answers map[QuestionID]string,
 ) ([]QuestionAnswer, error) {
-	if len(questionnaire.questions) != len(answers) {
-		return nil, fmt.Errorf("len of questions and len of answers are not equal: %d %d", len(questionnaire.questions), len(answers))
+	questionIDs := make(map[QuestionID]struct{}, len(questionnaire.questions))
+	for _, q := range questionnaire.questions {
+		questionIDs[q.id] = struct{}{}
+	}
+	for questionID := range answers {
+		if _, ok := questionIDs[questionID]; !ok {
+			return nil, fmt.Errorf("unknown questionID: %s", questionID)
+		}
 	}
+
+	displayed := questionnaire.displayedQuestionIDs(answers)
 	res := make([]QuestionAnswer, 0, len(answers))
 	for _, q := range questionnaire.questions {
-		if answer, ok := answers[q.id]; !ok {
-			return nil, fmt.Errorf("questionID does not contain: %s", q.id)
-		} else {
-			q, err := newQuestionAnswer(
-				assigneeID,
-				q,
-				answer,
-			)
-			if err != nil {
-				return nil, fmt.Errorf("newQuestionAnswer: %w", err)
+		if _, ok := displayed[q.id]; !ok {
+			continue
+		}
+		answer, ok := answers[q.id]
+		if !ok || answer == "" {
+			if q.required {
+				return nil, fmt.Errorf("answer is required: %s", q.id)
 			}
-			res = append(res, *q)
+			continue
+		}
+		q, err := newQuestionAnswer(
+			assigneeID,
+			q,
+			answer,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("newQuestionAnswer: %w", err)
 		}
+		res = append(res, *q)
 	}
 	return res, nil
 }
 
+// displayedQuestionIDs 回答内容から表示条件を満たす質問のIDを返す
+// 参照先の質問が表示されていない場合は、その質問も表示されない
+func (q *Questionnaire) displayedQuestionIDs(answers map[QuestionID]string) map[QuestionID]struct{} {
+	questionMap := make(map[QuestionID]Question, len(q.questions))
+	for _, question := range q.questions {
+		questionMap[question.id] = question
+	}
+	memo := make(map[QuestionID]bool, len(q.questions))
+	var isDisplayed func(question Question, depth int) bool
+	isDisplayed = func(question Question, depth int) bool {
+		if v, ok := memo[question.id]; ok {
+			return v
+		}
+		var v bool
+		switch {
+		case question.displayCondition == nil:
+			v = true
+		case depth > len(q.questions):
+			// 循環している場合は表示しない(保存時に検証しているため通常は発生しない)
+			v = false
+		default:
+			parent, ok := questionMap[question.displayCondition.questionID]
+			v = ok && isDisplayed(parent, depth+1) && answers[parent.id] == question.displayCondition.option
+		}
+		memo[question.id] = v
+		return v
+	}
+
+	res := make(map[QuestionID]struct{}, len(q.questions))
+	for _, question := range q.questions {
+		if isDisplayed(question, 0) {
+			res[question.id] = struct{}{}
+		}
+	}
+	return res
+}
+
 func newQuestionAnswer(
 	assigneeID AssigneeID,
 	question Question,
@@ -247,6 +582,7 @@ func newQuestionAnswer(
 		}
 		return m
 	}()
+	var selectedOptions []string
 	switch question.questionType {
 	case QuestionTypeRadio:
 		if _, ok := m[content]; !ok {
@@ -254,14 +590,53 @@ func newQuestionAnswer(
 		}
 	case QuestionTypeText:
 		// noop
+	case QuestionTypeCheckbox:
+		if err := json.Unmarshal([]byte(content), &selectedOptions); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w, %v", err, content)
+		}
+		if question.required && len(selectedOptions) == 0 {
+			return nil, fmt.Errorf("at least one option must be selected: %+v", question)
+		}
+		selected := make(map[string]struct{}, len(selectedOptions))
+		for _, o := range selectedOptions {
+			if _, ok := m[o]; !ok {
+				return nil, fmt.Errorf("unknown option: %+v, %v", question, o)
+			}
+			if _, ok := selected[o]; ok {
+				return nil, fmt.Errorf("selected option must be unique: %+v", selectedOptions)
+			}
+			selected[o] = struct{}{}
+		}
+		content = ""
+	case QuestionTypeNumber:
+		v, err := strconv.ParseFloat(content, 64)
+		if err != nil {
+			return nil, fmt.Errorf("strconv.ParseFloat: %w", err)
+		}
+		// ParseFloat は NaN・Inf も解釈するが、範囲の比較ができないため許可しない
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, fmt.Errorf("answer must be a finite number: %s", content)
+		}
+		if question.minValue != nil && v < *question.minValue {
+			return nil, fmt.Errorf("answer must be greater than or equal to %v: %v", *question.minValue, v)
+		}
+		if question.maxValue != nil && v > *question.maxValue {
+			return nil, fmt.Errorf("answer must be less than or equal to %v: %v", *question.maxValue, v)
+		}
+	case QuestionTypeDate:
+		if _, err := time.Parse(QuestionAnswerDateLayout, content); err != nil {
+			return nil, fmt.Errorf("time.Parse: %w", err)
+		}
 	default:
 		return nil, fmt.Errorf("unknown type: %+v", question)
 	}
 	return &QuestionAnswer{
-		assigneeID:  assigneeID,
-		questionID:  question.id,
-		offerItemID: question.offerItemID,
-		content:     content,
+		assigneeID:      assigneeID,
+		questionID:      question.id,
+		offerItemID:     question.offerItemID,
+		content:         content,
+		selectedOptions: selectedOptions,
+		questionVersion: question.version,
 	}, nil
 }
 
@@ -270,11 +645,27 @@ func NewQuestionAnswerFromRepository(
 	offerItemID OfferItemID,
 	questionID string,
 	content string,
+	selectedOptions []string,
+	questionVersion int,
 ) *QuestionAnswer {
 	return &QuestionAnswer{
-		assigneeID:  assigneeID,
-		questionID:  QuestionID(questionID),
-		offerItemID: offerItemID,
-		content:     content,
+		assigneeID:      assigneeID,
+		questionID:      QuestionID(questionID),
+		offerItemID:     offerItemID,
+		content:         content,
+		selectedOptions: selectedOptions,
+		questionVersion: questionVersion,
+	}
+}
+
+// DisplayContent 回答内容を文字列で返す。複数選択の場合は選択肢の JSON 配列文字列を返す
+func (q *QuestionAnswer) DisplayContent() string {
+	if q.selectedOptions == nil {
+		return q.content
+	}
+	bs, err := json.Marshal(q.selectedOptions)
+	if err != nil {
+		return q.content
 	}
+	return string(bs)
 }
@@ -10,3 +10,6 @@ func (q *Questionnaire) Description() string {
 func (q *Questionnaire) Questions() []Question {
 	return q.questions
 }
+func (q *Questionnaire) QuestionHistories() []Question {
+	return q.questionHistories
+}
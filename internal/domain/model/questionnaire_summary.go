@@ -0,0 +1,150 @@
+package model
+
+// QuestionnaireSummary アンケート回答の集計結果
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=QuestionnaireSummary
+type QuestionnaireSummary struct {
+	offerItemID OfferItemID
+	// 回答者数
+	respondentCount int
+	// 質問のバージョンごとの集計結果(Questionnaire.QuestionVersions の順)
+	questionSummaries []QuestionSummary
+}
+
+// QuestionSummary 質問のバージョンごとの集計結果
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=QuestionSummary
+type QuestionSummary struct {
+	question Question
+	// 回答数
+	answerCount int
+	// 選択肢ごとの回答数(単一選択・複数選択のみ)
+	optionCounts []OptionCount
+	// 自由回答(自由記述・数値・日付のみ)。取得条件で指定された範囲のみ保持する
+	textAnswers []string
+	// 自由回答の総数
+	textAnswerTotalCount int
+}
+
+// OptionCount 選択肢ごとの回答数
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=OptionCount
+type OptionCount struct {
+	option string
+	count  int
+	// 質問の回答数に対する割合(%)。複数選択の場合は合計が100を超えることがある
+	percentage float64
+}
+
+// QuestionVersionKey 質問のバージョンを識別するキー
+type QuestionVersionKey struct {
+	questionID QuestionID
+	version    int
+}
+
+func NewQuestionVersionKey(questionID QuestionID, version int) QuestionVersionKey {
+	return QuestionVersionKey{questionID: questionID, version: version}
+}
+
+func (k QuestionVersionKey) QuestionID() QuestionID {
+	return k.questionID
+}
+
+func (k QuestionVersionKey) Version() int {
+	return k.version
+}
+
+// VersionKey 質問のバージョンを識別するキーを返す
+func (q *Question) VersionKey() QuestionVersionKey {
+	return NewQuestionVersionKey(q.id, q.version)
+}
+
+// IsChoice 選択肢から回答する質問(単一選択・複数選択)か
+func (q *Question) IsChoice() bool {
+	return q.questionType == QuestionTypeRadio || q.questionType == QuestionTypeCheckbox
+}
+
+// AnswerContentCount 選択式の質問の回答内容ごとの回答数
+// 単一選択は選択肢ごと、複数選択は選択した選択肢の組み合わせごとに DB で集計した値
+type AnswerContentCount struct {
+	key             QuestionVersionKey
+	content         string
+	selectedOptions []string
+	count           int
+}
+
+func NewAnswerContentCountFromRepository(questionID QuestionID, version int, content string, selectedOptions []string, count int) AnswerContentCount {
+	return AnswerContentCount{
+		key:             NewQuestionVersionKey(questionID, version),
+		content:         content,
+		selectedOptions: selectedOptions,
+		count:           count,
+	}
+}
+
+// NewQuestionnaireSummary DB で集計したアンケートの回答を質問のバージョンごとにまとめる
+// 質問の定義が変更されると選択肢や質問タイプが変わるため、回答は回答時のバージョンの質問に対してのみ集計する
+// answerCounts は質問のバージョンごとの回答数、textAnswers は自由回答の質問のバージョンごとに取得条件の範囲のみ取得した回答
+func NewQuestionnaireSummary(
+	questionnaire Questionnaire,
+	respondentCount int,
+	answerCounts map[QuestionVersionKey]int,
+	contentCounts []AnswerContentCount,
+	textAnswers map[QuestionVersionKey][]string,
+) *QuestionnaireSummary {
+	optionCounts := make(map[QuestionVersionKey]map[string]int)
+	for _, c := range contentCounts {
+		counts, ok := optionCounts[c.key]
+		if !ok {
+			counts = make(map[string]int)
+			optionCounts[c.key] = counts
+		}
+		if c.selectedOptions == nil {
+			counts[c.content] += c.count
+			continue
+		}
+		for _, o := range c.selectedOptions {
+			counts[o] += c.count
+		}
+	}
+
+	questions := questionnaire.QuestionVersions()
+	summaries := make([]QuestionSummary, 0, len(questions))
+	for _, q := range questions {
+		key := q.VersionKey()
+		summaries = append(summaries, newQuestionSummary(q, answerCounts[key], optionCounts[key], textAnswers[key]))
+	}
+	return &QuestionnaireSummary{
+		offerItemID:       questionnaire.offerItemID,
+		respondentCount:   respondentCount,
+		questionSummaries: summaries,
+	}
+}
+
+func newQuestionSummary(question Question, answerCount int, counts map[string]int, textAnswers []string) QuestionSummary {
+	res := QuestionSummary{
+		question:    question,
+		answerCount: answerCount,
+	}
+	if !question.IsChoice() {
+		if textAnswers == nil {
+			textAnswers = []string{}
+		}
+		res.textAnswers = textAnswers
+		res.textAnswerTotalCount = answerCount
+		return res
+	}
+	res.optionCounts = make([]OptionCount, 0, len(question.options))
+	for _, o := range question.options {
+		var percentage float64
+		if answerCount > 0 {
+			percentage = float64(counts[o]) / float64(answerCount) * 100
+		}
+		res.optionCounts = append(res.optionCounts, OptionCount{
+			option:     o,
+			count:      counts[o],
+			percentage: percentage,
+		})
+	}
+	return res
+}
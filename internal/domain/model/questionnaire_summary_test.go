@@ -0,0 +1,128 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuestionnaireSummary(t *testing.T) {
+	questionnaire := Questionnaire{
+		offerItemID: "offer_item_id",
+		description: "アンケート",
+		questions: []Question{
+			{id: "radio", offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: "単一選択", options: []string{"A", "B"}, required: true},
+			{id: "checkbox", offerItemID: "offer_item_id", questionType: QuestionTypeCheckbox, title: "複数選択", options: []string{"A", "B"}, required: false},
+			{id: "text", offerItemID: "offer_item_id", questionType: QuestionTypeText, title: "自由記述", required: false},
+		},
+	}
+	answerCounts := map[QuestionVersionKey]int{
+		NewQuestionVersionKey("radio", 0):    4,
+		NewQuestionVersionKey("checkbox", 0): 2,
+		NewQuestionVersionKey("text", 0):     3,
+	}
+	contentCounts := []AnswerContentCount{
+		NewAnswerContentCountFromRepository("radio", 0, "A", nil, 3),
+		NewAnswerContentCountFromRepository("radio", 0, "B", nil, 1),
+		NewAnswerContentCountFromRepository("checkbox", 0, "", []string{"A", "B"}, 1),
+		NewAnswerContentCountFromRepository("checkbox", 0, "", []string{"A"}, 1),
+	}
+	type want struct {
+		answerCount          int
+		optionCounts         []OptionCount
+		textAnswers          []string
+		textAnswerTotalCount int
+	}
+	tests := []struct {
+		name        string
+		textAnswers map[QuestionVersionKey][]string
+		want        []want
+	}{
+		{
+			name:        "正常系。 選択肢の組み合わせごとの回答数を選択肢ごとに集計する",
+			textAnswers: map[QuestionVersionKey][]string{NewQuestionVersionKey("text", 0): {"回答2"}},
+			want: []want{
+				{answerCount: 4, optionCounts: []OptionCount{{option: "A", count: 3, percentage: 75}, {option: "B", count: 1, percentage: 25}}},
+				{answerCount: 2, optionCounts: []OptionCount{{option: "A", count: 2, percentage: 100}, {option: "B", count: 1, percentage: 50}}},
+				{answerCount: 3, textAnswers: []string{"回答2"}, textAnswerTotalCount: 3},
+			},
+		},
+		{
+			name:        "正常系。 取得条件の範囲に自由回答が無い",
+			textAnswers: map[QuestionVersionKey][]string{},
+			want: []want{
+				{answerCount: 4, optionCounts: []OptionCount{{option: "A", count: 3, percentage: 75}, {option: "B", count: 1, percentage: 25}}},
+				{answerCount: 2, optionCounts: []OptionCount{{option: "A", count: 2, percentage: 100}, {option: "B", count: 1, percentage: 50}}},
+				{answerCount: 3, textAnswers: []string{}, textAnswerTotalCount: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewQuestionnaireSummary(questionnaire, 4, answerCounts, contentCounts, tt.textAnswers)
+			assert.Equal(t, 4, got.RespondentCount())
+			gotSummaries := make([]want, 0, len(got.QuestionSummaries()))
+			for _, s := range got.QuestionSummaries() {
+				gotSummaries = append(gotSummaries, want{
+					answerCount:          s.answerCount,
+					optionCounts:         s.optionCounts,
+					textAnswers:          s.textAnswers,
+					textAnswerTotalCount: s.textAnswerTotalCount,
+				})
+			}
+			assert.Equal(t, tt.want, gotSummaries)
+		})
+	}
+}
+
+func TestNewQuestionnaireSummary_QuestionVersions(t *testing.T) {
+	questionnaire := Questionnaire{
+		offerItemID: "offer_item_id",
+		description: "アンケート",
+		questions: []Question{
+			{id: "radio", offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: "単一選択", options: []string{"A", "B", "C"}, version: 2},
+		},
+		questionHistories: []Question{
+			{id: "deleted", offerItemID: "offer_item_id", questionType: QuestionTypeText, title: "削除した質問", version: 1},
+			{id: "radio", offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: "単一選択", options: []string{"X", "Y"}, version: 1},
+		},
+	}
+	answerCounts := map[QuestionVersionKey]int{
+		NewQuestionVersionKey("radio", 1):   1,
+		NewQuestionVersionKey("radio", 2):   1,
+		NewQuestionVersionKey("deleted", 1): 1,
+	}
+	contentCounts := []AnswerContentCount{
+		NewAnswerContentCountFromRepository("radio", 1, "X", nil, 1),
+		NewAnswerContentCountFromRepository("radio", 2, "C", nil, 1),
+	}
+	textAnswers := map[QuestionVersionKey][]string{
+		NewQuestionVersionKey("deleted", 1): {"回答1"},
+	}
+
+	got := NewQuestionnaireSummary(questionnaire, 2, answerCounts, contentCounts, textAnswers)
+	assert.Equal(t, 2, got.RespondentCount())
+
+	type want struct {
+		questionID   QuestionID
+		version      int
+		answerCount  int
+		optionCounts []OptionCount
+		textAnswers  []string
+	}
+	gotSummaries := make([]want, 0, len(got.QuestionSummaries()))
+	for _, s := range got.QuestionSummaries() {
+		gotSummaries = append(gotSummaries, want{
+			questionID:   s.question.id,
+			version:      s.question.version,
+			answerCount:  s.answerCount,
+			optionCounts: s.optionCounts,
+			textAnswers:  s.textAnswers,
+		})
+	}
+	assert.Equal(t, []want{
+		{questionID: "radio", version: 2, answerCount: 1, optionCounts: []OptionCount{{option: "A"}, {option: "B"}, {option: "C", count: 1, percentage: 100}}},
+		{questionID: "radio", version: 1, answerCount: 1, optionCounts: []OptionCount{{option: "X", count: 1, percentage: 100}, {option: "Y"}}},
+		{questionID: "deleted", version: 1, answerCount: 1, textAnswers: []string{"回答1"}},
+	}, gotSummaries)
+}
@@ -0,0 +1,533 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestNewQuestionAnswers(t *testing.T) {
+	questionnaire := Questionnaire{
+		offerItemID: "offer_item_id",
+		description: "アンケート",
+		questions: []Question{
+			{id: "radio", offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: "単一選択", options: []string{"A", "B"}, required: true},
+			{id: "checkbox", offerItemID: "offer_item_id", questionType: QuestionTypeCheckbox, title: "複数選択", options: []string{"A", "B", "C"}, required: true},
+			{id: "number", offerItemID: "offer_item_id", questionType: QuestionTypeNumber, title: "数値", required: true, minValue: null.Float64From(0).Ptr(), maxValue: null.Float64From(100).Ptr()},
+			{id: "date", offerItemID: "offer_item_id", questionType: QuestionTypeDate, title: "日付", required: true},
+			{id: "text", offerItemID: "offer_item_id", questionType: QuestionTypeText, title: "任意の自由記述", required: false},
+		},
+	}
+	type args struct {
+		answers map[QuestionID]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    map[QuestionID][]string
+		wantErr bool
+	}{
+		{
+			name: "正常系。 全ての質問に回答",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A","C"]`,
+					"number":   "12.5",
+					"date":     "2024-06-18",
+					"text":     "自由記述",
+				},
+			},
+			want: map[QuestionID][]string{
+				"radio":    {"A"},
+				"checkbox": {"A", "C"},
+				"number":   {"12.5"},
+				"date":     {"2024-06-18"},
+				"text":     {"自由記述"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "正常系。 任意回答の質問は未回答を許容する",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "B",
+					"checkbox": `["B"]`,
+					"number":   "0",
+					"date":     "2024-06-18",
+				},
+			},
+			want: map[QuestionID][]string{
+				"radio":    {"B"},
+				"checkbox": {"B"},
+				"number":   {"0"},
+				"date":     {"2024-06-18"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系。 必須回答の質問が未回答",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A"]`,
+					"number":   "1",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 存在しない質問への回答",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A"]`,
+					"number":   "1",
+					"date":     "2024-06-18",
+					"unknown":  "xxx",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 複数選択に存在しない選択肢",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A","D"]`,
+					"number":   "1",
+					"date":     "2024-06-18",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 複数選択で選択肢が重複",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A","A"]`,
+					"number":   "1",
+					"date":     "2024-06-18",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 必須の複数選択で1つも選択されていない",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `[]`,
+					"number":   "1",
+					"date":     "2024-06-18",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 数値が最大値を超えている",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A"]`,
+					"number":   "100.1",
+					"date":     "2024-06-18",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 数値が NaN。範囲の比較をすり抜ける",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A"]`,
+					"number":   "NaN",
+					"date":     "2024-06-18",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 数値ではない",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A"]`,
+					"number":   "abc",
+					"date":     "2024-06-18",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 日付のフォーマットが不正",
+			args: args{
+				answers: map[QuestionID]string{
+					"radio":    "A",
+					"checkbox": `["A"]`,
+					"number":   "1",
+					"date":     "2024/06/18",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewQuestionAnswers("assignee_id", questionnaire, tt.args.answers)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewQuestionAnswers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			gotMap := make(map[QuestionID][]string, len(got))
+			for _, a := range got {
+				if a.selectedOptions != nil {
+					gotMap[a.questionID] = a.selectedOptions
+				} else {
+					gotMap[a.questionID] = []string{a.content}
+				}
+			}
+			assert.Equal(t, tt.want, gotMap)
+		})
+	}
+}
+
+func TestNewQuestion(t *testing.T) {
+	type args struct {
+		questionType QuestionType
+		title        string
+		options      []string
+		minValue     *float64
+		maxValue     *float64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "正常系。 複数選択",
+			args: args{
+				questionType: QuestionTypeCheckbox,
+				title:        "複数選択",
+				options:      []string{"A", "B"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "正常系。 数値 x 範囲指定あり",
+			args: args{
+				questionType: QuestionTypeNumber,
+				title:        "数値",
+				minValue:     null.Float64From(1).Ptr(),
+				maxValue:     null.Float64From(10).Ptr(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "正常系。 日付",
+			args: args{
+				questionType: QuestionTypeDate,
+				title:        "日付",
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系。 複数選択 x 選択肢なし",
+			args: args{
+				questionType: QuestionTypeCheckbox,
+				title:        "複数選択",
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 数値 x 最小値が最大値より大きい",
+			args: args{
+				questionType: QuestionTypeNumber,
+				title:        "数値",
+				minValue:     null.Float64From(10).Ptr(),
+				maxValue:     null.Float64From(1).Ptr(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 数値以外 x 範囲指定あり",
+			args: args{
+				questionType: QuestionTypeText,
+				title:        "自由記述",
+				minValue:     null.Float64From(1).Ptr(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 日付 x 選択肢あり",
+			args: args{
+				questionType: QuestionTypeDate,
+				title:        "日付",
+				options:      []string{"A"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewQuestion("offer_item_id", tt.args.questionType, tt.args.title, "", tt.args.options, true, tt.args.minValue, tt.args.maxValue); (err != nil) != tt.wantErr {
+				t.Errorf("NewQuestion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewQuestionAnswers_DisplayCondition(t *testing.T) {
+	questionnaire := Questionnaire{
+		offerItemID: "offer_item_id",
+		description: "アンケート",
+		questions: []Question{
+			{id: "parent", offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: "親", options: []string{"はい", "いいえ"}, required: true},
+			{id: "child", offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: "子", options: []string{"A", "B"}, required: true, displayCondition: &DisplayCondition{questionID: "parent", option: "はい"}},
+			{id: "grandchild", offerItemID: "offer_item_id", questionType: QuestionTypeText, title: "孫", required: true, displayCondition: &DisplayCondition{questionID: "child", option: "A"}},
+		},
+	}
+	type args struct {
+		answers map[QuestionID]string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []QuestionID
+		wantErr bool
+	}{
+		{
+			name: "正常系。 表示条件を満たす質問に回答",
+			args: args{
+				answers: map[QuestionID]string{
+					"parent":     "はい",
+					"child":      "A",
+					"grandchild": "自由記述",
+				},
+			},
+			want:    []QuestionID{"parent", "child", "grandchild"},
+			wantErr: false,
+		},
+		{
+			name: "正常系。 表示条件を満たさない質問は未回答を許容する",
+			args: args{
+				answers: map[QuestionID]string{
+					"parent": "いいえ",
+				},
+			},
+			want:    []QuestionID{"parent"},
+			wantErr: false,
+		},
+		{
+			name: "正常系。 表示されない質問への回答は保存しない",
+			args: args{
+				answers: map[QuestionID]string{
+					"parent":     "いいえ",
+					"child":      "A",
+					"grandchild": "自由記述",
+				},
+			},
+			want:    []QuestionID{"parent"},
+			wantErr: false,
+		},
+		{
+			name: "異常系。 表示条件を満たす必須の質問が未回答",
+			args: args{
+				answers: map[QuestionID]string{
+					"parent": "はい",
+					"child":  "A",
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewQuestionAnswers("assignee_id", questionnaire, tt.args.answers)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewQuestionAnswers() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			gotIDs := make([]QuestionID, 0, len(got))
+			for _, a := range got {
+				gotIDs = append(gotIDs, a.questionID)
+			}
+			assert.Equal(t, tt.want, gotIDs)
+		})
+	}
+}
+
+func TestNewQuestionnaire_DisplayCondition(t *testing.T) {
+	radio := func(id QuestionID, condition *DisplayCondition) Question {
+		return Question{id: id, offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: id.String(), options: []string{"A", "B"}, required: true, displayCondition: condition}
+	}
+	tests := []struct {
+		name      string
+		questions []Question
+		wantErr   bool
+	}{
+		{
+			name: "正常系。 単一選択の選択肢を参照",
+			questions: []Question{
+				radio("q1", nil),
+				radio("q2", &DisplayCondition{questionID: "q1", option: "A"}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "異常系。 存在しない質問を参照",
+			questions: []Question{
+				radio("q1", &DisplayCondition{questionID: "unknown", option: "A"}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 存在しない選択肢を参照",
+			questions: []Question{
+				radio("q1", nil),
+				radio("q2", &DisplayCondition{questionID: "q1", option: "C"}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 単一選択以外の質問を参照",
+			questions: []Question{
+				{id: "q1", offerItemID: "offer_item_id", questionType: QuestionTypeText, title: "q1", required: true},
+				radio("q2", &DisplayCondition{questionID: "q1", option: "A"}),
+			},
+			wantErr: true,
+		},
+		{
+			name: "異常系。 参照が循環している",
+			questions: []Question{
+				radio("q1", &DisplayCondition{questionID: "q3", option: "A"}),
+				radio("q2", &DisplayCondition{questionID: "q1", option: "A"}),
+				radio("q3", &DisplayCondition{questionID: "q2", option: "A"}),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewQuestionnaire("offer_item_id", "アンケート", tt.questions)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewQuestionnaire() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuestionnaire_ReviseQuestions(t *testing.T) {
+	radio := Question{id: "radio", offerItemID: "offer_item_id", questionType: QuestionTypeRadio, title: "単一選択", options: []string{"A", "B"}, required: true, version: 1}
+	text := Question{id: "text", offerItemID: "offer_item_id", questionType: QuestionTypeText, title: "自由記述", required: true, version: 2}
+	type args struct {
+		questions           func() []Question
+		answeredQuestionIDs map[QuestionID]struct{}
+	}
+	tests := []struct {
+		name             string
+		args             args
+		wantVersions     map[QuestionID]int
+		wantHistoryCount int
+	}{
+		{
+			name: "正常系。 未回答の質問の変更はバージョンを上げない",
+			args: args{
+				questions: func() []Question {
+					r := radio
+					r.options = []string{"A", "B", "C"}
+					return []Question{r, text}
+				},
+				answeredQuestionIDs: map[QuestionID]struct{}{"text": {}},
+			},
+			wantVersions:     map[QuestionID]int{"radio": 1, "text": 2},
+			wantHistoryCount: 0,
+		},
+		{
+			name: "正常系。 回答済みの質問の変更はバージョンを上げて変更前を残す",
+			args: args{
+				questions: func() []Question {
+					r := radio
+					r.options = []string{"A", "B", "C"}
+					return []Question{r, text}
+				},
+				answeredQuestionIDs: map[QuestionID]struct{}{"radio": {}, "text": {}},
+			},
+			wantVersions:     map[QuestionID]int{"radio": 2, "text": 2},
+			wantHistoryCount: 1,
+		},
+		{
+			name: "正常系。 回答済みの質問でも回答の解釈に影響しない変更はバージョンを上げない",
+			args: args{
+				questions: func() []Question {
+					r := radio
+					r.required = false
+					r.imageURL = "https://example.com/image.png"
+					return []Question{r, text}
+				},
+				answeredQuestionIDs: map[QuestionID]struct{}{"radio": {}, "text": {}},
+			},
+			wantVersions:     map[QuestionID]int{"radio": 1, "text": 2},
+			wantHistoryCount: 0,
+		},
+		{
+			name: "正常系。 回答済みの質問を削除した場合は変更前を残す",
+			args: args{
+				questions: func() []Question {
+					return []Question{radio}
+				},
+				answeredQuestionIDs: map[QuestionID]struct{}{"radio": {}, "text": {}},
+			},
+			wantVersions:     map[QuestionID]int{"radio": 1},
+			wantHistoryCount: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := Questionnaire{
+				offerItemID: "offer_item_id",
+				description: "アンケート",
+				questions:   []Question{radio, text},
+			}
+			if err := q.ReviseQuestions(tt.args.questions(), tt.args.answeredQuestionIDs); err != nil {
+				t.Errorf("ReviseQuestions() error = %v", err)
+				return
+			}
+			gotVersions := make(map[QuestionID]int, len(q.Questions()))
+			for _, question := range q.Questions() {
+				gotVersions[question.ID()] = question.Version()
+			}
+			assert.Equal(t, tt.wantVersions, gotVersions)
+			assert.Len(t, q.QuestionHistories(), tt.wantHistoryCount)
+		})
+	}
+}
+
+func TestQuestionnaire_ResolveImageURLs(t *testing.T) {
+	q := NewQuestionnaireFromRepository("offer_item_id", "説明", []Question{
+		{id: "key", imageURL: "questionnaire_image/abc.png"},
+		{id: "external", imageURL: "https://example.com/image.png"},
+		{id: "legacy", imageURL: "data:image/png;base64,AAAA"},
+		{id: "empty"},
+	}, nil)
+
+	// オブジェクトキーのみURLに置き換える
+	assert.NoError(t, q.ResolveImageURLs(func(key ObjectKey) (string, error) {
+		return "https://storage.example.com/" + key.String(), nil
+	}))
+	var got []string
+	for _, question := range q.Questions() {
+		got = append(got, question.ImageURL())
+	}
+	assert.Equal(t, []string{"https://storage.example.com/questionnaire_image/abc.png", "https://example.com/image.png", "data:image/png;base64,AAAA", ""}, got)
+}
@@ -188,25 +188,17 @@ func ScheduleEntityToModel(v int) ScheduleType {
 	}
 }
 
+// SetSchedules スケジュールを設定する。下書きの場合は必須のスケジュールが揃っていなくてもよい
 func (o *OfferItem) SetSchedules(schedules []*Schedule) error {
-	scheduleMap := make(map[ScheduleType]*Schedule)
-	for _, s := range schedules {
-		if _, ok := scheduleMap[s.scheduleType]; ok {
-			// スケジュールタイプでユニークになる
-			return apperr.OfferItemValidationError.Wrap(errors.New("scheduleType is duplicated"))
-		}
-		scheduleMap[s.scheduleType] = s
-	}
-	// 「参加募集」「記事投稿」「支払い」は必須の為設定されているか確認する
-	for _, mustScheduleType := range MustScheduleTypeValues() {
-		if scheduleMap[mustScheduleType] == nil {
-			return apperr.OfferItemValidationError.Wrap(errors.New("must mustScheduleType is required"))
+	if o.IsDraft() {
+		if _, err := scheduleMapByType(schedules); err != nil {
+			return apperr.OfferItemValidationError.Wrap(err)
 		}
+	} else if err := validateSchedules(schedules); err != nil {
+		return apperr.OfferItemValidationError.Wrap(err)
 	}
-
-	// スケジュールタイプが不正な値の場合はエラー
-	if len(scheduleMap) > len(ScheduleTypeValues()) || len(scheduleMap) < len(MustScheduleTypeValues()) {
-		return apperr.OfferItemValidationError.Wrap(errors.New("scheduleType is invalid"))
+	for _, s := range schedules {
+		s.offerItemID = o.id
 	}
 	o.schedules = schedules
 	return nil
@@ -0,0 +1,180 @@
+package model
+
+import (
+	"sort"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 全文検索で検索語に一致した項目
+type SearchHighlightField string
+
+const (
+	SearchHighlightFieldName             SearchHighlightField = "name"
+	SearchHighlightFieldProductFeatures  SearchHighlightField = "product_features"
+	SearchHighlightFieldCautionaryPoints SearchHighlightField = "cautionary_points"
+	SearchHighlightFieldReferenceInfo    SearchHighlightField = "reference_info"
+)
+
+const (
+	// 断片に含める最初の一致箇所より前の文字数
+	searchHighlightLeadingLength = 20
+	// 断片の最大文字数
+	searchHighlightFragmentLength = 100
+)
+
+// SearchHighlight 項目のうち最初に検索語に一致した箇所の前後を抜き出した断片
+// 断片をそのまま画面に表示できるよう、一致箇所はタグで囲まずに範囲で返す
+type SearchHighlight struct {
+	field    SearchHighlightField
+	fragment string
+	matches  []HighlightRange
+}
+
+// HighlightRange 断片の中で検索語に一致した範囲。文字 (rune) 単位で、start を含み end を含まない
+type HighlightRange struct {
+	start int
+	end   int
+}
+
+func (r HighlightRange) Start() int {
+	return r.start
+}
+
+func (r HighlightRange) End() int {
+	return r.end
+}
+
+// NewSearchHighlights オファー案件の全文検索の対象項目から、検索語に一致した箇所の断片を生成する
+// 一致の判定はカラムの照合順序 (utf8mb4_general_ci) に合わせ、一致しない項目は含めない
+func NewSearchHighlights(offerItem *OfferItem, terms []string) []*SearchHighlight {
+	fields := []struct {
+		field SearchHighlightField
+		text  string
+	}{
+		{SearchHighlightFieldName, offerItem.Name()},
+		{SearchHighlightFieldProductFeatures, offerItem.ProductFeatures()},
+		{SearchHighlightFieldCautionaryPoints, offerItem.CautionaryPoints()},
+		{SearchHighlightFieldReferenceInfo, offerItem.ReferenceInfo()},
+	}
+
+	highlights := make([]*SearchHighlight, 0, len(fields))
+	for _, f := range fields {
+		if h := newSearchHighlight(f.field, f.text, terms); h != nil {
+			highlights = append(highlights, h)
+		}
+	}
+	return highlights
+}
+
+func newSearchHighlight(field SearchHighlightField, text string, terms []string) *SearchHighlight {
+	runes := []rune(text)
+	matches := findHighlightRanges(foldRunes(runes), terms)
+	if len(matches) == 0 {
+		return nil
+	}
+
+	start := matches[0].start - searchHighlightLeadingLength
+	if start < 0 {
+		start = 0
+	}
+	end := start + searchHighlightFragmentLength
+	if end < matches[0].end {
+		end = matches[0].end
+	}
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	fragmentMatches := make([]HighlightRange, 0, len(matches))
+	for _, m := range matches {
+		if m.start >= end {
+			break
+		}
+		if m.end > end {
+			m.end = end
+		}
+		fragmentMatches = append(fragmentMatches, HighlightRange{start: m.start - start, end: m.end - start})
+	}
+
+	return &SearchHighlight{
+		field:    field,
+		fragment: string(runes[start:end]),
+		matches:  fragmentMatches,
+	}
+}
+
+// 検索語に一致する範囲を昇順に並べ、重なる範囲は結合して返す
+func findHighlightRanges(folded []rune, terms []string) []HighlightRange {
+	ranges := make([]HighlightRange, 0)
+	for _, term := range terms {
+		t := foldRunes([]rune(term))
+		if len(t) == 0 {
+			continue
+		}
+		for i := 0; i+len(t) <= len(folded); i++ {
+			if equalRunes(folded[i:i+len(t)], t) {
+				ranges = append(ranges, HighlightRange{start: i, end: i + len(t)})
+			}
+		}
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start < ranges[j].start })
+
+	merged := make([]HighlightRange, 0, len(ranges))
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.start <= merged[n-1].end {
+			if r.end > merged[n-1].end {
+				merged[n-1].end = r.end
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// 文字ごとに utf8mb4_general_ci の照合順序で同じ文字として扱われる代表の文字に変換する
+// 文字数が変わらないため、元の文字列の位置と対応する
+func foldRunes(runes []rune) []rune {
+	folded := make([]rune, len(runes))
+	for i, r := range runes {
+		folded[i] = foldRune(r)
+	}
+	return folded
+}
+
+// utf8mb4_general_ci は大文字小文字と、ラテン文字・ギリシャ文字のアクセントを区別しない
+// 全角半角・ひらがなカタカナ・濁点の有無は区別し、BMP 外の文字は全て同じ文字として扱う
+func foldRune(r rune) rune {
+	if r > 0xFFFF {
+		return unicode.ReplacementChar
+	}
+	if r < 0x0400 {
+		if d := []rune(norm.NFD.String(string(r))); len(d) > 0 {
+			r = d[0]
+		}
+	}
+	return unicode.ToUpper(r)
+}
+
+func equalRunes(a, b []rune) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *SearchHighlight) Field() SearchHighlightField {
+	return h.field
+}
+
+func (h *SearchHighlight) Fragment() string {
+	return h.fragment
+}
+
+func (h *SearchHighlight) Matches() []HighlightRange {
+	return h.matches
+}
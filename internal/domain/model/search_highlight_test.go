@@ -0,0 +1,75 @@
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSearchHighlight(t *testing.T) {
+	long := strings.Repeat("あ", 50) + "保湿クリーム" + strings.Repeat("い", 200)
+
+	tests := []struct {
+		name         string
+		text         string
+		terms        []string
+		want         bool
+		wantFragment string
+		wantMatches  []HighlightRange
+	}{
+		{
+			name:  "一致しない",
+			text:  "保湿クリーム",
+			terms: []string{"化粧水"},
+		},
+		{
+			name:         "大文字小文字を区別しない",
+			text:         "Organic Cream",
+			terms:        []string{"cream"},
+			want:         true,
+			wantFragment: "Organic Cream",
+			wantMatches:  []HighlightRange{{start: 8, end: 13}},
+		},
+		{
+			name:         "アクセントを区別しない",
+			text:         "Crème brûlée",
+			terms:        []string{"creme", "BRULEE"},
+			want:         true,
+			wantFragment: "Crème brûlée",
+			wantMatches:  []HighlightRange{{start: 0, end: 5}, {start: 6, end: 12}},
+		},
+		{
+			name:  "全角半角とひらがなカタカナは区別する",
+			text:  "ＡＢＣクリーム",
+			terms: []string{"abc", "くりーむ"},
+		},
+		{
+			name:         "重なる一致箇所は結合する",
+			text:         "無添加保湿クリーム",
+			terms:        []string{"保湿", "湿クリ"},
+			want:         true,
+			wantFragment: "無添加保湿クリーム",
+			wantMatches:  []HighlightRange{{start: 3, end: 7}},
+		},
+		{
+			name:         "長い文章は最初の一致箇所の前後を抜き出す",
+			text:         long,
+			terms:        []string{"クリーム"},
+			want:         true,
+			wantFragment: string([]rune(long)[32:132]),
+			wantMatches:  []HighlightRange{{start: 20, end: 24}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newSearchHighlight(SearchHighlightFieldName, tt.text, tt.terms)
+			if !tt.want {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.wantFragment, got.Fragment())
+			assert.Equal(t, tt.wantMatches, got.Matches())
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+)
+
+// OfferItemSortKey オファー案件一覧 (ListOfferItem, SearchOfferItem) のソートキー
+type OfferItemSortKey string
+
+const (
+	OfferItemSortKeyName      OfferItemSortKey = "name"
+	OfferItemSortKeyCreatedAt OfferItemSortKey = "created_at"
+	// 募集スケジュールの終了日
+	OfferItemSortKeyInvitationEndDate OfferItemSortKey = "invitation_end_date"
+	// 削除されていないアサイニーの数
+	OfferItemSortKeyAssigneeCount OfferItemSortKey = "assignee_count"
+	// 現在日時以降に終了するスケジュールのうち、最も近い終了日
+	OfferItemSortKeyNextDeadline OfferItemSortKey = "next_deadline"
+)
+
+var offerItemSortKeys = []OfferItemSortKey{
+	OfferItemSortKeyName,
+	OfferItemSortKeyCreatedAt,
+	OfferItemSortKeyInvitationEndDate,
+	OfferItemSortKeyAssigneeCount,
+	OfferItemSortKeyNextDeadline,
+}
+
+func (k OfferItemSortKey) Valid() bool {
+	for _, v := range offerItemSortKeys {
+		if k == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (k OfferItemSortKey) String() string {
+	return string(k)
+}
+
+// AssigneeSortKey アサイニー一覧 (ListAssignee, ListAssigneeUnderExamination) のソートキー
+type AssigneeSortKey string
+
+const (
+	AssigneeSortKeyAmebaID   AssigneeSortKey = "ameba_id"
+	AssigneeSortKeyCreatedAt AssigneeSortKey = "created_at"
+	AssigneeSortKeyUpdatedAt AssigneeSortKey = "updated_at"
+)
+
+var assigneeSortKeys = []AssigneeSortKey{
+	AssigneeSortKeyAmebaID,
+	AssigneeSortKeyCreatedAt,
+	AssigneeSortKeyUpdatedAt,
+}
+
+func (k AssigneeSortKey) Valid() bool {
+	for _, v := range assigneeSortKeys {
+		if k == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (k AssigneeSortKey) String() string {
+	return string(k)
+}
+
+// ValidateOfferItemSorts ソート設定がオファー案件一覧のソートキーであることを確認する
+func (l *ListCondition) ValidateOfferItemSorts() error {
+	for _, s := range l.sorts {
+		if !OfferItemSortKey(s.OrderBy()).Valid() {
+			return apperr.OfferItemValidationError.Wrap(fmt.Errorf("unknown offer item sort key: %s", s.OrderBy()))
+		}
+	}
+	return nil
+}
+
+// ValidateAssigneeSorts ソート設定がアサイニー一覧のソートキーであることを確認する
+func (l *ListCondition) ValidateAssigneeSorts() error {
+	for _, s := range l.sorts {
+		if !AssigneeSortKey(s.OrderBy()).Valid() {
+			return apperr.OfferItemValidationError.Wrap(fmt.Errorf("unknown assignee sort key: %s", s.OrderBy()))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/terui-ryota/offer-item/pkg/id"
+)
+
+// ObjectKey オブジェクトストレージ上のキー。DBにはキーのみを保存する
+type ObjectKey string
+
+func (k ObjectKey) String() string {
+	return string(k)
+}
+
+const (
+	// ObjectKeyPrefixQuestionnaireImage アンケートの質問画像
+	ObjectKeyPrefixQuestionnaireImage = "questionnaire_image"
+	// ObjectKeyPrefixSNSScreenshot SNS投稿のスクリーンショット
+	ObjectKeyPrefixSNSScreenshot = "sns_screenshot"
+	// ObjectKeyPrefixOfferItemArchive 退避したオファー案件のアーカイブ
+	ObjectKeyPrefixOfferItemArchive = "offer_item_archive"
+
+	// MaxImageObjectSize 画像としてアップロードできる最大サイズ(5MiB)
+	MaxImageObjectSize = 5 << 20
+)
+
+// 画像としてアップロードできるコンテンツタイプと拡張子
+var imageContentTypeExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// StorageObject オブジェクトストレージに保存するオブジェクト
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=StorageObject
+type StorageObject struct {
+	key         ObjectKey
+	contentType string
+	data        []byte
+}
+
+// NewImageObject 画像オブジェクトを生成する
+// コンテンツタイプは許可されたものに限り、実際のデータから判定したコンテンツタイプと一致しなければならない
+func NewImageObject(prefix string, contentType string, data []byte) (*StorageObject, error) {
+	ext, ok := imageContentTypeExtensions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("content type is not allowed: %s", contentType)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("data must not be empty")
+	}
+	if len(data) > MaxImageObjectSize {
+		return nil, fmt.Errorf("data size must be less than or equal to %d: %d", MaxImageObjectSize, len(data))
+	}
+	if detected := http.DetectContentType(data); detected != contentType {
+		return nil, fmt.Errorf("content type does not match data: %s, %s", contentType, detected)
+	}
+	return &StorageObject{
+		key:         ObjectKey(fmt.Sprintf("%s/%s%s", prefix, id.New(), ext)),
+		contentType: contentType,
+		data:        data,
+	}, nil
+}
+
+// NewImageObjectFromDataURL data URL(例: data:image/png;base64,...)から画像オブジェクトを生成する
+func NewImageObjectFromDataURL(prefix string, dataURL string) (*StorageObject, error) {
+	if !IsDataURL(dataURL) {
+		return nil, fmt.Errorf("invalid data url")
+	}
+	meta, encoded, ok := strings.Cut(strings.TrimPrefix(dataURL, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid data url")
+	}
+	contentType, isBase64 := strings.CutSuffix(meta, ";base64")
+	if !isBase64 {
+		return nil, fmt.Errorf("data url must be base64 encoded")
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("base64.StdEncoding.DecodeString: %w", err)
+	}
+	o, err := NewImageObject(prefix, contentType, data)
+	if err != nil {
+		return nil, fmt.Errorf("NewImageObject: %w", err)
+	}
+	return o, nil
+}
+
+func NewStorageObjectFromRepository(key ObjectKey, contentType string, data []byte) *StorageObject {
+	return &StorageObject{
+		key:         key,
+		contentType: contentType,
+		data:        data,
+	}
+}
+
+// IsDataURL data URL 形式の文字列かどうか
+func IsDataURL(v string) bool {
+	return strings.HasPrefix(v, "data:")
+}
+
+// IsExternalURL http(s) のURLかどうか。オブジェクトストレージへの移行前から外部のURLを保存している値はそのまま扱う
+func IsExternalURL(v string) bool {
+	return strings.HasPrefix(v, "http://") || strings.HasPrefix(v, "https://")
+}
+
+// SubtractObjectKeys keys のうち excludes に含まれないキー
+func SubtractObjectKeys(keys, excludes []ObjectKey) []ObjectKey {
+	excluded := make(map[ObjectKey]struct{}, len(excludes))
+	for _, k := range excludes {
+		excluded[k] = struct{}{}
+	}
+	res := make([]ObjectKey, 0, len(keys))
+	for _, k := range keys {
+		if _, ok := excluded[k]; !ok {
+			res = append(res, k)
+		}
+	}
+	return res
+}
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImageObject(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	tests := []struct {
+		name        string
+		contentType string
+		data        []byte
+		wantErr     bool
+	}{
+		{
+			name:        "正常系",
+			contentType: "image/png",
+			data:        png,
+		},
+		{
+			name:        "異常系。 許可されていないコンテンツタイプ",
+			contentType: "application/pdf",
+			data:        []byte("%PDF-1.4"),
+			wantErr:     true,
+		},
+		{
+			name:        "異常系。 データが空",
+			contentType: "image/png",
+			data:        nil,
+			wantErr:     true,
+		},
+		{
+			name:        "異常系。 最大サイズを超えている",
+			contentType: "image/png",
+			data:        append(png, bytes.Repeat([]byte{0}, MaxImageObjectSize)...),
+			wantErr:     true,
+		},
+		{
+			name:        "異常系。 コンテンツタイプとデータが一致しない",
+			contentType: "image/jpeg",
+			data:        png,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewImageObject(ObjectKeyPrefixQuestionnaireImage, tt.contentType, tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(got.Key().String(), ObjectKeyPrefixQuestionnaireImage+"/"))
+			assert.True(t, strings.HasSuffix(got.Key().String(), ".png"))
+			assert.Equal(t, tt.contentType, got.ContentType())
+			assert.Equal(t, tt.data, got.Data())
+		})
+	}
+}
+
+func TestNewImageObjectFromDataURL(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	tests := []struct {
+		name    string
+		dataURL string
+		wantErr bool
+	}{
+		{
+			name:    "正常系",
+			dataURL: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+		},
+		{
+			name:    "異常系。 data URL ではない",
+			dataURL: "https://example.com/image.png",
+			wantErr: true,
+		},
+		{
+			name:    "異常系。 base64 エンコードされていない",
+			dataURL: "data:image/png," + string(png),
+			wantErr: true,
+		},
+		{
+			name:    "異常系。 base64 として不正",
+			dataURL: "data:image/png;base64,!!!",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewImageObjectFromDataURL(ObjectKeyPrefixQuestionnaireImage, tt.dataURL)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "image/png", got.ContentType())
+			assert.Equal(t, png, got.Data())
+		})
+	}
+}
+
+func TestLegacyImage_ToImageObject(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	tests := []struct {
+		name            string
+		image           *LegacyImage
+		wantContentType string
+		wantKeyPrefix   string
+		wantErr         bool
+	}{
+		{
+			name:            "正常系。 data URL",
+			image:           NewLegacyImageFromRepository(LegacyImageColumnQuestionnaireQuestionImage, "1", []byte("data:image/png;base64,"+base64.StdEncoding.EncodeToString(png))),
+			wantContentType: "image/png",
+			wantKeyPrefix:   ObjectKeyPrefixQuestionnaireImage + "/",
+		},
+		{
+			name:            "正常系。 画像のバイナリ",
+			image:           NewLegacyImageFromRepository(LegacyImageColumnExaminationSNSScreenshot, "1", png),
+			wantContentType: "image/png",
+			wantKeyPrefix:   ObjectKeyPrefixSNSScreenshot + "/",
+		},
+		{
+			name:    "異常系。 画像ではない値",
+			image:   NewLegacyImageFromRepository(LegacyImageColumnQuestionnaireQuestionImage, "1", []byte("https://example.com/image.png")),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.image.ToImageObject()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantContentType, got.ContentType())
+			assert.True(t, strings.HasPrefix(got.Key().String(), tt.wantKeyPrefix))
+			assert.True(t, tt.image.Column().IsObjectKey(got.Key().String()))
+			assert.Equal(t, png, got.Data())
+		})
+	}
+}
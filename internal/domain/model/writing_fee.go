@@ -0,0 +1,91 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+)
+
+// 執筆報酬のデフォルト単価(フォロワー数帯ごと)
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=WritingFeeTier
+type WritingFeeTier struct {
+	// この単価が適用される最小フォロワー数
+	minFollowerCount int
+	// 執筆報酬
+	writingFee int
+}
+
+func NewWritingFeeTier(minFollowerCount, writingFee int) (*WritingFeeTier, error) {
+	if minFollowerCount < 0 {
+		return nil, apperr.OfferItemValidationError.Wrap(errors.New("minFollowerCount must be greater than or equal to 0"))
+	}
+	if writingFee < 0 {
+		return nil, apperr.OfferItemValidationError.Wrap(errors.New("writingFee must be greater than or equal to 0"))
+	}
+	return &WritingFeeTier{
+		minFollowerCount: minFollowerCount,
+		writingFee:       writingFee,
+	}, nil
+}
+
+func NewWritingFeeTierFromRepository(minFollowerCount, writingFee int) WritingFeeTier {
+	return WritingFeeTier{
+		minFollowerCount: minFollowerCount,
+		writingFee:       writingFee,
+	}
+}
+
+// WritingFeeTierList 最小フォロワー数の昇順に並んだ執筆報酬の単価リスト
+type WritingFeeTierList []WritingFeeTier
+
+// NewWritingFeeTierList 最小フォロワー数の昇順に並べた単価リストを生成する。最小フォロワー数の重複は許可しない
+func NewWritingFeeTierList(tiers []WritingFeeTier) (WritingFeeTierList, error) {
+	res := make(WritingFeeTierList, len(tiers))
+	copy(res, tiers)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].minFollowerCount < res[j].minFollowerCount
+	})
+	for i := 1; i < len(res); i++ {
+		if res[i].minFollowerCount == res[i-1].minFollowerCount {
+			return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("minFollowerCount is duplicated: %d", res[i].minFollowerCount))
+		}
+	}
+	return res, nil
+}
+
+// WritingFeeFor フォロワー数に対応する執筆報酬を返す。対応する単価がない場合は false を返す
+func (l WritingFeeTierList) WritingFeeFor(followerCount int) (int, bool) {
+	for i := len(l) - 1; i >= 0; i-- {
+		if l[i].minFollowerCount <= followerCount {
+			return l[i].writingFee, true
+		}
+	}
+	return 0, false
+}
+
+// 執筆報酬の変更履歴
+//
+//go:generate go run github.com/terui-ryota/gen-getter -type=WritingFeeChange
+type WritingFeeChange struct {
+	// 変更前の執筆報酬
+	previousWritingFee int
+	// 変更後の執筆報酬
+	writingFee int
+	// 変更したユーザー。保存時にリクエストの操作者が設定される
+	changedBy string
+	// 変更日時
+	changedAt time.Time
+}
+
+func NewWritingFeeChangeFromRepository(previousWritingFee, writingFee int, changedBy string, changedAt time.Time) WritingFeeChange {
+	return WritingFeeChange{
+		previousWritingFee: previousWritingFee,
+		writingFee:         writingFee,
+		changedBy:          changedBy,
+		changedAt:          changedAt,
+	}
+}
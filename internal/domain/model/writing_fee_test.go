@@ -0,0 +1,37 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestWritingFeeTierList_WritingFeeFor(t *testing.T) {
+	tiers, err := NewWritingFeeTierList([]WritingFeeTier{
+		{minFollowerCount: 10000, writingFee: 5000},
+		{minFollowerCount: 0, writingFee: 1000},
+		{minFollowerCount: 1000, writingFee: 3000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name          string
+		followerCount int
+		want          int
+	}{
+		{name: "正常系。最小の帯", followerCount: 999, want: 1000},
+		{name: "正常系。帯の境界", followerCount: 1000, want: 3000},
+		{name: "正常系。最大の帯", followerCount: 50000, want: 5000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tiers.WritingFeeFor(tt.followerCount)
+			if !ok || got != tt.want {
+				t.Errorf("WritingFeeFor() = %v, %v, want %v", got, ok, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewWritingFeeTierList([]WritingFeeTier{{minFollowerCount: 0}, {minFollowerCount: 0}}); err == nil {
+		t.Errorf("NewWritingFeeTierList() error = nil, want duplicated error")
+	}
+}
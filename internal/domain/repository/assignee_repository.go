@@ -16,11 +16,16 @@ type AssigneeRepository interface {
 	BulkGetByOfferItemIDAmebaIDs(ctx context.Context, db *sql.DB, offerItemID model.OfferItemID, amebaIDs []model.AmebaID, withLock bool) (map[model.AmebaID]*model.Assignee, error)
 	ListByOfferItemIDStage(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, stage model.Stage) (model.AssigneeList, error)
 	ListUnderExamination(ctx context.Context, exec boil.ContextExecutor) (model.AssigneeList, error)
+	ListPageByOfferItemIDStage(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, stage model.Stage, condition *model.ListCondition) (*model.ListAssigneeResult, error)
+	ListPageUnderExamination(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition) (*model.ListAssigneeResult, error)
+	ListPageByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, condition *model.ListCondition) (*model.ListAssigneeResult, error)
 	ListCount(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) ([]model.AssigneeCount, error)
+	ListByStage(ctx context.Context, exec boil.ContextExecutor, stage model.Stage) (model.AssigneeList, error)
 	ListUnderPaying(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, amebaIDs []model.AmebaID) (model.AssigneeList, error)
 	ListByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (model.AssigneeList, error)
 	GetByAmebaIDOfferItemID(ctx context.Context, exec boil.ContextExecutor, amebaID model.AmebaID, offerItemID model.OfferItemID) (*model.Assignee, error)
 	ListByAmebaID(ctx context.Context, exec boil.ContextExecutor, amebaID model.AmebaID) (model.AssigneeList, error)
 	Get(ctx context.Context, exec boil.ContextExecutor, assigneeID model.AssigneeID) (*model.Assignee, error)
+	ListWritingFeeChanges(ctx context.Context, exec boil.ContextExecutor, assigneeID model.AssigneeID) ([]model.WritingFeeChange, error)
 	DeleteByOfferItemIDAndAmebaID(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, amebaID model.AmebaID) error
 }
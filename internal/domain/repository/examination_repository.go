@@ -6,11 +6,13 @@ import (
 	"database/sql"
 
 	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type ExaminationRepository interface {
 	BulkGetByOfferItemID(ctx context.Context, db *sql.DB, offerItemID model.OfferItemID, entryType model.EntryType) (map[model.AmebaID]*model.Examination, error)
-	Get(ctx context.Context, db *sql.DB, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType) (*model.Examination, error)
-	Update(ctx context.Context, exec *sql.DB, examination *model.Examination) error
+	BulkGetLatestByAssigneeIDs(ctx context.Context, exec boil.ContextExecutor, assigneeIDs []model.AssigneeID) (map[model.AssigneeID]*model.Examination, error)
+	Get(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType, withLock bool) (*model.Examination, error)
+	Update(ctx context.Context, exec boil.ContextExecutor, examination *model.Examination) error
 	Create(ctx context.Context, db *sql.DB, examination *model.Examination) error
 }
@@ -0,0 +1,17 @@
+//go:generate go run github.com/golang/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock_$GOPACKAGE
+package repository
+
+import (
+	"context"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// LegacyImageRepository オブジェクトストレージへの移行前に DB に直接保存された画像データを扱う
+type LegacyImageRepository interface {
+	// ListByColumn オブジェクトキーではない値を ID の昇順で取得する。afterID より大きい ID のみを対象とする
+	ListByColumn(ctx context.Context, exec boil.ContextExecutor, column model.LegacyImageColumn, afterID string, limit int) ([]*model.LegacyImage, error)
+	// ReplaceWithObjectKey 値をオブジェクトキーに置き換える。取得後に値が変更されていた場合は置き換えず false を返す
+	ReplaceWithObjectKey(ctx context.Context, exec boil.ContextExecutor, image *model.LegacyImage, key model.ObjectKey) (bool, error)
+}
@@ -170,6 +170,21 @@ func (mr *MockAssigneeRepositoryMockRecorder) ListByOfferItemIDStage(ctx, exec,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOfferItemIDStage", reflect.TypeOf((*MockAssigneeRepository)(nil).ListByOfferItemIDStage), ctx, exec, offerItemID, stage)
 }
 
+// ListByStage mocks base method.
+func (m *MockAssigneeRepository) ListByStage(ctx context.Context, exec boil.ContextExecutor, stage model.Stage) (model.AssigneeList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByStage", ctx, exec, stage)
+	ret0, _ := ret[0].(model.AssigneeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByStage indicates an expected call of ListByStage.
+func (mr *MockAssigneeRepositoryMockRecorder) ListByStage(ctx, exec, stage interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByStage", reflect.TypeOf((*MockAssigneeRepository)(nil).ListByStage), ctx, exec, stage)
+}
+
 // ListCount mocks base method.
 func (m *MockAssigneeRepository) ListCount(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) ([]model.AssigneeCount, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +200,51 @@ func (mr *MockAssigneeRepositoryMockRecorder) ListCount(ctx, exec, offerItemID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCount", reflect.TypeOf((*MockAssigneeRepository)(nil).ListCount), ctx, exec, offerItemID)
 }
 
+// ListPageByOfferItemID mocks base method.
+func (m *MockAssigneeRepository) ListPageByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPageByOfferItemID", ctx, exec, offerItemID, condition)
+	ret0, _ := ret[0].(*model.ListAssigneeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPageByOfferItemID indicates an expected call of ListPageByOfferItemID.
+func (mr *MockAssigneeRepositoryMockRecorder) ListPageByOfferItemID(ctx, exec, offerItemID, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPageByOfferItemID", reflect.TypeOf((*MockAssigneeRepository)(nil).ListPageByOfferItemID), ctx, exec, offerItemID, condition)
+}
+
+// ListPageByOfferItemIDStage mocks base method.
+func (m *MockAssigneeRepository) ListPageByOfferItemIDStage(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, stage model.Stage, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPageByOfferItemIDStage", ctx, exec, offerItemID, stage, condition)
+	ret0, _ := ret[0].(*model.ListAssigneeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPageByOfferItemIDStage indicates an expected call of ListPageByOfferItemIDStage.
+func (mr *MockAssigneeRepositoryMockRecorder) ListPageByOfferItemIDStage(ctx, exec, offerItemID, stage, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPageByOfferItemIDStage", reflect.TypeOf((*MockAssigneeRepository)(nil).ListPageByOfferItemIDStage), ctx, exec, offerItemID, stage, condition)
+}
+
+// ListPageUnderExamination mocks base method.
+func (m *MockAssigneeRepository) ListPageUnderExamination(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPageUnderExamination", ctx, exec, condition)
+	ret0, _ := ret[0].(*model.ListAssigneeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPageUnderExamination indicates an expected call of ListPageUnderExamination.
+func (mr *MockAssigneeRepositoryMockRecorder) ListPageUnderExamination(ctx, exec, condition interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPageUnderExamination", reflect.TypeOf((*MockAssigneeRepository)(nil).ListPageUnderExamination), ctx, exec, condition)
+}
+
 // ListUnderExamination mocks base method.
 func (m *MockAssigneeRepository) ListUnderExamination(ctx context.Context, exec boil.ContextExecutor) (model.AssigneeList, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +275,21 @@ func (mr *MockAssigneeRepositoryMockRecorder) ListUnderPaying(ctx, exec, offerIt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnderPaying", reflect.TypeOf((*MockAssigneeRepository)(nil).ListUnderPaying), ctx, exec, offerItemID, amebaIDs)
 }
 
+// ListWritingFeeChanges mocks base method.
+func (m *MockAssigneeRepository) ListWritingFeeChanges(ctx context.Context, exec boil.ContextExecutor, assigneeID model.AssigneeID) ([]model.WritingFeeChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWritingFeeChanges", ctx, exec, assigneeID)
+	ret0, _ := ret[0].([]model.WritingFeeChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWritingFeeChanges indicates an expected call of ListWritingFeeChanges.
+func (mr *MockAssigneeRepositoryMockRecorder) ListWritingFeeChanges(ctx, exec, assigneeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWritingFeeChanges", reflect.TypeOf((*MockAssigneeRepository)(nil).ListWritingFeeChanges), ctx, exec, assigneeID)
+}
+
 // Update mocks base method.
 func (m *MockAssigneeRepository) Update(ctx context.Context, exec boil.ContextExecutor, assignee *model.Assignee) error {
 	m.ctrl.T.Helper()
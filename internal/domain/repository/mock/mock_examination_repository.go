@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/terui-ryota/offer-item/internal/domain/model"
+	boil "github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 // MockExaminationRepository is a mock of ExaminationRepository interface.
@@ -51,6 +52,21 @@ func (mr *MockExaminationRepositoryMockRecorder) BulkGetByOfferItemID(ctx, db, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkGetByOfferItemID", reflect.TypeOf((*MockExaminationRepository)(nil).BulkGetByOfferItemID), ctx, db, offerItemID, entryType)
 }
 
+// BulkGetLatestByAssigneeIDs mocks base method.
+func (m *MockExaminationRepository) BulkGetLatestByAssigneeIDs(ctx context.Context, exec boil.ContextExecutor, assigneeIDs []model.AssigneeID) (map[model.AssigneeID]*model.Examination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkGetLatestByAssigneeIDs", ctx, exec, assigneeIDs)
+	ret0, _ := ret[0].(map[model.AssigneeID]*model.Examination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkGetLatestByAssigneeIDs indicates an expected call of BulkGetLatestByAssigneeIDs.
+func (mr *MockExaminationRepositoryMockRecorder) BulkGetLatestByAssigneeIDs(ctx, exec, assigneeIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkGetLatestByAssigneeIDs", reflect.TypeOf((*MockExaminationRepository)(nil).BulkGetLatestByAssigneeIDs), ctx, exec, assigneeIDs)
+}
+
 // Create mocks base method.
 func (m *MockExaminationRepository) Create(ctx context.Context, db *sql.DB, examination *model.Examination) error {
 	m.ctrl.T.Helper()
@@ -66,22 +82,22 @@ func (mr *MockExaminationRepositoryMockRecorder) Create(ctx, db, examination int
 }
 
 // Get mocks base method.
-func (m *MockExaminationRepository) Get(ctx context.Context, db *sql.DB, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType) (*model.Examination, error) {
+func (m *MockExaminationRepository) Get(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType, withLock bool) (*model.Examination, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, db, offerItemID, assigneeID, entryType)
+	ret := m.ctrl.Call(m, "Get", ctx, exec, offerItemID, assigneeID, entryType, withLock)
 	ret0, _ := ret[0].(*model.Examination)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockExaminationRepositoryMockRecorder) Get(ctx, db, offerItemID, assigneeID, entryType interface{}) *gomock.Call {
+func (mr *MockExaminationRepositoryMockRecorder) Get(ctx, exec, offerItemID, assigneeID, entryType, withLock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExaminationRepository)(nil).Get), ctx, db, offerItemID, assigneeID, entryType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockExaminationRepository)(nil).Get), ctx, exec, offerItemID, assigneeID, entryType, withLock)
 }
 
 // Update mocks base method.
-func (m *MockExaminationRepository) Update(ctx context.Context, exec *sql.DB, examination *model.Examination) error {
+func (m *MockExaminationRepository) Update(ctx context.Context, exec boil.ContextExecutor, examination *model.Examination) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Update", ctx, exec, examination)
 	ret0, _ := ret[0].(error)
@@ -68,6 +68,20 @@ func (mr *MockOfferItemRepositoryMockRecorder) Create(ctx, tx, offerItem interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOfferItemRepository)(nil).Create), ctx, tx, offerItem)
 }
 
+// CreateRevision mocks base method.
+func (m *MockOfferItemRepository) CreateRevision(ctx context.Context, tx *sql.Tx, revision *model.OfferItemRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRevision", ctx, tx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRevision indicates an expected call of CreateRevision.
+func (mr *MockOfferItemRepositoryMockRecorder) CreateRevision(ctx, tx, revision interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRevision", reflect.TypeOf((*MockOfferItemRepository)(nil).CreateRevision), ctx, tx, revision)
+}
+
 // Delete mocks base method.
 func (m *MockOfferItemRepository) Delete(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error {
 	m.ctrl.T.Helper()
@@ -97,19 +111,34 @@ func (mr *MockOfferItemRepositoryMockRecorder) Get(ctx, exec, offerItemID, withL
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOfferItemRepository)(nil).Get), ctx, exec, offerItemID, withLock)
 }
 
+// GetRevisionAsOf mocks base method.
+func (m *MockOfferItemRepository) GetRevisionAsOf(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, at time.Time) (*model.OfferItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionAsOf", ctx, exec, offerItemID, at)
+	ret0, _ := ret[0].(*model.OfferItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionAsOf indicates an expected call of GetRevisionAsOf.
+func (mr *MockOfferItemRepositoryMockRecorder) GetRevisionAsOf(ctx, exec, offerItemID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionAsOf", reflect.TypeOf((*MockOfferItemRepository)(nil).GetRevisionAsOf), ctx, exec, offerItemID, at)
+}
+
 // List mocks base method.
-func (m *MockOfferItemRepository) List(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition, isClosed bool) (*model.ListOfferItemResult, error) {
+func (m *MockOfferItemRepository) List(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition, statuses []model.OfferItemStatus) (*model.ListOfferItemResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, exec, condition, isClosed)
+	ret := m.ctrl.Call(m, "List", ctx, exec, condition, statuses)
 	ret0, _ := ret[0].(*model.ListOfferItemResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockOfferItemRepositoryMockRecorder) List(ctx, exec, condition, isClosed interface{}) *gomock.Call {
+func (mr *MockOfferItemRepositoryMockRecorder) List(ctx, exec, condition, statuses interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOfferItemRepository)(nil).List), ctx, exec, condition, isClosed)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOfferItemRepository)(nil).List), ctx, exec, condition, statuses)
 }
 
 // ListIDsByEndDate mocks base method.
@@ -127,6 +156,95 @@ func (mr *MockOfferItemRepositoryMockRecorder) ListIDsByEndDate(ctx, exec, since
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIDsByEndDate", reflect.TypeOf((*MockOfferItemRepository)(nil).ListIDsByEndDate), ctx, exec, sinceEndDate, untilEndDate)
 }
 
+// ListIDsByStatuses mocks base method.
+func (m *MockOfferItemRepository) ListIDsByStatuses(ctx context.Context, exec boil.ContextExecutor, statuses []model.OfferItemStatus) (model.OfferItemIDList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIDsByStatuses", ctx, exec, statuses)
+	ret0, _ := ret[0].(model.OfferItemIDList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIDsByStatuses indicates an expected call of ListIDsByStatuses.
+func (mr *MockOfferItemRepositoryMockRecorder) ListIDsByStatuses(ctx, exec, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIDsByStatuses", reflect.TypeOf((*MockOfferItemRepository)(nil).ListIDsByStatuses), ctx, exec, statuses)
+}
+
+// ListIDsDeletedBefore mocks base method.
+func (m *MockOfferItemRepository) ListIDsDeletedBefore(ctx context.Context, exec boil.ContextExecutor, deletedBefore time.Time) (model.OfferItemIDList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIDsDeletedBefore", ctx, exec, deletedBefore)
+	ret0, _ := ret[0].(model.OfferItemIDList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIDsDeletedBefore indicates an expected call of ListIDsDeletedBefore.
+func (mr *MockOfferItemRepositoryMockRecorder) ListIDsDeletedBefore(ctx, exec, deletedBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIDsDeletedBefore", reflect.TypeOf((*MockOfferItemRepository)(nil).ListIDsDeletedBefore), ctx, exec, deletedBefore)
+}
+
+// ListObjectKeys mocks base method.
+func (m *MockOfferItemRepository) ListObjectKeys(ctx context.Context, exec boil.ContextExecutor, id model.OfferItemID) ([]model.ObjectKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectKeys", ctx, exec, id)
+	ret0, _ := ret[0].([]model.ObjectKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectKeys indicates an expected call of ListObjectKeys.
+func (mr *MockOfferItemRepositoryMockRecorder) ListObjectKeys(ctx, exec, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectKeys", reflect.TypeOf((*MockOfferItemRepository)(nil).ListObjectKeys), ctx, exec, id)
+}
+
+// ListRevisions mocks base method.
+func (m *MockOfferItemRepository) ListRevisions(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) ([]*model.OfferItemRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", ctx, exec, offerItemID)
+	ret0, _ := ret[0].([]*model.OfferItemRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockOfferItemRepositoryMockRecorder) ListRevisions(ctx, exec, offerItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockOfferItemRepository)(nil).ListRevisions), ctx, exec, offerItemID)
+}
+
+// Purge mocks base method.
+func (m *MockOfferItemRepository) Purge(ctx context.Context, tx *sql.Tx, id model.OfferItemID) ([]model.ObjectKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, tx, id)
+	ret0, _ := ret[0].([]model.ObjectKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockOfferItemRepositoryMockRecorder) Purge(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockOfferItemRepository)(nil).Purge), ctx, tx, id)
+}
+
+// Restore mocks base method.
+func (m *MockOfferItemRepository) Restore(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockOfferItemRepositoryMockRecorder) Restore(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockOfferItemRepository)(nil).Restore), ctx, tx, id)
+}
+
 // Search mocks base method.
 func (m *MockOfferItemRepository) Search(ctx context.Context, exec boil.ContextExecutor, searchCriteria *dto.SearchOfferItemCriteria, condition *model.ListCondition) (*model.ListOfferItemResult, error) {
 	m.ctrl.T.Helper()
@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/terui-ryota/offer-item/internal/domain/model"
+	boil "github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 // MockQuestionnaireQuestionAnswerRepository is a mock of QuestionnaireQuestionAnswerRepository interface.
@@ -51,6 +52,51 @@ func (mr *MockQuestionnaireQuestionAnswerRepositoryMockRecorder) BulkGetByOfferI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkGetByOfferItemIDAndAssigneeIDs", reflect.TypeOf((*MockQuestionnaireQuestionAnswerRepository)(nil).BulkGetByOfferItemIDAndAssigneeIDs), ctx, offerItemID, assigneeIDs)
 }
 
+// CountByAnswerContent mocks base method.
+func (m *MockQuestionnaireQuestionAnswerRepository) CountByAnswerContent(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, questionIDs []model.QuestionID) ([]model.AnswerContentCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByAnswerContent", ctx, exec, offerItemID, questionIDs)
+	ret0, _ := ret[0].([]model.AnswerContentCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByAnswerContent indicates an expected call of CountByAnswerContent.
+func (mr *MockQuestionnaireQuestionAnswerRepositoryMockRecorder) CountByAnswerContent(ctx, exec, offerItemID, questionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByAnswerContent", reflect.TypeOf((*MockQuestionnaireQuestionAnswerRepository)(nil).CountByAnswerContent), ctx, exec, offerItemID, questionIDs)
+}
+
+// CountByQuestionVersion mocks base method.
+func (m *MockQuestionnaireQuestionAnswerRepository) CountByQuestionVersion(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (map[model.QuestionVersionKey]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByQuestionVersion", ctx, exec, offerItemID)
+	ret0, _ := ret[0].(map[model.QuestionVersionKey]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByQuestionVersion indicates an expected call of CountByQuestionVersion.
+func (mr *MockQuestionnaireQuestionAnswerRepositoryMockRecorder) CountByQuestionVersion(ctx, exec, offerItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByQuestionVersion", reflect.TypeOf((*MockQuestionnaireQuestionAnswerRepository)(nil).CountByQuestionVersion), ctx, exec, offerItemID)
+}
+
+// CountRespondents mocks base method.
+func (m *MockQuestionnaireQuestionAnswerRepository) CountRespondents(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRespondents", ctx, exec, offerItemID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRespondents indicates an expected call of CountRespondents.
+func (mr *MockQuestionnaireQuestionAnswerRepositoryMockRecorder) CountRespondents(ctx, exec, offerItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRespondents", reflect.TypeOf((*MockQuestionnaireQuestionAnswerRepository)(nil).CountRespondents), ctx, exec, offerItemID)
+}
+
 // DeleteByOfferItemID mocks base method.
 func (m *MockQuestionnaireQuestionAnswerRepository) DeleteByOfferItemID(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) error {
 	m.ctrl.T.Helper()
@@ -65,6 +111,36 @@ func (mr *MockQuestionnaireQuestionAnswerRepositoryMockRecorder) DeleteByOfferIt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByOfferItemID", reflect.TypeOf((*MockQuestionnaireQuestionAnswerRepository)(nil).DeleteByOfferItemID), ctx, tx, offerItemID)
 }
 
+// ListByOfferItemID mocks base method.
+func (m *MockQuestionnaireQuestionAnswerRepository) ListByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOfferItemID", ctx, exec, offerItemID)
+	ret0, _ := ret[0].(map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOfferItemID indicates an expected call of ListByOfferItemID.
+func (mr *MockQuestionnaireQuestionAnswerRepositoryMockRecorder) ListByOfferItemID(ctx, exec, offerItemID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOfferItemID", reflect.TypeOf((*MockQuestionnaireQuestionAnswerRepository)(nil).ListByOfferItemID), ctx, exec, offerItemID)
+}
+
+// ListTextAnswers mocks base method.
+func (m *MockQuestionnaireQuestionAnswerRepository) ListTextAnswers(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, key model.QuestionVersionKey, offset, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTextAnswers", ctx, exec, offerItemID, key, offset, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTextAnswers indicates an expected call of ListTextAnswers.
+func (mr *MockQuestionnaireQuestionAnswerRepositoryMockRecorder) ListTextAnswers(ctx, exec, offerItemID, key, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTextAnswers", reflect.TypeOf((*MockQuestionnaireQuestionAnswerRepository)(nil).ListTextAnswers), ctx, exec, offerItemID, key, offset, limit)
+}
+
 // Save mocks base method.
 func (m *MockQuestionnaireQuestionAnswerRepository) Save(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, assigneeID model.AssigneeID, answers []model.QuestionAnswer) error {
 	m.ctrl.T.Helper()
@@ -94,3 +94,17 @@ func (mr *MockQuestionnaireRepositoryMockRecorder) Save(ctx, tx, questionnaire i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockQuestionnaireRepository)(nil).Save), ctx, tx, questionnaire)
 }
+
+// SoftDelete mocks base method.
+func (m *MockQuestionnaireRepository) SoftDelete(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SoftDelete", ctx, tx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SoftDelete indicates an expected call of SoftDelete.
+func (mr *MockQuestionnaireRepositoryMockRecorder) SoftDelete(ctx, tx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockQuestionnaireRepository)(nil).SoftDelete), ctx, tx, id)
+}
@@ -0,0 +1,18 @@
+//go:generate go run github.com/golang/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock_$GOPACKAGE
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type OfferItemArchiveRepository interface {
+	Archive(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) (*model.OfferItemArchive, error)
+	Get(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (*model.OfferItemArchive, error)
+	Unarchive(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) (*model.OfferItemArchive, error)
+	ListArchivableIDs(ctx context.Context, exec boil.ContextExecutor, completedBefore time.Time) (model.OfferItemIDList, error)
+}
@@ -12,12 +12,21 @@ import (
 )
 
 type OfferItemRepository interface {
-	List(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition, isClosed bool) (*model.ListOfferItemResult, error)
+	List(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition, statuses []model.OfferItemStatus) (*model.ListOfferItemResult, error)
 	Delete(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error
+	Restore(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error
+	ListIDsDeletedBefore(ctx context.Context, exec boil.ContextExecutor, deletedBefore time.Time) (model.OfferItemIDList, error)
+	Purge(ctx context.Context, tx *sql.Tx, id model.OfferItemID) ([]model.ObjectKey, error)
+	// ListObjectKeys オファー案件の集約が参照しているオブジェクトストレージのキーを取得する
+	ListObjectKeys(ctx context.Context, exec boil.ContextExecutor, id model.OfferItemID) ([]model.ObjectKey, error)
 	Search(ctx context.Context, exec boil.ContextExecutor, searchCriteria *dto.SearchOfferItemCriteria, condition *model.ListCondition) (*model.ListOfferItemResult, error)
 	Get(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, withLock bool) (*model.OfferItem, error)
 	Create(ctx context.Context, tx *sql.Tx, offerItem *model.OfferItem) error
 	Update(ctx context.Context, tx *sql.Tx, offerItem *model.OfferItem) error
 	BulkGet(ctx context.Context, exec boil.ContextExecutor, ids []model.OfferItemID, isClosed bool) (map[model.OfferItemID]*model.OfferItem, error)
 	ListIDsByEndDate(ctx context.Context, exec boil.ContextExecutor, sinceEndDate, untilEndDate time.Time) (model.OfferItemIDList, error)
+	ListIDsByStatuses(ctx context.Context, exec boil.ContextExecutor, statuses []model.OfferItemStatus) (model.OfferItemIDList, error)
+	CreateRevision(ctx context.Context, tx *sql.Tx, revision *model.OfferItemRevision) error
+	ListRevisions(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) ([]*model.OfferItemRevision, error)
+	GetRevisionAsOf(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, at time.Time) (*model.OfferItemRevision, error)
 }
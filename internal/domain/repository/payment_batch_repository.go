@@ -0,0 +1,17 @@
+//go:generate go run github.com/golang/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock_$GOPACKAGE
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type PaymentBatchRepository interface {
+	Create(ctx context.Context, tx *sql.Tx, paymentBatch *model.PaymentBatch) error
+	Get(ctx context.Context, exec boil.ContextExecutor, id model.PaymentBatchID, withLock bool) (*model.PaymentBatch, error)
+	Update(ctx context.Context, tx *sql.Tx, paymentBatch *model.PaymentBatch) error
+	ListUnconfirmedAssigneeIDs(ctx context.Context, exec boil.ContextExecutor, assigneeIDs []model.AssigneeID) (map[model.AssigneeID]struct{}, error)
+}
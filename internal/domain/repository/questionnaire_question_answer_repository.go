@@ -6,10 +6,16 @@ import (
 	"database/sql"
 
 	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type QuestionnaireQuestionAnswerRepository interface {
 	Save(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, assigneeID model.AssigneeID, answers []model.QuestionAnswer) error
 	BulkGetByOfferItemIDAndAssigneeIDs(ctx context.Context, offerItemID model.OfferItemID, assigneeIDs []model.AssigneeID) (map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer, error)
+	ListByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer, error)
+	CountRespondents(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (int, error)
+	CountByQuestionVersion(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (map[model.QuestionVersionKey]int, error)
+	CountByAnswerContent(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, questionIDs []model.QuestionID) ([]model.AnswerContentCount, error)
+	ListTextAnswers(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, key model.QuestionVersionKey, offset, limit int) ([]string, error)
 	DeleteByOfferItemID(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) error
 }
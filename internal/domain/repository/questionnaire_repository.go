@@ -14,4 +14,5 @@ type QuestionnaireRepository interface {
 	BulkGet(ctx context.Context, exec boil.ContextExecutor, ids []model.OfferItemID) (map[model.OfferItemID]model.Questionnaire, error)
 	Save(ctx context.Context, tx *sql.Tx, questionnaire model.Questionnaire) error
 	Delete(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error
+	SoftDelete(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error
 }
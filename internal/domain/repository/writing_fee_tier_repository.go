@@ -0,0 +1,15 @@
+//go:generate go run github.com/golang/mock/mockgen -source=$GOFILE -destination=mock/mock_$GOFILE -package=mock_$GOPACKAGE
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type WritingFeeTierRepository interface {
+	ListByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (model.WritingFeeTierList, error)
+	Save(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, tiers model.WritingFeeTierList) error
+}
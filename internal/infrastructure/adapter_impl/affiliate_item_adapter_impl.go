@@ -3,15 +3,13 @@ package adapter_impl
 import (
 	"context"
 	"fmt"
-	//"sync"
+	"sync"
 	"time"
 
-	"github.com/dgraph-io/ristretto"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
 	"github.com/terui-ryota/offer-item/internal/domain/model"
-	"github.com/terui-ryota/offer-item/internal/infrastructure/component/rakuten"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/cache"
 	"github.com/terui-ryota/offer-item/pkg/logger"
-	//"golang.org/x/sync/errgroup"
-	"golang.org/x/sync/singleflight"
 
 	"github.com/terui-ryota/offer-item/internal/domain/adapter"
 	"github.com/terui-ryota/offer-item/pkg/apperr"
@@ -19,56 +17,43 @@ import (
 	"go.uber.org/zap"
 )
 
-func NewAffiliateItemAdapterImpl(rakutenClient *rakuten.RakutenIchibaClient) adapter.AffiliateItemAdapter {
-	// コネクションを生成する
-	//conn, err := grpc.Dial(
-	//config.AffiliateItem.Target(),
-	//	grpc.WithTransportCredentials(insecure.NewCredentials()),
-	//	grpc.WithUnaryInterceptor(
-	//		grpc_middleware.UnaryClientInterceptor(consts.ContextName),
-	//	),
-	//	grpc.WithStatsHandler(&ocgrpc.ClientHandler{
-	//		StartOptions: trace.StartOptions{
-	//			Sampler: trace.ProbabilitySampler(0.5),
-	//		},
-	//	}),
-	//)
-	//if err != nil {
-	//	// 接続できない場合
-	//	panic(err)
-	//}
-	// クライアントを生成する
-	//affiliateItemAdminClient := affiliate_item.NewAffiliateItemAdminHandlerClient(conn)
-	//affiliateItemClient := affiliate_item.NewAffiliateItemHandlerClient(conn)
-	//
-	//cache, err := ristretto.NewCache(&ristretto.Config{
-	//	NumCounters: 10000,
-	//	MaxCost:     10 * 1024 * 1024, // 10MB
-	//	BufferItems: 64,
-	//})
-	//if err != nil {
-	//	panic(err)
-	//}
-
-	cache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 10000,
-		MaxCost:     10 * 1024 * 1024, // 10MB
-		BufferItems: 64,
-	})
-	if err != nil {
-		panic(err)
+const (
+	defaultAffiliateItemCacheTTL = 24 * time.Hour
+	// バックグラウンドで再取得する場合のタイムアウト
+	affiliateItemRevalidateTimeout = 30 * time.Second
+)
+
+func NewAffiliateItemAdapterImpl(registry *AffiliateItemProviderRegistry, itemCache cache.Cache, config *config.AffiliateItemConfig) adapter.AffiliateItemAdapter {
+	ttl, staleTTL := defaultAffiliateItemCacheTTL, time.Duration(0)
+	if config != nil && config.Cache != nil {
+		if config.Cache.TTL.Duration > 0 {
+			ttl = config.Cache.TTL.Duration
+		}
+		staleTTL = config.Cache.StaleTTL.Duration
 	}
 
 	return &AffiliateItemAdapterImpl{
-		rakutenClient: rakutenClient,
-		localCache:    cache,
+		registry:     registry,
+		cache:        itemCache,
+		ttl:          ttl,
+		staleTTL:     staleTTL,
+		now:          time.Now,
+		revalidating: make(map[string]struct{}),
 	}
 }
 
+// AffiliateItemAdapterImpl 案件IDの接頭辞に応じた取得元から案件情報を取得する
+// 取得した案件情報はキャッシュし、TTL の経過後 stale_ttl の間は古い値を返しつつバックグラウンドで再取得する
 type AffiliateItemAdapterImpl struct {
-	rakutenClient *rakuten.RakutenIchibaClient
-	sg            singleflight.Group
-	localCache    *ristretto.Cache
+	registry *AffiliateItemProviderRegistry
+	cache    cache.Cache
+	ttl      time.Duration
+	staleTTL time.Duration
+	now      func() time.Time
+
+	mu sync.Mutex
+	// バックグラウンドで再取得中のキャッシュのキー
+	revalidating map[string]struct{}
 }
 
 // 案件ID、DF案件IDを指定して、案件情報を取得する
@@ -76,147 +61,73 @@ func (a *AffiliateItemAdapterImpl) GetItems(ctx context.Context, itemIdentifier
 	ctx, span := trace.StartSpan(ctx, "AffiliateItemAdapterImpl.GetItems")
 	defer span.End()
 
-	itemMap, err := a.bulkGetItems(ctx, []model.ItemIdentifier{itemIdentifier}, true)
+	itemMap, unavailable, err := a.bulkGetItems(ctx, []model.ItemIdentifier{itemIdentifier}, true)
 	if err != nil {
 		return nil, fmt.Errorf("a.bulkGetItems: %w", err)
 	}
 	items, ok := itemMap[itemIdentifier]
 	if !ok {
+		if len(unavailable) > 0 {
+			return nil, apperr.OfferItemAffiliateItemUnavailableError.Wrap(fmt.Errorf("item provider is unavailable: %v", itemIdentifier))
+		}
 		return nil, apperr.OfferItemAffiliateItemNotFoundError.Wrap(fmt.Errorf("item not found: %v", itemIdentifier))
 	}
 
 	return &items, nil
 }
 
-// itemIdentifiersを指定して、案件情報マップを取得する
-func (a *AffiliateItemAdapterImpl) BulkGetItems(ctx context.Context, itemIdentifiers model.ItemIdentifiers) (map[model.ItemIdentifier]model.Items, error) {
+// itemIdentifiersを指定して、案件情報マップと取得元の障害により取得できなかった案件を取得する
+func (a *AffiliateItemAdapterImpl) BulkGetItems(ctx context.Context, itemIdentifiers model.ItemIdentifiers) (map[model.ItemIdentifier]model.Items, model.ItemIdentifiers, error) {
 	ctx, span := trace.StartSpan(ctx, "AffiliateItemAdapterImpl.BulkGetItems")
 	defer span.End()
 
 	return a.bulkGetItems(ctx, itemIdentifiers, true)
 }
 
-// 並行処理を行うと楽天APIでエラーが発生する
-//func (a *AffiliateItemAdapterImpl) getAffiliateItems(ctx context.Context, pairs model.ItemIdentifiers) (model.AffiliateItemPairList, error) {
-//	mutex := &sync.Mutex{}
-//	// Context は評価後に Cancel されるため別名で定義する
-//	eg, egCtx := errgroup.WithContext(ctx)
-//
-//	var ids []string
-//	for _, v := range pairs {
-//		if v.ItemID().String() != "" || v.DFItemID().String() != "RK000001" {
-//			continue
-//		}
-//		ids = append(ids, v.DFItemID().String())
-//	}
-//
-//	var affiliateItemDFItemList model.AffiliateItemDFItemList
-//
-//	uncachedList := make(model.AffiliateItemDFItemList, 0, len(ids))
-//	for _, itemPair := range pairs {
-//		if itemPair.DFItemID().String() == "" || itemPair.DFItemID().String() == "RK000001" {
-//			continue
-//		}
-//		id := itemPair.DFItemID().String()
-//		eg.Go(func() error {
-//			fmt.Println("================aiueo====================")
-//			v, err, _ := a.sg.Do(id, func() (interface{}, error) {
-//				return a.rakutenClient.GetItemsByItemId(egCtx, id)
-//			})
-//			if err != nil {
-//				switch err := err.(type) {
-//				case rakuten.TooManyRequestsErr:
-//					// 楽天API でリクエスト数超過が出た場合は400系で返却するためにTooManyRequestErrorで返却する
-//					return rakuten.NewASPTooManyRequestError(itemPair.ItemID())
-//				default:
-//					// 正常に取得できた item は返却する必要があるので、リクエスト数超過以外のエラーはここでエラーログで出力し関数自体は正常終了させる。
-//					logger.Default().Error("Failed to get rakuten items by ids", zap.String("id", id), zap.Error(err))
-//					return nil
-//				}
-//			}
-//			result, ok := v.(*rakuten.ItemResult)
-//			if !ok {
-//				return apperr.AffiliateItemInternalError.Wrap(errors.New("failed convert to rakuten.ItemResult"))
-//			}
-//			// model変換
-//			affiliateItemDFItemList = a.convertAffiliateItemDFItems(egCtx, result, itemPair.ItemID())
-//			mutex.Lock()
-//			defer mutex.Unlock()
-//			uncachedList = append(uncachedList, affiliateItemDFItemList...)
-//			return nil
-//		})
-//	}
-//	if err := eg.Wait(); err != nil {
-//		return nil, err
-//	}
-//
-//	var affiliateItemPairList model.AffiliateItemPairList
-//	affiliateItemRate := float32(4)
-//	affiliateItemStaticRate := float32(4)
-//	affiliateItemCommission := model.NewAffiliateItemCommission(&affiliateItemRate, nil, &affiliateItemStaticRate, "")
-//	affiliateItemItem := model.NewAffiliateItemItem("RK000001", "https://stat.amebame.com/pub/content/5164757434/amebapick/item/rakuten/logo.png", "楽天市場", affiliateItemCommission, "楽天株式会社", model.URLMap{model.PlatformTypeAll: "https://www.rakuten.co.jp/"}, true)
-//	for _, affiliateItemDFItem := range uncachedList {
-//		affiliateItemPair := model.NewAffiliateItemPair(affiliateItemItem, affiliateItemDFItem)
-//		affiliateItemPairList = append(affiliateItemPairList, affiliateItemPair)
-//	}
-//	return affiliateItemPairList, nil
-//}
-
-func (a *AffiliateItemAdapterImpl) getAffiliateItems(ctx context.Context, pairs model.ItemIdentifiers) (model.AffiliateItemPairList, error) {
-	var ids []string
-	for _, v := range pairs {
-		if v.ItemID().String() != "" || v.DFItemID().String() != "RK000001" {
+// 案件IDの接頭辞ごとに取得元へ振り分けて案件情報を取得する
+func (a *AffiliateItemAdapterImpl) getAffiliateItems(ctx context.Context, pairs model.ItemIdentifiers) (model.AffiliateItemPairList, model.ItemIdentifiers, error) {
+	prefixes := make([]string, 0)
+	providers := make(map[string]AffiliateItemProvider)
+	grouped := make(map[string]model.ItemIdentifiers)
+	for _, pair := range pairs {
+		prefix, provider, ok := a.registry.Lookup(pair.ItemID())
+		if !ok {
+			logger.FromContext(ctx).Warn("Affiliate item provider not found", zap.String("ItemID", pair.ItemID().String()))
 			continue
 		}
-		ids = append(ids, v.DFItemID().String())
-	}
-
-	uncachedList := make(model.AffiliateItemDFItemList, 0, len(ids))
-	for _, itemPair := range pairs {
-		if itemPair.DFItemID().String() == "" || itemPair.DFItemID().String() == "RK000001" {
-			continue
+		if _, ok := grouped[prefix]; !ok {
+			prefixes = append(prefixes, prefix)
+			providers[prefix] = provider
 		}
-		id := itemPair.DFItemID().String()
-
-		result, err := a.rakutenClient.GetItemsByItemId(ctx, id)
-		if err != nil {
-			switch err := err.(type) {
-			case rakuten.TooManyRequestsErr:
-				// 楽天API でリクエスト数超過が出た場合は400系で返却するためにTooManyRequestErrorで返却する
-				return nil, rakuten.NewASPTooManyRequestError(itemPair.ItemID())
-			default:
-				// 正常に取得できた item は返却する必要があるので、リクエスト数超過以外のエラーはここでエラーログで出力し処理を続行する。
-				logger.Default().Error("Failed to get rakuten items by ids", zap.String("id", id), zap.Error(err))
-				continue
-			}
-		}
-
-		// model変換
-		affiliateItemDFItemList := a.convertAffiliateItemDFItems(ctx, result, itemPair.ItemID())
-		uncachedList = append(uncachedList, affiliateItemDFItemList...)
+		grouped[prefix] = append(grouped[prefix], pair)
 	}
 
 	var affiliateItemPairList model.AffiliateItemPairList
-	affiliateItemRate := float32(4)
-	affiliateItemStaticRate := float32(4)
-	affiliateItemCommission := model.NewAffiliateItemCommission(&affiliateItemRate, nil, &affiliateItemStaticRate, "")
-	affiliateItemItem := model.NewAffiliateItemItem("RK000001", "https://stat.amebame.com/pub/content/5164757434/amebapick/item/rakuten/logo.png", "楽天市場", affiliateItemCommission, "楽天株式会社", model.URLMap{model.PlatformTypeAll: "https://www.rakuten.co.jp/"}, true)
-	for _, affiliateItemDFItem := range uncachedList {
-		affiliateItemPair := model.NewAffiliateItemPair(affiliateItemItem, affiliateItemDFItem)
-		affiliateItemPairList = append(affiliateItemPairList, affiliateItemPair)
+	var unavailable model.ItemIdentifiers
+	for _, prefix := range prefixes {
+		list, u, err := providers[prefix].GetAffiliateItems(ctx, grouped[prefix])
+		if err != nil {
+			return nil, nil, fmt.Errorf("provider.GetAffiliateItems(%s): %w", prefix, err)
+		}
+		affiliateItemPairList = append(affiliateItemPairList, list...)
+		unavailable = append(unavailable, u...)
 	}
-	return affiliateItemPairList, nil
+	return affiliateItemPairList, unavailable, nil
 }
 
-func (a *AffiliateItemAdapterImpl) bulkGetItems(ctx context.Context, pairs model.ItemIdentifiers, useCache bool) (map[model.ItemIdentifier]model.Items, error) {
+func (a *AffiliateItemAdapterImpl) bulkGetItems(ctx context.Context, pairs model.ItemIdentifiers, useCache bool) (map[model.ItemIdentifier]model.Items, model.ItemIdentifiers, error) {
 	res := make(map[model.ItemIdentifier]model.Items)
 	afRequestPairs := make(model.ItemIdentifiers, 0, len(pairs))
+	stalePairs := make(model.ItemIdentifiers, 0)
 
 	// cacheから取得できるアイテムと取得できないアイテムを分ける
 	for _, pair := range pairs {
 		if useCache {
-			if items, found := a.getListItemsFromLocalCache(ctx, pair); found {
+			if items, stale, found := a.getListItemsFromCache(ctx, pair); found {
 				res[pair] = *items
+				if stale {
+					stalePairs = append(stalePairs, pair)
+				}
 				continue
 			}
 		}
@@ -224,18 +135,33 @@ func (a *AffiliateItemAdapterImpl) bulkGetItems(ctx context.Context, pairs model
 		afRequestPairs = append(afRequestPairs, pair)
 	}
 
-	logger.FromContext(ctx).Debugf("Number of cache hits : %d, Number of cache misses: %d", len(res), len(afRequestPairs))
+	logger.FromContext(ctx).Debugf("Number of cache hits : %d, Number of stale cache hits : %d, Number of cache misses: %d", len(res), len(stalePairs), len(afRequestPairs))
+	a.revalidate(ctx, stalePairs)
 	if len(afRequestPairs) == 0 {
-		return res, nil
+		return res, nil, nil
 	}
 
-	lir, err := a.getAffiliateItems(ctx, afRequestPairs)
+	fetched, unavailable, err := a.fetchItems(ctx, afRequestPairs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("a.fetchItems: %w", err)
+	}
+	for pair, items := range fetched {
+		res[pair] = items
+	}
+	return res, unavailable, nil
+}
+
+// 取得元から案件情報を取得し、キャッシュに保存する
+// 取得元の障害により取得できなかった案件はキャッシュせずに返す
+func (a *AffiliateItemAdapterImpl) fetchItems(ctx context.Context, afRequestPairs model.ItemIdentifiers) (map[model.ItemIdentifier]model.Items, model.ItemIdentifiers, error) {
+	res := make(map[model.ItemIdentifier]model.Items)
+	lir, unavailable, err := a.getAffiliateItems(ctx, afRequestPairs)
 	if err != nil {
-		return nil, fmt.Errorf("a.getAffiliateItems: %w", err)
+		return nil, nil, fmt.Errorf("a.getAffiliateItems: %w", err)
 	}
 
-	if len(lir) == 0 {
-		return nil, apperr.OfferItemAffiliateItemNotFoundError.Wrap(fmt.Errorf("item not found: %v", afRequestPairs))
+	if len(lir) == 0 && len(unavailable) == 0 {
+		return nil, nil, apperr.OfferItemAffiliateItemNotFoundError.Wrap(fmt.Errorf("item not found: %v", afRequestPairs))
 	}
 
 	items := make(map[string]model.Item)
@@ -245,14 +171,14 @@ func (a *AffiliateItemAdapterImpl) bulkGetItems(ctx context.Context, pairs model
 		if affiliateItemItem := lir[i].AffiliateItemItem(); affiliateItemItem != nil {
 			item, err := model.AffiliateItemItemToItem(affiliateItemItem)
 			if err != nil {
-				return nil, fmt.Errorf("converter.AffiliateItemItemToItem: %w", err)
+				return nil, nil, fmt.Errorf("converter.AffiliateItemItemToItem: %w", err)
 			}
 			items[item.ID().String()] = *item
 		}
 		if affiliateItemDFItem := lir[i].AffiliateItemDFItem(); affiliateItemDFItem != nil {
 			dfItem, err := model.AffiliateItemDFItemToDFItem(affiliateItemDFItem)
 			if err != nil {
-				return nil, fmt.Errorf("converter.AffiliateItemDFItemToDFItem: %w", err)
+				return nil, nil, fmt.Errorf("converter.AffiliateItemDFItemToDFItem: %w", err)
 			}
 			dfItems[dfItem.ID().String()] = *dfItem
 		}
@@ -275,7 +201,7 @@ func (a *AffiliateItemAdapterImpl) bulkGetItems(ctx context.Context, pairs model
 				DFItem: dfItems[afRequestPairs[i].DFItemID().String()],
 			}
 			// キャッシュに保存する
-			a.saveListItemsToLocalCache(afRequestPairs[i], res[afRequestPairs[i]])
+			a.saveListItemsToCache(ctx, afRequestPairs[i], res[afRequestPairs[i]])
 			continue
 		}
 
@@ -283,138 +209,100 @@ func (a *AffiliateItemAdapterImpl) bulkGetItems(ctx context.Context, pairs model
 			res[afRequestPairs[i]] = model.Items{
 				Item: items[afRequestPairs[i].ItemID().String()],
 			}
-			a.saveListItemsToLocalCache(afRequestPairs[i], res[afRequestPairs[i]])
+			a.saveListItemsToCache(ctx, afRequestPairs[i], res[afRequestPairs[i]])
 			continue
 		}
 	}
 
-	return res, nil
+	return res, unavailable, nil
 }
 
-//func (a *AffiliateItemAdapterImpl) bulkGetItems(ctx context.Context, pairs model.ItemIdentifiers, useCache bool) (map[model.ItemIdentifier]model.Items, error) {
-//	res := make(map[model.ItemIdentifier]model.Items)
-//
-//	lir, err := a.getAffiliateItems(ctx, pairs)
-//	if err != nil {
-//		return nil, fmt.Errorf("a.getAffiliateItems: %w", err)
-//	}
-//
-//	if len(lir) == 0 {
-//		return nil, apperr.OfferItemAffiliateItemNotFoundError.Wrap(fmt.Errorf("item not found: %v", pairs))
-//	}
-//
-//	items := make(map[string]model.Item)
-//	dfItems := make(map[string]model.DFItem)
-//
-//	for i := range lir {
-//		if affiliateItemItem := lir[i].AffiliateItemItem(); affiliateItemItem != nil {
-//			item, err := model.AffiliateItemItemToItem(affiliateItemItem)
-//			if err != nil {
-//				return nil, fmt.Errorf("converter.AffiliateItemItemToItem: %w", err)
-//			}
-//			items[item.ID().String()] = *item
-//		}
-//		if affiliateItemDFItem := lir[i].AffiliateItemDFItem(); affiliateItemDFItem != nil {
-//			dfItem, err := model.AffiliateItemDFItemToDFItem(affiliateItemDFItem)
-//			if err != nil {
-//				return nil, fmt.Errorf("converter.AffiliateItemDFItemToDFItem: %w", err)
-//			}
-//			dfItems[dfItem.ID().String()] = *dfItem
-//		}
-//	}
-//
-//	for i := range pairs {
-//		_, isItemExist := items[pairs[i].ItemID().String()]
-//		_, isDFItemExist := dfItems[pairs[i].DFItemID().String()]
-//		// DfItemが存在するが、DFItemを取得出来ない場合はログに出力して次の処理へいく
-//		if pairs[i].DFItemID().String() != "" && !isDFItemExist {
-//			logger.FromContext(ctx).Warn("DFItem not found", zap.String("DFItemID", pairs[i].DFItemID().String()))
-//			continue
-//		}
-//		// ItemIDとDFItemIDの両方が非空であり、それぞれのアイテムがitemsとdfItemsに存在する場合
-//		if pairs[i].ItemID().String() != "" && pairs[i].DFItemID().String() != "" && isItemExist && isDFItemExist {
-//			res[pairs[i]] = model.Items{
-//				Item:   items[pairs[i].ItemID().String()],
-//				DFItem: dfItems[pairs[i].DFItemID().String()],
-//			}
-//			continue
-//		}
-//
-//		if pairs[i].ItemID().String() != "" && isItemExist {
-//			res[pairs[i]] = model.Items{
-//				Item: items[pairs[i].ItemID().String()],
-//			}
-//			continue
-//		}
-//	}
-//
-//	return res, nil
-//}
-
-func (a *AffiliateItemAdapterImpl) saveListItemsToLocalCache(itemIdentifier model.ItemIdentifier, items model.Items) {
-	ttl := 24 * time.Hour
-	key := itemIdentifier.ItemID().String()
-	if itemIdentifier.DFItemID().String() != "" {
-		key = key + "#" + itemIdentifier.DFItemID().String()
+// TTL の経過後も stale_ttl の間は古い値を返せるよう、キャッシュには TTL と stale_ttl の合計の期間保存する
+func (a *AffiliateItemAdapterImpl) saveListItemsToCache(ctx context.Context, itemIdentifier model.ItemIdentifier, items model.Items) {
+	key := affiliateItemCacheKey(itemIdentifier)
+	value, err := encodeAffiliateItemCacheEntry(items, a.now())
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to encode cache entry", zap.String("key", key), zap.Error(err))
+		return
+	}
+	// キャッシュに保存できない場合も、取得した案件情報は返す
+	if err := a.cache.Set(ctx, key, value, a.ttl+a.staleTTL); err != nil {
+		logger.FromContext(ctx).Warn("Failed to save items to cache", zap.String("key", key), zap.Error(err))
 	}
-	a.localCache.SetWithTTL(key, items, 1, ttl)
 }
 
-func (r *AffiliateItemAdapterImpl) getListItemsFromLocalCache(ctx context.Context, itemIdentifier model.ItemIdentifier) (*model.Items, bool) {
-	key := itemIdentifier.ItemID().String()
-	if itemIdentifier.DFItemID().String() != "" {
-		key = key + "#" + itemIdentifier.DFItemID().String()
+// キャッシュから案件情報を取得する。TTL を経過している場合は stale を true で返す
+// キャッシュを参照できない場合は、取得元から取得するためキャッシュに無いものとして扱う
+func (a *AffiliateItemAdapterImpl) getListItemsFromCache(ctx context.Context, itemIdentifier model.ItemIdentifier) (items *model.Items, stale bool, found bool) {
+	key := affiliateItemCacheKey(itemIdentifier)
+	value, found, err := a.cache.Get(ctx, key)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to get items from cache", zap.String("key", key), zap.Error(err))
+		return nil, false, false
 	}
-	if value, isFound := r.localCache.Get(key); isFound {
-		if items, ok := value.(model.Items); ok {
-			return &items, true
-		} else {
-			logger.FromContext(ctx).Warnf("Failed to parse cached. key: %s, value: %d", key, items)
-		}
+	if !found {
+		return nil, false, false
 	}
-
-	return nil, false
+	items, storedAt, err := decodeAffiliateItemCacheEntry(value)
+	if err != nil {
+		logger.FromContext(ctx).Warnf("Failed to parse cached. key: %s, error: %v", key, err)
+		return nil, false, false
+	}
+	return items, a.now().Sub(storedAt) >= a.ttl, true
 }
 
-// convertAffiliateItemDFItems はrakuten商品検索APIの検索結果をAffiliateItemDFItemModelに変換します。
-func (r *AffiliateItemAdapterImpl) convertAffiliateItemDFItems(ctx context.Context, result *rakuten.ItemResult, itemId model.ItemID) model.AffiliateItemDFItemList {
-	// 楽天のDF案件
-	affiliateItemDFItemList := make(model.AffiliateItemDFItemList, 0, len(result.Items))
-	for _, resultItem := range result.Items {
-		affiliateRate := float32(resultItem.Item.AffiliateRate)
-		commissionRate := model.NewAffiliateItemCommission(
-			&affiliateRate,
-			&affiliateRate,
-			&affiliateRate,
-			"",
-		)
-
-		// 商品URL
-		itemUrl, err := resultItem.Item.ParsedItemUrl()
-		if err != nil {
-			logger.Default().Warn("Failed to parse url.", zap.String("url", resultItem.Item.AffiliateURL))
+// TTL を経過した案件情報をバックグラウンドで再取得する。再取得中のキーは重複して取得しない
+func (a *AffiliateItemAdapterImpl) revalidate(ctx context.Context, pairs model.ItemIdentifiers) {
+	targets := make(model.ItemIdentifiers, 0, len(pairs))
+	a.mu.Lock()
+	for _, pair := range pairs {
+		key := affiliateItemCacheKey(pair)
+		if _, ok := a.revalidating[key]; ok {
+			continue
 		}
+		a.revalidating[key] = struct{}{}
+		targets = append(targets, pair)
+	}
+	a.mu.Unlock()
+	if len(targets) == 0 {
+		return
+	}
+
+	// リクエストの終了後も再取得を続けるため、ctx のキャンセルは引き継がない
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), affiliateItemRevalidateTimeout)
+	go func() {
+		defer cancel()
+		defer func() {
+			a.mu.Lock()
+			defer a.mu.Unlock()
+			for _, pair := range targets {
+				delete(a.revalidating, affiliateItemCacheKey(pair))
+			}
+		}()
 
-		// 金額
-		price := model.AffiliateItemDFItemPriceList{
-			model.AffiliateItemDFItemPrice{
-				SalePrice:   model.Price(resultItem.Item.ItemPrice),
-				RetailPrice: model.Price(resultItem.Item.ItemPrice),
-			},
+		ctx, span := trace.StartSpan(ctx, "AffiliateItemAdapterImpl.revalidate")
+		defer span.End()
+		if _, _, err := a.fetchItems(ctx, targets); err != nil {
+			logger.FromContext(ctx).Warn("Failed to revalidate cached items", zap.Error(err))
 		}
-		affiliateItemDFItem := model.NewAffiliateItemDFItem(
-			resultItem.Item.ItemCode,
-			resultItem.Item.ItemName, // CatchcopyはCarrierによって変わる（PC、スマホ、などなど）
-			itemUrl,
-			resultItem.MakeMaterial(),
-			resultItem.Item.ShopName,
-			resultItem.Item.ShopCode,
-			itemId,
-			price,
-			model.AffiliateItemCommissionList{commissionRate},
-		)
-
-		affiliateItemDFItemList.Append(affiliateItemDFItem)
+	}()
+}
+
+// 案件ID、DF案件IDを指定して、キャッシュした案件情報を削除する
+// DF案件IDを指定しない場合は、案件IDに紐づく全てのDF案件のキャッシュも削除する
+// memory ドライバーではキャッシュがレプリカ間で共有されないため、リクエストを処理したレプリカのキャッシュのみ削除される
+func (a *AffiliateItemAdapterImpl) InvalidateItems(ctx context.Context, itemIdentifier model.ItemIdentifier) error {
+	ctx, span := trace.StartSpan(ctx, "AffiliateItemAdapterImpl.InvalidateItems")
+	defer span.End()
+
+	if err := a.cache.Delete(ctx, affiliateItemCacheKey(itemIdentifier)); err != nil {
+		return fmt.Errorf("a.cache.Delete: %w", err)
+	}
+	if itemIdentifier.DFItemID().String() != "" {
+		return nil
+	}
+	if err := a.cache.DeletePrefix(ctx, affiliateItemCacheKeyPrefix(itemIdentifier.ItemID())); err != nil {
+		return fmt.Errorf("a.cache.DeletePrefix: %w", err)
 	}
-	return affiliateItemDFItemList
+	return nil
 }
@@ -0,0 +1,295 @@
+package adapter_impl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/cache"
+	libtime "github.com/terui-ryota/offer-item/pkg/time"
+)
+
+// fakeAffiliateItemProvider 指定された案件を記録し、親案件のみを返す
+type fakeAffiliateItemProvider struct {
+	parentItem *model.AffiliateItemItem
+	requested  model.ItemIdentifiers
+}
+
+func (p *fakeAffiliateItemProvider) GetAffiliateItems(_ context.Context, identifiers model.ItemIdentifiers) (model.AffiliateItemPairList, model.ItemIdentifiers, error) {
+	p.requested = append(p.requested, identifiers...)
+	return model.AffiliateItemPairList{model.NewAffiliateItemPair(p.parentItem, nil)}, nil, nil
+}
+
+const testCatalog = `{
+  "items": [
+    {
+      "id": "AM000001",
+      "name": "Amazon",
+      "content_name": "アマゾンジャパン合同会社",
+      "url": "https://www.amazon.co.jp/",
+      "commission_rate": 3,
+      "df_items": [
+        {"id": "B000000001", "name": "商品 1", "image_url": "https://example.com/1.png", "price": 1980, "commission_rate": 5}
+      ]
+    }
+  ]
+}`
+
+func newTestCatalogProvider(t *testing.T) *CatalogAffiliateItemProvider {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "catalog.json")
+	if err := os.WriteFile(path, []byte(testCatalog), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := NewCatalogAffiliateItemProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestAffiliateItemProviderRegistry_Lookup(t *testing.T) {
+	rakuten := &fakeAffiliateItemProvider{}
+	rakutenBooks := &fakeAffiliateItemProvider{}
+	registry := NewAffiliateItemProviderRegistry()
+	assert.NoError(t, registry.Register("RK", rakuten))
+	assert.NoError(t, registry.Register("RKB", rakutenBooks))
+	assert.Error(t, registry.Register("RK", rakuten))
+	assert.Error(t, registry.Register("", rakuten))
+
+	tests := []struct {
+		name       string
+		itemID     model.ItemID
+		wantPrefix string
+		wantFound  bool
+	}{
+		{name: "接頭辞が一致する", itemID: "RK000001", wantPrefix: "RK", wantFound: true},
+		{name: "長い接頭辞を優先する", itemID: "RKB00001", wantPrefix: "RKB", wantFound: true},
+		{name: "一致する接頭辞が無い", itemID: "AM000001", wantFound: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix, _, found := registry.Lookup(tt.itemID)
+			assert.Equal(t, tt.wantPrefix, prefix)
+			assert.Equal(t, tt.wantFound, found)
+		})
+	}
+}
+
+func TestNewAffiliateItemProviderRegistryFromConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *config.AffiliateItemConfig
+		wantErr bool
+	}{
+		{
+			name: "楽天の親案件を設定から生成する",
+			config: &config.AffiliateItemConfig{Providers: []config.AffiliateItemProviderConfig{
+				{Prefix: "RK", Driver: AffiliateItemDriverRakuten, ParentItem: &config.ParentItemConfig{ID: "RK000001", CommissionRate: 4}},
+			}},
+		},
+		{
+			name: "楽天の親案件が設定されていない",
+			config: &config.AffiliateItemConfig{Providers: []config.AffiliateItemProviderConfig{
+				{Prefix: "RK", Driver: AffiliateItemDriverRakuten},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "affiliate-item サービスの接続先が設定されていない",
+			config: &config.AffiliateItemConfig{Providers: []config.AffiliateItemProviderConfig{
+				{Prefix: "AF", Driver: AffiliateItemDriverGRPC},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "不明な driver",
+			config: &config.AffiliateItemConfig{Providers: []config.AffiliateItemProviderConfig{
+				{Prefix: "XX", Driver: "unknown"},
+			}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewAffiliateItemProviderRegistryFromConfig(tt.config, nil, nil)
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestAffiliateItemAdapterImpl_BulkGetItems(t *testing.T) {
+	rate := float32(4)
+	parentItem := model.NewAffiliateItemItem("RK000001", "", "楽天市場", model.NewAffiliateItemCommission(&rate, nil, &rate, ""), "楽天株式会社", model.URLMap{}, true)
+	rakuten := &fakeAffiliateItemProvider{parentItem: parentItem}
+
+	registry := NewAffiliateItemProviderRegistry()
+	assert.NoError(t, registry.Register("RK", rakuten))
+	assert.NoError(t, registry.Register("AM", newTestCatalogProvider(t)))
+	itemCache, err := cache.NewMemoryCache()
+	assert.NoError(t, err)
+	a := NewAffiliateItemAdapterImpl(registry, itemCache, nil)
+
+	rakutenItem := *model.NewItemIdentifier("RK000001", "")
+	amazonItem := *model.NewItemIdentifier("AM000001", "")
+	amazonDFItem := *model.NewItemIdentifier("AM000001", "B000000001")
+	unknownDFItem := *model.NewItemIdentifier("AM000001", "B999999999")
+	unknownProviderItem := *model.NewItemIdentifier("XX000001", "")
+
+	got, unavailable, err := a.BulkGetItems(context.Background(), model.ItemIdentifiers{rakutenItem, amazonItem, amazonDFItem, unknownDFItem, unknownProviderItem})
+	assert.NoError(t, err)
+	assert.Empty(t, unavailable)
+	assert.Len(t, got, 3)
+
+	rakutenItems, amazonItems, amazonDFItems := got[rakutenItem], got[amazonItem], got[amazonDFItem]
+	assert.Equal(t, "楽天市場", rakutenItems.Item.Name())
+	assert.Equal(t, "Amazon", amazonItems.Item.Name())
+	assert.Equal(t, model.ItemID("AM000001"), amazonDFItems.Item.ID())
+	assert.Equal(t, model.DFItemID("B000000001"), amazonDFItems.DFItem.ID())
+	assert.Equal(t, "https://example.com/1.png", amazonDFItems.DFItem.Img())
+
+	// 取得元には接頭辞が一致する案件のみを渡す
+	assert.Equal(t, model.ItemIdentifiers{rakutenItem}, rakuten.requested)
+}
+
+// countingAffiliateItemProvider 取得した回数を記録し、設定された案件名の親案件を返す
+type countingAffiliateItemProvider struct {
+	mu    sync.Mutex
+	name  string
+	calls int
+}
+
+func (p *countingAffiliateItemProvider) GetAffiliateItems(_ context.Context, _ model.ItemIdentifiers) (model.AffiliateItemPairList, model.ItemIdentifiers, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+	rate := float32(4)
+	parentItem := model.NewAffiliateItemItem("RK000001", "", p.name, model.NewAffiliateItemCommission(&rate, nil, &rate, ""), "楽天株式会社", model.URLMap{}, true)
+	return model.AffiliateItemPairList{model.NewAffiliateItemPair(parentItem, nil)}, nil, nil
+}
+
+func (p *countingAffiliateItemProvider) rename(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.name = name
+}
+
+func (p *countingAffiliateItemProvider) callCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.calls
+}
+
+// Redis のキャッシュを共有する adapter を生成する
+func newTestCachedAdapter(t *testing.T, server *miniredis.Miniredis, provider AffiliateItemProvider, now func() time.Time) *AffiliateItemAdapterImpl {
+	t.Helper()
+	registry := NewAffiliateItemProviderRegistry()
+	assert.NoError(t, registry.Register("RK", provider))
+	conf := &config.AffiliateItemConfig{Cache: &config.CacheConfig{
+		Driver:   cache.DriverRedis,
+		TTL:      libtime.Duration{Duration: time.Hour},
+		StaleTTL: libtime.Duration{Duration: time.Hour},
+		Redis:    config.RedisConfig{Addr: server.Addr()},
+	}}
+	itemCache, err := NewAffiliateItemCache(conf)
+	assert.NoError(t, err)
+	a := NewAffiliateItemAdapterImpl(registry, itemCache, conf).(*AffiliateItemAdapterImpl)
+	a.now = now
+	return a
+}
+
+func TestAffiliateItemAdapterImpl_GetItems_Cache(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	provider := &countingAffiliateItemProvider{name: "楽天市場"}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	// 同じキャッシュを参照する 2 つのレプリカ
+	replica1 := newTestCachedAdapter(t, server, provider, clock)
+	replica2 := newTestCachedAdapter(t, server, provider, clock)
+	identifier := *model.NewItemIdentifier("RK000001", "")
+
+	getName := func(a *AffiliateItemAdapterImpl) string {
+		t.Helper()
+		items, err := a.GetItems(ctx, identifier)
+		assert.NoError(t, err)
+		return items.Item.Name()
+	}
+
+	// 他のレプリカが取得した案件情報を使用する
+	assert.Equal(t, "楽天市場", getName(replica1))
+	assert.Equal(t, "楽天市場", getName(replica2))
+	assert.Equal(t, 1, provider.callCount())
+
+	// TTL の経過後は古い値を返し、バックグラウンドで再取得する
+	provider.rename("楽天市場 (新)")
+	now = now.Add(time.Hour)
+	server.FastForward(time.Hour)
+	assert.Equal(t, "楽天市場", getName(replica1))
+	assert.Eventually(t, func() bool {
+		replica1.mu.Lock()
+		defer replica1.mu.Unlock()
+		return len(replica1.revalidating) == 0
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, "楽天市場 (新)", getName(replica2))
+	assert.Equal(t, 2, provider.callCount())
+
+	// 管理者がキャッシュを削除した場合は、次の取得時に取得元から取得する
+	provider.rename("楽天市場 (更新)")
+	assert.NoError(t, replica1.InvalidateItems(ctx, identifier))
+	assert.Equal(t, "楽天市場 (更新)", getName(replica2))
+	assert.Equal(t, 3, provider.callCount())
+
+	// DF案件IDを指定しない場合は、案件IDに紐づくDF案件のキャッシュも削除する
+	assert.NoError(t, replica1.cache.Set(ctx, "RK000001#df1", []byte("{}"), time.Hour))
+	assert.NoError(t, replica1.cache.Set(ctx, "RK0000010#df1", []byte("{}"), time.Hour))
+	assert.NoError(t, replica1.InvalidateItems(ctx, identifier))
+	assert.Equal(t, []string{"RK0000010#df1"}, server.Keys())
+	assert.Equal(t, "楽天市場 (更新)", getName(replica2))
+	assert.Equal(t, 4, provider.callCount())
+
+	// TTL と stale_ttl の経過後は、取得元から取得するまで待つ
+	provider.rename("楽天市場 (期限切れ)")
+	now = now.Add(2 * time.Hour)
+	server.FastForward(2 * time.Hour)
+	assert.Equal(t, "楽天市場 (期限切れ)", getName(replica1))
+	assert.Equal(t, 5, provider.callCount())
+}
+
+func TestAffiliateItemCacheEntry(t *testing.T) {
+	minCommission, err := model.NewCommission(model.CommissionTypeFixedRate, 1.5)
+	assert.NoError(t, err)
+	maxCommission, err := model.NewCommission(model.CommissionTypeFixedRate, 4)
+	assert.NoError(t, err)
+	url, err := model.NewPlatformURL(model.PlatformTypeAll, "https://example.com/item")
+	assert.NoError(t, err)
+	item, err := model.NewItem("RK000001", "https://example.com/logo.png", "楽天市場", minCommission, maxCommission, []*model.PlatformURL{url}, true, "楽天株式会社", true, true)
+	assert.NoError(t, err)
+	dfItem := model.NewDFItem("shop:10000001", "https://example.com/1.png", "商品 1", maxCommission, maxCommission, []*model.PlatformURL{url})
+	storedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		items model.Items
+	}{
+		{name: "案件のみ", items: model.Items{Item: *item}},
+		{name: "DF案件を含む", items: model.Items{Item: *item, DFItem: *dfItem}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := encodeAffiliateItemCacheEntry(tt.items, storedAt)
+			assert.NoError(t, err)
+			got, gotStoredAt, err := decodeAffiliateItemCacheEntry(b)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.items, *got)
+			assert.True(t, storedAt.Equal(gotStoredAt))
+		})
+	}
+}
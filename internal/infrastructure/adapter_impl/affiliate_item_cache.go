@@ -0,0 +1,195 @@
+package adapter_impl
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/cache"
+)
+
+// NewAffiliateItemCache 案件情報のキャッシュを設定に応じて生成する
+func NewAffiliateItemCache(config *config.AffiliateItemConfig) (cache.Cache, error) {
+	if config == nil {
+		return cache.NewCache(nil)
+	}
+	return cache.NewCache(config.Cache)
+}
+
+// キャッシュのキー。DF案件を指定した場合は DF案件IDを含める
+func affiliateItemCacheKey(itemIdentifier model.ItemIdentifier) string {
+	if itemIdentifier.DFItemID().String() == "" {
+		return itemIdentifier.ItemID().String()
+	}
+	return affiliateItemCacheKeyPrefix(itemIdentifier.ItemID()) + itemIdentifier.DFItemID().String()
+}
+
+// 案件IDに紐づく全てのDF案件のキャッシュのキーの接頭辞
+func affiliateItemCacheKeyPrefix(itemID model.ItemID) string {
+	return itemID.String() + "#"
+}
+
+// affiliateItemCacheEntry キャッシュに保存する案件情報
+// 最新とみなす期間を判定するため、取得した時刻を含める
+type affiliateItemCacheEntry struct {
+	StoredAt time.Time   `json:"stored_at"`
+	Item     cachedItem  `json:"item"`
+	DFItem   *cachedItem `json:"df_item,omitempty"`
+}
+
+type cachedItem struct {
+	ID                string              `json:"id"`
+	Img               string              `json:"img"`
+	Name              string              `json:"name"`
+	MinCommissionRate *cachedCommission   `json:"min_commission_rate,omitempty"`
+	MaxCommissionRate *cachedCommission   `json:"max_commission_rate,omitempty"`
+	URLs              []cachedPlatformURL `json:"urls,omitempty"`
+	HasTieup          bool                `json:"has_tieup,omitempty"`
+	ContentName       string              `json:"content_name,omitempty"`
+	EnabledSelfBack   bool                `json:"enabled_self_back,omitempty"`
+	IsDF              bool                `json:"is_df,omitempty"`
+}
+
+type cachedCommission struct {
+	CommissionType int     `json:"commission_type"`
+	CalculatedRate float32 `json:"calculated_rate"`
+}
+
+type cachedPlatformURL struct {
+	PlatformType int    `json:"platform_type"`
+	URL          string `json:"url"`
+}
+
+func encodeAffiliateItemCacheEntry(items model.Items, storedAt time.Time) ([]byte, error) {
+	entry := affiliateItemCacheEntry{
+		StoredAt: storedAt,
+		Item: cachedItem{
+			ID:                items.Item.ID().String(),
+			Img:               items.Item.Img(),
+			Name:              items.Item.Name(),
+			MinCommissionRate: newCachedCommission(items.Item.MinCommissionRate()),
+			MaxCommissionRate: newCachedCommission(items.Item.MaxCommissionRate()),
+			URLs:              newCachedPlatformURLs(items.Item.Urls()),
+			HasTieup:          items.Item.HasTieup(),
+			ContentName:       items.Item.ContentName(),
+			EnabledSelfBack:   items.Item.EnabledSelfBack(),
+			IsDF:              items.Item.IsDF(),
+		},
+	}
+	if items.DFItem.ID() != "" {
+		entry.DFItem = &cachedItem{
+			ID:                items.DFItem.ID().String(),
+			Img:               items.DFItem.Img(),
+			Name:              items.DFItem.Name(),
+			MinCommissionRate: newCachedCommission(items.DFItem.MinCommissionRate()),
+			MaxCommissionRate: newCachedCommission(items.DFItem.MaxCommissionRate()),
+			URLs:              newCachedPlatformURLs(items.DFItem.Urls()),
+		}
+	}
+	return json.Marshal(entry)
+}
+
+func decodeAffiliateItemCacheEntry(b []byte) (*model.Items, time.Time, error) {
+	var entry affiliateItemCacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, time.Time{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	minCommissionRate, err := entry.Item.MinCommissionRate.toModel()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("entry.Item.MinCommissionRate.toModel: %w", err)
+	}
+	maxCommissionRate, err := entry.Item.MaxCommissionRate.toModel()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("entry.Item.MaxCommissionRate.toModel: %w", err)
+	}
+	urls, err := toPlatformURLs(entry.Item.URLs)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("toPlatformURLs: %w", err)
+	}
+	item, err := model.NewItem(
+		model.ItemID(entry.Item.ID),
+		entry.Item.Img,
+		entry.Item.Name,
+		minCommissionRate,
+		maxCommissionRate,
+		urls,
+		entry.Item.HasTieup,
+		entry.Item.ContentName,
+		entry.Item.EnabledSelfBack,
+		entry.Item.IsDF,
+	)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("model.NewItem: %w", err)
+	}
+
+	items := &model.Items{Item: *item}
+	if entry.DFItem != nil {
+		minCommissionRate, err := entry.DFItem.MinCommissionRate.toModel()
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("entry.DFItem.MinCommissionRate.toModel: %w", err)
+		}
+		maxCommissionRate, err := entry.DFItem.MaxCommissionRate.toModel()
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("entry.DFItem.MaxCommissionRate.toModel: %w", err)
+		}
+		urls, err := toPlatformURLs(entry.DFItem.URLs)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("toPlatformURLs: %w", err)
+		}
+		items.DFItem = *model.NewDFItem(
+			model.DFItemID(entry.DFItem.ID),
+			entry.DFItem.Img,
+			entry.DFItem.Name,
+			minCommissionRate,
+			maxCommissionRate,
+			urls,
+		)
+	}
+	return items, entry.StoredAt, nil
+}
+
+func newCachedCommission(c *model.Commission) *cachedCommission {
+	if c == nil {
+		return nil
+	}
+	return &cachedCommission{
+		CommissionType: c.CommissionType().Int(),
+		CalculatedRate: c.CalculatedRate(),
+	}
+}
+
+func (c *cachedCommission) toModel() (*model.Commission, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return model.NewCommission(model.ConvertCommissionType(c.CommissionType), c.CalculatedRate)
+}
+
+func newCachedPlatformURLs(urls []*model.PlatformURL) []cachedPlatformURL {
+	res := make([]cachedPlatformURL, 0, len(urls))
+	for _, u := range urls {
+		if u == nil {
+			continue
+		}
+		res = append(res, cachedPlatformURL{PlatformType: u.PlatformType().Int(), URL: u.URL()})
+	}
+	return res
+}
+
+func toPlatformURLs(urls []cachedPlatformURL) ([]*model.PlatformURL, error) {
+	if len(urls) == 0 {
+		return nil, nil
+	}
+	res := make([]*model.PlatformURL, 0, len(urls))
+	for _, u := range urls {
+		platformURL, err := model.NewPlatformURL(model.PlatformType(u.PlatformType), u.URL)
+		if err != nil {
+			return nil, fmt.Errorf("model.NewPlatformURL: %w", err)
+		}
+		res = append(res, platformURL)
+	}
+	return res, nil
+}
@@ -0,0 +1,128 @@
+package adapter_impl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	commonConf "github.com/terui-ryota/offer-item/internal/common/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/rakuten"
+)
+
+const (
+	AffiliateItemDriverRakuten = "rakuten"
+	AffiliateItemDriverGRPC    = "grpc"
+	AffiliateItemDriverCatalog = "catalog"
+)
+
+// AffiliateItemProvider ASP ごとの案件情報の取得元
+type AffiliateItemProvider interface {
+	// 案件情報と DF 案件情報の組を取得する。取得できなかった案件は結果に含めない
+	// 取得元の障害により取得できなかった案件は、商品の削除などと区別できるよう unavailable で返す
+	GetAffiliateItems(ctx context.Context, identifiers model.ItemIdentifiers) (pairs model.AffiliateItemPairList, unavailable model.ItemIdentifiers, err error)
+}
+
+// AffiliateItemProviderRegistry 案件IDの接頭辞ごとに案件情報の取得元を保持する
+type AffiliateItemProviderRegistry struct {
+	providers map[string]AffiliateItemProvider
+	// 長い接頭辞を優先して照合するため、長さの降順に並べる
+	prefixes []string
+}
+
+func NewAffiliateItemProviderRegistry() *AffiliateItemProviderRegistry {
+	return &AffiliateItemProviderRegistry{
+		providers: make(map[string]AffiliateItemProvider),
+	}
+}
+
+// NewAffiliateItemProviderRegistryFromConfig 設定の driver に応じた取得元を接頭辞ごとに登録する
+func NewAffiliateItemProviderRegistryFromConfig(
+	config *config.AffiliateItemConfig,
+	externalContexts *commonConf.ExternalContexts,
+	rakutenClient *rakuten.RakutenIchibaClient,
+) (*AffiliateItemProviderRegistry, error) {
+	if config == nil {
+		return nil, fmt.Errorf("affiliate item config is required")
+	}
+
+	registry := NewAffiliateItemProviderRegistry()
+	for _, c := range config.Providers {
+		var provider AffiliateItemProvider
+		switch c.Driver {
+		case AffiliateItemDriverRakuten:
+			parentItem, err := newParentAffiliateItemItem(c.ParentItem)
+			if err != nil {
+				return nil, fmt.Errorf("newParentAffiliateItemItem: %w", err)
+			}
+			provider = NewRakutenAffiliateItemProvider(rakutenClient, parentItem)
+		case AffiliateItemDriverGRPC:
+			if externalContexts == nil || externalContexts.AffiliateItem == nil {
+				return nil, fmt.Errorf("external_contexts.affiliate_item is required for driver: %s", c.Driver)
+			}
+			p, err := NewGRPCAffiliateItemProvider(externalContexts.AffiliateItem)
+			if err != nil {
+				return nil, fmt.Errorf("NewGRPCAffiliateItemProvider: %w", err)
+			}
+			provider = p
+		case AffiliateItemDriverCatalog:
+			p, err := NewCatalogAffiliateItemProvider(c.CatalogPath)
+			if err != nil {
+				return nil, fmt.Errorf("NewCatalogAffiliateItemProvider: %w", err)
+			}
+			provider = p
+		default:
+			return nil, fmt.Errorf("unknown affiliate item driver: %s", c.Driver)
+		}
+
+		if err := registry.Register(c.Prefix, provider); err != nil {
+			return nil, fmt.Errorf("registry.Register: %w", err)
+		}
+	}
+	return registry, nil
+}
+
+// Register 接頭辞に一致する案件IDの取得元を登録する
+func (r *AffiliateItemProviderRegistry) Register(prefix string, provider AffiliateItemProvider) error {
+	if prefix == "" {
+		return fmt.Errorf("prefix is required")
+	}
+	if _, ok := r.providers[prefix]; ok {
+		return fmt.Errorf("duplicate prefix: %s", prefix)
+	}
+	r.providers[prefix] = provider
+	r.prefixes = append(r.prefixes, prefix)
+	sort.SliceStable(r.prefixes, func(i, j int) bool { return len(r.prefixes[i]) > len(r.prefixes[j]) })
+	return nil
+}
+
+// Lookup 案件IDに対応する取得元を返す。一致する接頭辞が無い場合は空文字と false を返す
+func (r *AffiliateItemProviderRegistry) Lookup(itemID model.ItemID) (string, AffiliateItemProvider, bool) {
+	for _, prefix := range r.prefixes {
+		if strings.HasPrefix(itemID.String(), prefix) {
+			return prefix, r.providers[prefix], true
+		}
+	}
+	return "", nil, false
+}
+
+// 設定から DF 案件の親となる案件を生成する
+func newParentAffiliateItemItem(c *config.ParentItemConfig) (*model.AffiliateItemItem, error) {
+	if c == nil || c.ID == "" {
+		return nil, fmt.Errorf("parent_item.id is required")
+	}
+	rate := c.CommissionRate
+	staticRate := c.StaticCommissionRate
+	commission := model.NewAffiliateItemCommission(&rate, nil, &staticRate, "")
+	return model.NewAffiliateItemItem(
+		model.ItemID(c.ID),
+		c.ImageURL,
+		c.Name,
+		commission,
+		c.ContentName,
+		model.URLMap{model.PlatformTypeAll: c.URL},
+		true,
+	), nil
+}
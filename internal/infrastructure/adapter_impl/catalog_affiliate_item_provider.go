@@ -0,0 +1,118 @@
+package adapter_impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+)
+
+// 案件カタログの JSON ファイルの形式
+type affiliateItemCatalog struct {
+	Items []affiliateItemCatalogItem `json:"items"`
+}
+
+type affiliateItemCatalogItem struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	ImageURL    string  `json:"image_url"`
+	ContentName string  `json:"content_name"`
+	URL         string  `json:"url"`
+	Rate        float32 `json:"commission_rate"`
+	// 案件に紐づく DF 案件
+	DFItems []affiliateItemCatalogDFItem `json:"df_items"`
+}
+
+type affiliateItemCatalogDFItem struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	URL      string  `json:"url"`
+	ImageURL string  `json:"image_url"`
+	ShopName string  `json:"shop_name"`
+	ShopCode string  `json:"shop_code"`
+	Price    int64   `json:"price"`
+	Rate     float32 `json:"commission_rate"`
+}
+
+// CatalogAffiliateItemProvider JSON ファイルに定義した案件を返す
+// 外部の ASP に接続できないローカル環境での動作確認に使用する
+type CatalogAffiliateItemProvider struct {
+	items   map[model.ItemID]*model.AffiliateItemItem
+	dfItems map[model.ItemID]map[model.DFItemID]*model.AffiliateItemDFItem
+}
+
+func NewCatalogAffiliateItemProvider(path string) (*CatalogAffiliateItemProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+	var catalog affiliateItemCatalog
+	if err := json.Unmarshal(content, &catalog); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	p := &CatalogAffiliateItemProvider{
+		items:   make(map[model.ItemID]*model.AffiliateItemItem, len(catalog.Items)),
+		dfItems: make(map[model.ItemID]map[model.DFItemID]*model.AffiliateItemDFItem, len(catalog.Items)),
+	}
+	for _, item := range catalog.Items {
+		if item.ID == "" {
+			return nil, fmt.Errorf("catalog item id is required")
+		}
+		itemID := model.ItemID(item.ID)
+		rate := item.Rate
+		commission := model.NewAffiliateItemCommission(&rate, nil, &rate, "")
+		p.items[itemID] = model.NewAffiliateItemItem(
+			itemID,
+			item.ImageURL,
+			item.Name,
+			commission,
+			item.ContentName,
+			model.URLMap{model.PlatformTypeAll: item.URL},
+			len(item.DFItems) > 0,
+		)
+
+		dfItems := make(map[model.DFItemID]*model.AffiliateItemDFItem, len(item.DFItems))
+		for _, dfItem := range item.DFItems {
+			dfRate := dfItem.Rate
+			dfCommission := model.NewAffiliateItemCommission(&dfRate, nil, &dfRate, "")
+			var material model.MaterialList
+			if dfItem.ImageURL != "" {
+				material = model.MaterialList{{MaterialType: model.Image, Value: dfItem.ImageURL}}
+			}
+			dfItems[model.DFItemID(dfItem.ID)] = model.NewAffiliateItemDFItem(
+				dfItem.ID,
+				dfItem.Name,
+				dfItem.URL,
+				material,
+				dfItem.ShopName,
+				dfItem.ShopCode,
+				itemID,
+				model.AffiliateItemDFItemPriceList{{SalePrice: model.Price(dfItem.Price), RetailPrice: model.Price(dfItem.Price)}},
+				model.AffiliateItemCommissionList{dfCommission},
+			)
+		}
+		p.dfItems[itemID] = dfItems
+	}
+	return p, nil
+}
+
+func (p *CatalogAffiliateItemProvider) GetAffiliateItems(_ context.Context, identifiers model.ItemIdentifiers) (model.AffiliateItemPairList, model.ItemIdentifiers, error) {
+	affiliateItemPairList := make(model.AffiliateItemPairList, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		item, ok := p.items[identifier.ItemID()]
+		if !ok {
+			continue
+		}
+		if identifier.DFItemID() == "" {
+			affiliateItemPairList = append(affiliateItemPairList, model.NewAffiliateItemPair(item, nil))
+			continue
+		}
+		if dfItem, ok := p.dfItems[identifier.ItemID()][identifier.DFItemID()]; ok {
+			affiliateItemPairList = append(affiliateItemPairList, model.NewAffiliateItemPair(item, dfItem))
+		}
+	}
+	return affiliateItemPairList, nil, nil
+}
@@ -0,0 +1,46 @@
+package adapter_impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	commonConf "github.com/terui-ryota/offer-item/internal/common/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"go.opencensus.io/plugin/ocgrpc"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// GRPCAffiliateItemProvider affiliate-item サービスから案件情報を取得する
+type GRPCAffiliateItemProvider struct {
+	conn *grpc.ClientConn
+}
+
+func NewGRPCAffiliateItemProvider(config *commonConf.ContextConfig) (*GRPCAffiliateItemProvider, error) {
+	// 接続は最初のリクエスト時に確立される
+	conn, err := grpc.Dial(
+		net.JoinHostPort(config.Host, config.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(&ocgrpc.ClientHandler{
+			StartOptions: trace.StartOptions{
+				Sampler: trace.ProbabilitySampler(0.5),
+			},
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("grpc.Dial: %w", err)
+	}
+	return &GRPCAffiliateItemProvider{conn: conn}, nil
+}
+
+// TODO: protofiles に affiliate_item のクライアントが追加されたら、AffiliateItemHandler を呼び出して案件情報を取得する
+func (p *GRPCAffiliateItemProvider) GetAffiliateItems(ctx context.Context, identifiers model.ItemIdentifiers) (model.AffiliateItemPairList, model.ItemIdentifiers, error) {
+	_, span := trace.StartSpan(ctx, "GRPCAffiliateItemProvider.GetAffiliateItems")
+	defer span.End()
+
+	return nil, nil, apperr.AffiliateItemInternalError.Wrap(errors.New("affiliate_item client is not available"))
+}
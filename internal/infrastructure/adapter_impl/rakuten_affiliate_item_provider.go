@@ -0,0 +1,151 @@
+package adapter_impl
+
+import (
+	"context"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/rakuten"
+	"github.com/terui-ryota/offer-item/pkg/logger"
+	"go.opencensus.io/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// RakutenAffiliateItemProvider 楽天市場の商品を DF 案件として取得する
+// 楽天市場の商品は全て設定の親案件に紐づける
+type RakutenAffiliateItemProvider struct {
+	rakutenClient *rakuten.RakutenIchibaClient
+	parentItem    *model.AffiliateItemItem
+}
+
+func NewRakutenAffiliateItemProvider(rakutenClient *rakuten.RakutenIchibaClient, parentItem *model.AffiliateItemItem) *RakutenAffiliateItemProvider {
+	return &RakutenAffiliateItemProvider{
+		rakutenClient: rakutenClient,
+		parentItem:    parentItem,
+	}
+}
+
+// 商品は並行して取得する。楽天APIのリクエスト数の上限はクライアントで制御する
+func (p *RakutenAffiliateItemProvider) GetAffiliateItems(ctx context.Context, identifiers model.ItemIdentifiers) (model.AffiliateItemPairList, model.ItemIdentifiers, error) {
+	ctx, span := trace.StartSpan(ctx, "RakutenAffiliateItemProvider.GetAffiliateItems")
+	defer span.End()
+
+	// 同じ商品を重複して取得しないよう、商品コードごとにまとめる
+	ids := make([]string, 0, len(identifiers))
+	requestedItemIDs := make(map[string]model.ItemID, len(identifiers))
+	for _, itemPair := range identifiers {
+		id := itemPair.DFItemID().String()
+		if id == "" || id == p.parentItem.ItemId().String() {
+			continue
+		}
+		if _, ok := requestedItemIDs[id]; ok {
+			continue
+		}
+		requestedItemIDs[id] = itemPair.ItemID()
+		ids = append(ids, id)
+	}
+
+	// 結果は指定された順に並べるため、商品コードの位置に格納する
+	results := make([]model.AffiliateItemDFItemList, len(ids))
+	// 楽天APIの障害により取得できなかった商品コードの位置
+	unavailable := make([]bool, len(ids))
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(p.rakutenClient.Concurrency())
+	for i, id := range ids {
+		i, id := i, id
+		eg.Go(func() error {
+			result, err := p.rakutenClient.GetItemsByItemId(egCtx, id)
+			if err != nil {
+				switch err := err.(type) {
+				case rakuten.TooManyRequestsErr:
+					// 楽天API でリクエスト数超過が出た場合は400系で返却するためにTooManyRequestErrorで返却する
+					return rakuten.NewASPTooManyRequestError(requestedItemIDs[id])
+				case rakuten.CircuitOpenErr:
+					// 楽天APIの障害中は取得元の障害として返し、呼び出し元で下書きの案件情報を使用する
+					logger.Default().Warn("Skip rakuten request while circuit breaker is open", zap.String("id", id))
+					unavailable[i] = true
+					return nil
+				case rakuten.UnavailableErr:
+					logger.Default().Warn("Rakuten is unavailable", zap.String("id", id), zap.Error(err))
+					unavailable[i] = true
+					return nil
+				default:
+					// 正常に取得できた item は返却する必要があるので、リクエスト数超過以外のエラーはここでエラーログで出力し処理を続行する。
+					logger.Default().Error("Failed to get rakuten items by ids", zap.String("id", id), zap.Error(err))
+					return nil
+				}
+			}
+			results[i] = convertRakutenAffiliateItemDFItems(result, p.parentItem.ItemId())
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	unavailableIDs := make(map[string]struct{})
+	for i, id := range ids {
+		if unavailable[i] {
+			unavailableIDs[id] = struct{}{}
+		}
+	}
+	// 重複して指定された案件も含め、取得できなかった商品コードの案件を全て返す
+	var unavailableIdentifiers model.ItemIdentifiers
+	for _, itemPair := range identifiers {
+		if _, ok := unavailableIDs[itemPair.DFItemID().String()]; ok {
+			unavailableIdentifiers = append(unavailableIdentifiers, itemPair)
+		}
+	}
+
+	// 親案件のみを指定された場合にも返せるよう、親案件は常に含める
+	affiliateItemPairList := model.AffiliateItemPairList{model.NewAffiliateItemPair(p.parentItem, nil)}
+	for _, affiliateItemDFItemList := range results {
+		for _, affiliateItemDFItem := range affiliateItemDFItemList {
+			affiliateItemPairList = append(affiliateItemPairList, model.NewAffiliateItemPair(p.parentItem, affiliateItemDFItem))
+		}
+	}
+	return affiliateItemPairList, unavailableIdentifiers, nil
+}
+
+// convertRakutenAffiliateItemDFItems はrakuten商品検索APIの検索結果をAffiliateItemDFItemModelに変換します。
+func convertRakutenAffiliateItemDFItems(result *rakuten.ItemResult, itemId model.ItemID) model.AffiliateItemDFItemList {
+	// 楽天のDF案件
+	affiliateItemDFItemList := make(model.AffiliateItemDFItemList, 0, len(result.Items))
+	for _, resultItem := range result.Items {
+		affiliateRate := float32(resultItem.Item.AffiliateRate)
+		commissionRate := model.NewAffiliateItemCommission(
+			&affiliateRate,
+			&affiliateRate,
+			&affiliateRate,
+			"",
+		)
+
+		// 商品URL
+		itemUrl, err := resultItem.Item.ParsedItemUrl()
+		if err != nil {
+			logger.Default().Warn("Failed to parse url.", zap.String("url", resultItem.Item.AffiliateURL))
+		}
+
+		// 金額
+		price := model.AffiliateItemDFItemPriceList{
+			model.AffiliateItemDFItemPrice{
+				SalePrice:   model.Price(resultItem.Item.ItemPrice),
+				RetailPrice: model.Price(resultItem.Item.ItemPrice),
+			},
+		}
+		affiliateItemDFItem := model.NewAffiliateItemDFItem(
+			resultItem.Item.ItemCode,
+			resultItem.Item.ItemName, // CatchcopyはCarrierによって変わる（PC、スマホ、などなど）
+			itemUrl,
+			resultItem.MakeMaterial(),
+			resultItem.Item.ShopName,
+			resultItem.Item.ShopCode,
+			itemId,
+			price,
+			model.AffiliateItemCommissionList{commissionRate},
+		)
+
+		affiliateItemDFItemList.Append(affiliateItemDFItem)
+	}
+	return affiliateItemDFItemList
+}
@@ -0,0 +1,161 @@
+package adapter_impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/rakuten"
+)
+
+// fakeRakutenIchiba アプリIDごとのリクエスト間隔を検証する楽天商品検索APIのフェイク
+// 間隔が短い場合は楽天APIと同じく too_many_requests を返す
+type fakeRakutenIchiba struct {
+	interval time.Duration
+
+	mu          sync.Mutex
+	lastRequest map[string]time.Time
+	requests    map[string]int
+	violations  int
+	inFlight    int
+	maxInFlight int
+}
+
+func (f *fakeRakutenIchiba) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	applicationID := r.URL.Query().Get("applicationId")
+	itemCode := r.URL.Query().Get("itemCode")
+
+	f.mu.Lock()
+	now := time.Now()
+	last, ok := f.lastRequest[applicationID]
+	f.lastRequest[applicationID] = now
+	f.requests[applicationID]++
+	// タイマーの誤差を許容する
+	if ok && now.Sub(last) < f.interval*9/10 {
+		f.violations++
+		f.mu.Unlock()
+		w.WriteHeader(http.StatusTooManyRequests)
+		_ = json.NewEncoder(w).Encode(rakuten.RakutenError{Error: "too_many_requests"})
+		return
+	}
+	f.inFlight++
+	if f.inFlight > f.maxInFlight {
+		f.maxInFlight = f.inFlight
+	}
+	f.mu.Unlock()
+
+	// 並行してリクエストされるよう、応答に時間をかける
+	time.Sleep(20 * time.Millisecond)
+
+	f.mu.Lock()
+	f.inFlight--
+	f.mu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(rakuten.ItemResult{Items: []rakuten.ItemResponse{{Item: rakuten.Item{
+		ItemCode:      itemCode,
+		ItemName:      "商品 " + itemCode,
+		ItemPrice:     1000,
+		AffiliateRate: 4,
+	}}}})
+}
+
+func TestRakutenAffiliateItemProvider_GetAffiliateItems(t *testing.T) {
+	const (
+		rateLimit   = 20
+		concurrency = 4
+		itemCount   = 24
+	)
+	fake := &fakeRakutenIchiba{
+		interval:    time.Second / rateLimit,
+		lastRequest: make(map[string]time.Time),
+		requests:    make(map[string]int),
+	}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	rakutenConfig := &config.RakutenConfig{
+		ApplicationID: []string{"app1", "app2", "app3"},
+		RateLimit:     rateLimit,
+		Concurrency:   concurrency,
+		RakutenIchiba: config.RakutenIchibaConfig{Format: "json", ItemSearchURL: server.URL},
+	}
+	client := rakuten.NewRakutenIchibaClient(rakutenConfig, server.Client(), rakuten.NewApplicationIDHelper(rakutenConfig))
+	parentItem, err := newParentAffiliateItemItem(&config.ParentItemConfig{ID: "RK000001", Name: "楽天市場", CommissionRate: 4})
+	assert.NoError(t, err)
+	p := NewRakutenAffiliateItemProvider(client, parentItem)
+
+	identifiers := make(model.ItemIdentifiers, 0, itemCount+1)
+	for i := 0; i < itemCount; i++ {
+		identifiers = append(identifiers, *model.NewItemIdentifier("RK000001", model.DFItemID(fmt.Sprintf("shop:%03d", i))))
+	}
+	// 同じ商品は 1 回だけ取得する
+	identifiers = append(identifiers, identifiers[0])
+
+	got, unavailable, err := p.GetAffiliateItems(context.Background(), identifiers)
+	assert.NoError(t, err)
+	assert.Empty(t, unavailable)
+
+	// 親案件と、指定した順の DF 案件
+	if assert.Len(t, got, itemCount+1) {
+		assert.Nil(t, got[0].AffiliateItemDFItem())
+		for i := 0; i < itemCount; i++ {
+			assert.Equal(t, model.AffiliateItemDFItemID(fmt.Sprintf("shop:%03d", i)), got[i+1].AffiliateItemDFItem().DfItemId())
+			assert.Equal(t, model.ItemID("RK000001"), got[i+1].AffiliateItemItem().ItemId())
+		}
+	}
+
+	assert.Zero(t, fake.violations)
+	assert.Len(t, fake.requests, len(rakutenConfig.ApplicationID))
+	for id, n := range fake.requests {
+		assert.Equal(t, itemCount/len(rakutenConfig.ApplicationID), n, id)
+	}
+	assert.Greater(t, fake.maxInFlight, 1)
+	assert.LessOrEqual(t, fake.maxInFlight, concurrency)
+}
+
+func TestRakutenAffiliateItemProvider_GetAffiliateItems_Unavailable(t *testing.T) {
+	// "error:" で始まる商品コードは 5xx、"missing:" で始まる商品コードは不正なリクエストとして返す
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		itemCode := r.URL.Query().Get("itemCode")
+		switch {
+		case strings.HasPrefix(itemCode, "error:"):
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case strings.HasPrefix(itemCode, "missing:"):
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(rakuten.RakutenError{Error: "wrong_parameter"})
+		default:
+			_ = json.NewEncoder(w).Encode(rakuten.ItemResult{Items: []rakuten.ItemResponse{{Item: rakuten.Item{ItemCode: itemCode}}}})
+		}
+	}))
+	defer server.Close()
+
+	rakutenConfig := &config.RakutenConfig{
+		ApplicationID: []string{"app1"},
+		RakutenIchiba: config.RakutenIchibaConfig{Format: "json", ItemSearchURL: server.URL},
+	}
+	client := rakuten.NewRakutenIchibaClient(rakutenConfig, server.Client(), rakuten.NewApplicationIDHelper(rakutenConfig))
+	parentItem, err := newParentAffiliateItemItem(&config.ParentItemConfig{ID: "RK000001", Name: "楽天市場"})
+	assert.NoError(t, err)
+	p := NewRakutenAffiliateItemProvider(client, parentItem)
+
+	found := *model.NewItemIdentifier("RK000001", "shop:001")
+	unavailableItem := *model.NewItemIdentifier("RK000001", "error:001")
+	missing := *model.NewItemIdentifier("RK000001", "missing:001")
+	got, unavailable, err := p.GetAffiliateItems(context.Background(), model.ItemIdentifiers{found, unavailableItem, missing, unavailableItem})
+	assert.NoError(t, err)
+
+	// 取得元の障害により取得できなかった案件のみを、指定された数だけ返す
+	assert.Equal(t, model.ItemIdentifiers{unavailableItem, unavailableItem}, unavailable)
+	if assert.Len(t, got, 2) {
+		assert.Equal(t, model.AffiliateItemDFItemID("shop:001"), got[1].AffiliateItemDFItem().DfItemId())
+	}
+}
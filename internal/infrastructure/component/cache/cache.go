@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"go.opencensus.io/trace"
+)
+
+const (
+	DriverMemory = "memory"
+	DriverRedis  = "redis"
+)
+
+const (
+	defaultRedisTimeout      = time.Second
+	defaultRedisMaxIdleConns = 8
+	// DeletePrefix で 1 回の SCAN で走査するキー数の目安
+	redisScanCount = 100
+)
+
+// Cache キーと値を TTL 付きで保存するキャッシュ
+// プロセス間で共有できるよう、値はバイト列で扱う
+type Cache interface {
+	// Get キーに対応する値を取得する。存在しない場合は false を返す
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix prefix で始まるキーを全て削除する。キーを列挙できない実装では全てのキーを削除する
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
+// NewCache 設定の driver に応じたキャッシュを生成する。設定が無い場合はプロセス内にキャッシュする
+func NewCache(config *config.CacheConfig) (Cache, error) {
+	if config == nil {
+		return NewMemoryCache()
+	}
+	switch config.Driver {
+	case "", DriverMemory:
+		return NewMemoryCache()
+	case DriverRedis:
+		return NewRedisCache(config.Redis)
+	default:
+		return nil, fmt.Errorf("unknown cache driver: %s", config.Driver)
+	}
+}
+
+// RedisCache Redis にキャッシュする。複数のレプリカでキャッシュを共有できる
+type RedisCache struct {
+	client    *redis.Client
+	keyPrefix string
+}
+
+func NewRedisCache(config config.RedisConfig) (*RedisCache, error) {
+	if config.Addr == "" {
+		return nil, fmt.Errorf("redis addr is required")
+	}
+	timeout := config.Timeout.Duration
+	if timeout <= 0 {
+		timeout = defaultRedisTimeout
+	}
+	maxIdleConns := config.MaxIdleConns
+	if maxIdleConns <= 0 {
+		maxIdleConns = defaultRedisMaxIdleConns
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:         config.Addr,
+		Password:     config.Password,
+		DB:           config.DB,
+		DialTimeout:  timeout,
+		ReadTimeout:  timeout,
+		WriteTimeout: timeout,
+		MaxIdleConns: maxIdleConns,
+	})
+	return &RedisCache{client: client, keyPrefix: config.KeyPrefix}, nil
+}
+
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "RedisCache.Get")
+	defer span.End()
+
+	value, err := c.client.Get(ctx, c.keyPrefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("c.client.Get: %w", err)
+	}
+	return value, true, nil
+}
+
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	ctx, span := trace.StartSpan(ctx, "RedisCache.Set")
+	defer span.End()
+
+	if err := c.client.Set(ctx, c.keyPrefix+key, value, ttl).Err(); err != nil {
+		return fmt.Errorf("c.client.Set: %w", err)
+	}
+	return nil
+}
+
+func (c *RedisCache) Delete(ctx context.Context, key string) error {
+	ctx, span := trace.StartSpan(ctx, "RedisCache.Delete")
+	defer span.End()
+
+	if err := c.client.Del(ctx, c.keyPrefix+key).Err(); err != nil {
+		return fmt.Errorf("c.client.Del: %w", err)
+	}
+	return nil
+}
+
+// DeletePrefix KEYS はサーバーをブロックするため、SCAN で少しずつ走査して削除する
+func (c *RedisCache) DeletePrefix(ctx context.Context, prefix string) error {
+	ctx, span := trace.StartSpan(ctx, "RedisCache.DeletePrefix")
+	defer span.End()
+
+	iter := c.client.Scan(ctx, 0, escapeRedisPattern(c.keyPrefix+prefix)+"*", redisScanCount).Iterator()
+	keys := make([]string, 0, redisScanCount)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) < redisScanCount {
+			continue
+		}
+		if err := c.client.Unlink(ctx, keys...).Err(); err != nil {
+			return fmt.Errorf("c.client.Unlink: %w", err)
+		}
+		keys = keys[:0]
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("iter.Err: %w", err)
+	}
+	if len(keys) > 0 {
+		if err := c.client.Unlink(ctx, keys...).Err(); err != nil {
+			return fmt.Errorf("c.client.Unlink: %w", err)
+		}
+	}
+	return nil
+}
+
+// SCAN の MATCH で特別な意味を持つ文字をエスケープする
+var redisPatternReplacer = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
+
+func escapeRedisPattern(s string) string {
+	return redisPatternReplacer.Replace(s)
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	libtime "github.com/terui-ryota/offer-item/pkg/time"
+)
+
+func TestCache(t *testing.T) {
+	server := miniredis.RunT(t)
+	tests := []struct {
+		name   string
+		config *config.CacheConfig
+	}{
+		{name: "設定が無い場合はプロセス内にキャッシュする"},
+		{name: "memory", config: &config.CacheConfig{Driver: DriverMemory}},
+		{name: "redis", config: &config.CacheConfig{Driver: DriverRedis, Redis: config.RedisConfig{Addr: server.Addr()}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c, err := NewCache(tt.config)
+			assert.NoError(t, err)
+
+			_, found, err := c.Get(ctx, tt.name)
+			assert.NoError(t, err)
+			assert.False(t, found)
+
+			assert.NoError(t, c.Set(ctx, tt.name, []byte("value\r\n"), time.Hour))
+			got, found, err := c.Get(ctx, tt.name)
+			assert.NoError(t, err)
+			assert.True(t, found)
+			assert.Equal(t, []byte("value\r\n"), got)
+
+			assert.NoError(t, c.Delete(ctx, tt.name))
+			_, found, err = c.Get(ctx, tt.name)
+			assert.NoError(t, err)
+			assert.False(t, found)
+
+			// prefix で始まるキーは全て削除される
+			assert.NoError(t, c.Set(ctx, tt.name+"#1", []byte("value"), time.Hour))
+			assert.NoError(t, c.Set(ctx, tt.name+"#2", []byte("value"), time.Hour))
+			assert.NoError(t, c.DeletePrefix(ctx, tt.name+"#"))
+			for _, key := range []string{tt.name + "#1", tt.name + "#2"} {
+				_, found, err = c.Get(ctx, key)
+				assert.NoError(t, err)
+				assert.False(t, found)
+			}
+		})
+	}
+}
+
+func TestNewCache_UnknownDriver(t *testing.T) {
+	_, err := NewCache(&config.CacheConfig{Driver: "unknown"})
+	assert.Error(t, err)
+	_, err = NewCache(&config.CacheConfig{Driver: DriverRedis})
+	assert.Error(t, err)
+}
+
+func TestRedisCache(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	server.RequireAuth("secret")
+	c, err := NewRedisCache(config.RedisConfig{
+		Addr:      server.Addr(),
+		Password:  "secret",
+		DB:        1,
+		KeyPrefix: "offer-item:",
+		Timeout:   libtime.Duration{Duration: time.Second},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, c.Set(ctx, "key", []byte("value"), time.Minute))
+	assert.Equal(t, []string{"offer-item:key"}, server.DB(1).Keys())
+
+	// TTL が経過した値は取得できない
+	server.FastForward(time.Minute)
+	_, found, err := c.Get(ctx, "key")
+	assert.NoError(t, err)
+	assert.False(t, found)
+
+	// prefix の特殊文字はパターンとして扱わない
+	for _, key := range []string{"item*#1", "item*#2", "item1#1", "item*"} {
+		assert.NoError(t, c.Set(ctx, key, []byte("value"), time.Minute))
+	}
+	assert.NoError(t, c.DeletePrefix(ctx, "item*#"))
+	keys := server.DB(1).Keys()
+	sort.Strings(keys)
+	assert.Equal(t, []string{"offer-item:item*", "offer-item:item1#1"}, keys)
+
+	// 認証に失敗した場合はエラーを返す
+	c, err = NewRedisCache(config.RedisConfig{Addr: server.Addr(), Password: "wrong"})
+	assert.NoError(t, err)
+	_, _, err = c.Get(ctx, "key")
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+)
+
+// MemoryCache プロセス内のメモリにキャッシュする。キャッシュはレプリカ間で共有されない
+type MemoryCache struct {
+	cache *ristretto.Cache
+}
+
+func NewMemoryCache() (*MemoryCache, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 10000,
+		MaxCost:     10 * 1024 * 1024, // 10MB
+		BufferItems: 64,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ristretto.NewCache: %w", err)
+	}
+	return &MemoryCache{cache: cache}, nil
+}
+
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	value, found := c.cache.Get(key)
+	if !found {
+		return nil, false, nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected cached value type: %T", value)
+	}
+	return b, true, nil
+}
+
+// Set ristretto は非同期に保存するため、直後の Get で取得できるよう保存を待つ
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.cache.SetWithTTL(key, value, int64(len(value)), ttl)
+	c.cache.Wait()
+	return nil
+}
+
+func (c *MemoryCache) Delete(_ context.Context, key string) error {
+	c.cache.Del(key)
+	return nil
+}
+
+// DeletePrefix ristretto はキーを列挙できないため、プロセス内のキャッシュを全て削除する
+func (c *MemoryCache) DeletePrefix(_ context.Context, _ string) error {
+	c.cache.Clear()
+	return nil
+}
@@ -3,6 +3,7 @@ package rakuten
 import (
 	"context"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
@@ -15,19 +16,85 @@ func init() {
 
 type ApplicationIDHelper struct {
 	applicationIDs []string
+
+	mu sync.Mutex
+	// アプリIDごとのリクエスト数の制限。rate_limit が 0 以下の場合は制限しない
+	limiters []*tokenBucket
+	now      func() time.Time
 }
 
 func NewApplicationIDHelper(conf *config.RakutenConfig) *ApplicationIDHelper {
-	return &ApplicationIDHelper{
+	h := &ApplicationIDHelper{
 		applicationIDs: conf.ApplicationID,
+		now:            time.Now,
+	}
+	if conf.RateLimit > 0 {
+		h.limiters = make([]*tokenBucket, len(conf.ApplicationID))
+		for i := range h.limiters {
+			h.limiters[i] = newTokenBucket(conf.RateLimit)
+		}
 	}
+	return h
 }
 
-func (h *ApplicationIDHelper) GetApplicationID(ctx context.Context) string {
-	ctx, span := trace.StartSpan(ctx, "ApplicationIDHelper#GetApplicationID")
+// AcquireApplicationID 最も早くリクエストできるアプリIDを選び、リクエストできるようになるまで待機する
+// 待機中に ctx が終了した場合はエラーを返す
+func (h *ApplicationIDHelper) AcquireApplicationID(ctx context.Context) (string, error) {
+	ctx, span := trace.StartSpan(ctx, "ApplicationIDHelper#AcquireApplicationID")
 	defer span.End()
 
-	applicationID := h.applicationIDs[rand.Intn(len(h.applicationIDs))]
+	if h.limiters == nil {
+		return h.applicationIDs[rand.Intn(len(h.applicationIDs))], nil
+	}
+
+	index, delay := h.reserve()
+	if delay > 0 {
+		if err := sleepContext(ctx, delay); err != nil {
+			return "", err
+		}
+	}
+	return h.applicationIDs[index], nil
+}
+
+// 次のトークンが最も早く補充されるアプリIDのトークンを予約し、アプリIDの位置と待機時間を返す
+// 待機せずにリクエストできるアプリIDが複数ある場合は、最も長く使われていないものを選ぶ
+func (h *ApplicationIDHelper) reserve() (int, time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	index := 0
+	for i := 1; i < len(h.limiters); i++ {
+		if h.limiters[i].next.Before(h.limiters[index].next) {
+			index = i
+		}
+	}
+	return index, h.limiters[index].reserve(h.now())
+}
+
+// tokenBucket 容量 1 のトークンバケット
+// 楽天APIは秒間のリクエスト数の上限を超えると 429 を返すため、まとめてリクエストできるバーストは許可しない
+type tokenBucket struct {
+	// トークンが補充される間隔
+	interval time.Duration
+	// 次のトークンが補充される時刻
+	next time.Time
+}
+
+func newTokenBucket(ratePerSecond int) *tokenBucket {
+	return &tokenBucket{interval: time.Second / time.Duration(ratePerSecond)}
+}
+
+// トークンを取得できるまでの待機時間
+func (b *tokenBucket) delay(now time.Time) time.Duration {
+	if b.next.After(now) {
+		return b.next.Sub(now)
+	}
+	return 0
+}
 
-	return applicationID
+// トークンを予約し、取得できるまでの待機時間を返す
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	delay := b.delay(now)
+	b.next = now.Add(delay + b.interval)
+	return delay
 }
@@ -0,0 +1,60 @@
+package rakuten
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+)
+
+func TestApplicationIDHelper_reserve(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	h := NewApplicationIDHelper(&config.RakutenConfig{ApplicationID: []string{"app1", "app2"}, RateLimit: 2})
+	h.now = func() time.Time { return now }
+
+	type reservation struct {
+		index int
+		delay time.Duration
+	}
+	// アプリIDを交互に使い、各アプリIDは 500ms 間隔でリクエストする
+	want := []reservation{
+		{index: 0, delay: 0},
+		{index: 1, delay: 0},
+		{index: 0, delay: 500 * time.Millisecond},
+		{index: 1, delay: 500 * time.Millisecond},
+		{index: 0, delay: time.Second},
+	}
+	for i, w := range want {
+		index, delay := h.reserve()
+		assert.Equal(t, w, reservation{index: index, delay: delay}, i)
+	}
+
+	// 時間が経過すると待機せずにリクエストできる
+	now = now.Add(2 * time.Second)
+	index, delay := h.reserve()
+	assert.Equal(t, reservation{index: 1, delay: 0}, reservation{index: index, delay: delay})
+}
+
+func TestApplicationIDHelper_AcquireApplicationID(t *testing.T) {
+	t.Run("制限が無い場合は待機しない", func(t *testing.T) {
+		h := NewApplicationIDHelper(&config.RakutenConfig{ApplicationID: []string{"app1"}})
+		for i := 0; i < 10; i++ {
+			id, err := h.AcquireApplicationID(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "app1", id)
+		}
+	})
+
+	t.Run("待機中に ctx が終了した場合はエラーを返す", func(t *testing.T) {
+		h := NewApplicationIDHelper(&config.RakutenConfig{ApplicationID: []string{"app1"}, RateLimit: 1})
+		_, err := h.AcquireApplicationID(context.Background())
+		assert.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = h.AcquireApplicationID(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
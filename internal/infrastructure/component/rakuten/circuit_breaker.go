@@ -0,0 +1,171 @@
+package rakuten
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/pkg/logger"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.uber.org/zap"
+)
+
+// CircuitBreakerState サーキットブレーカーの状態。メトリクスにはこの値を出力する
+type CircuitBreakerState int64
+
+const (
+	// リクエストを通す
+	CircuitBreakerClosed CircuitBreakerState = 0
+	// 復旧を確認するため、1 件だけリクエストを通す
+	CircuitBreakerHalfOpen CircuitBreakerState = 1
+	// リクエストを遮断する
+	CircuitBreakerOpen CircuitBreakerState = 2
+)
+
+func (s CircuitBreakerState) String() string {
+	switch s {
+	case CircuitBreakerClosed:
+		return "closed"
+	case CircuitBreakerHalfOpen:
+		return "half_open"
+	case CircuitBreakerOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	MeasureCircuitBreakerState = stats.Int64(
+		"offer_item/rakuten/circuit_breaker_state",
+		"楽天APIのサーキットブレーカーの状態 (0: closed, 1: half_open, 2: open)",
+		stats.UnitDimensionless,
+	)
+	CircuitBreakerStateView = &view.View{
+		Name:        "offer_item/rakuten/circuit_breaker_state",
+		Description: MeasureCircuitBreakerState.Description(),
+		Measure:     MeasureCircuitBreakerState,
+		Aggregation: view.LastValue(),
+	}
+)
+
+// CircuitOpenErr サーキットブレーカーによりリクエストを遮断した場合のエラー
+type CircuitOpenErr struct{}
+
+func (CircuitOpenErr) Error() string {
+	return "rakuten circuit breaker is open"
+}
+
+// サーキットブレーカーに報告するリクエストの結果
+type requestOutcome int
+
+const (
+	requestSucceeded requestOutcome = iota
+	// 楽天APIの障害による失敗
+	requestFailed
+	// ctx の終了など、楽天APIの障害か判断できない結果
+	requestAborted
+)
+
+// 楽天APIへの接続エラーや 5xx が続いた場合にリクエストを遮断する
+type circuitBreaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+	now              func() time.Time
+
+	mu    sync.Mutex
+	state CircuitBreakerState
+	// 連続して失敗した回数
+	failures int
+	// 遮断を開始した時刻
+	openedAt time.Time
+	// half_open で試しに通したリクエストの結果を待っているか
+	trialInFlight bool
+}
+
+func newCircuitBreaker(conf config.RakutenCircuitBreakerConfig) *circuitBreaker {
+	b := &circuitBreaker{
+		failureThreshold: conf.FailureThreshold,
+		openDuration:     conf.OpenDuration.Duration,
+		now:              time.Now,
+	}
+	stats.Record(context.Background(), MeasureCircuitBreakerState.M(int64(b.state)))
+	return b
+}
+
+// allow リクエストを通すかを判定する。通した場合は結果を done で報告する
+func (b *circuitBreaker) allow() bool {
+	if b.failureThreshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitBreakerOpen:
+		if b.now().Sub(b.openedAt) < b.openDuration {
+			return false
+		}
+		b.setState(CircuitBreakerHalfOpen)
+		b.trialInFlight = true
+		return true
+	case CircuitBreakerHalfOpen:
+		if b.trialInFlight {
+			return false
+		}
+		b.trialInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+// done リクエストの結果を報告する
+func (b *circuitBreaker) done(outcome requestOutcome) {
+	if b.failureThreshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitBreakerHalfOpen {
+		b.trialInFlight = false
+	}
+	switch {
+	// 遮断する前に始まったリクエストの結果は、遮断の判定に使用しない
+	case outcome == requestAborted, b.state == CircuitBreakerOpen:
+		return
+	case outcome == requestSucceeded:
+		b.failures = 0
+		b.setState(CircuitBreakerClosed)
+	case b.state == CircuitBreakerHalfOpen:
+		b.open()
+	default:
+		b.failures++
+		if b.failures >= b.failureThreshold {
+			b.open()
+		}
+	}
+}
+
+func (b *circuitBreaker) open() {
+	b.openedAt = b.now()
+	b.setState(CircuitBreakerOpen)
+}
+
+func (b *circuitBreaker) setState(state CircuitBreakerState) {
+	if b.state == state {
+		return
+	}
+	logger.Default().Warn("Rakuten circuit breaker state changed.", zap.Stringer("from", b.state), zap.Stringer("to", state))
+	b.state = state
+	stats.Record(context.Background(), MeasureCircuitBreakerState.M(int64(state)))
+}
+
+func (b *circuitBreaker) currentState() CircuitBreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
@@ -8,13 +8,12 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
-
-	//"net/url"
-	//"time"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
 	"github.com/terui-ryota/offer-item/pkg/logger"
+	"go.opencensus.io/stats/view"
 	"go.opencensus.io/trace"
 	"go.uber.org/zap"
 )
@@ -24,22 +23,33 @@ func NewRakutenIchibaClient(
 	client *http.Client,
 	helper *ApplicationIDHelper,
 ) *RakutenIchibaClient {
+	if err := view.Register(CircuitBreakerStateView); err != nil {
+		logger.Default().Warn("Failed to register rakuten circuit breaker view.", zap.Error(err))
+	}
 	return &RakutenIchibaClient{
-		client: client,
-		config: *config,
-		helper: helper,
+		client:  client,
+		config:  *config,
+		helper:  helper,
+		breaker: newCircuitBreaker(config.CircuitBreaker),
+		sleep:   sleepContext,
 	}
 }
 
 // RakutenIchibaClient は楽天商品検索API用Clientです。
 // URL:https://webservice.rakuten.co.jp/api/ichibaitemsearch/
 type RakutenIchibaClient struct {
-	client *http.Client
-	config config.RakutenConfig
-	helper *ApplicationIDHelper
+	client  *http.Client
+	config  config.RakutenConfig
+	helper  *ApplicationIDHelper
+	breaker *circuitBreaker
+	// リトライまでの待機。テストで差し替える
+	sleep func(ctx context.Context, d time.Duration) error
 }
 
-type TooManyRequestsErr struct{}
+type TooManyRequestsErr struct {
+	// Retry-After で指定された待機時間
+	retryAfter time.Duration
+}
 
 func (TooManyRequestsErr) Error() string {
 	return ""
@@ -50,11 +60,31 @@ const (
 )
 
 // GetItemsByItemId は指定の商品コードの商品情報を取得します。
+// 接続エラー、5xx、429 の場合はリトライし、楽天APIの障害が続く場合は CircuitOpenErr を返します。
 func (r *RakutenIchibaClient) GetItemsByItemId(ctx context.Context, itemCode string) (*ItemResult, error) {
 	ctx, span := trace.StartSpan(ctx, "RakutenIchibaClient#GetItemsByItemId")
 	defer span.End()
 
-	applicationId := r.helper.GetApplicationID(ctx)
+	if !r.breaker.allow() {
+		return nil, CircuitOpenErr{}
+	}
+	result, err := r.withRetry(ctx, func() (*ItemResult, error) {
+		return r.getItemsByItemId(ctx, itemCode)
+	})
+	r.breaker.done(outcomeOf(ctx, err))
+	return result, err
+}
+
+// CircuitBreakerState はサーキットブレーカーの現在の状態を返します。
+func (r *RakutenIchibaClient) CircuitBreakerState() CircuitBreakerState {
+	return r.breaker.currentState()
+}
+
+func (r *RakutenIchibaClient) getItemsByItemId(ctx context.Context, itemCode string) (*ItemResult, error) {
+	applicationId, err := r.helper.AcquireApplicationID(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to acquire rakuten application id")
+	}
 	// パラメーター生成
 	param := NewItemSearchByItemCodeParam(
 		applicationId,
@@ -63,16 +93,20 @@ func (r *RakutenIchibaClient) GetItemsByItemId(ctx context.Context, itemCode str
 	)
 
 	// リクエスト生成
-	req, err := r.createRequest(ctx, rakutenIchibaItemURL, param)
+	itemURL := r.itemSearchURL()
+	req, err := r.createRequest(ctx, itemURL, param)
 	if err != nil {
-		return nil, errors.Wrap(err, fmt.Sprintf("Failed to generate request rakuten url:%s param:%+v", rakutenIchibaItemURL, param))
+		return nil, errors.Wrap(err, fmt.Sprintf("Failed to generate request rakuten url:%s param:%+v", itemURL, param))
 	}
 	logger.Default().Debug("Rakuten request.", zap.String("url", req.URL.String()))
 
 	// リクエスト実行
 	resp, err := r.client.Do(req)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Failed to Rakuten http request")
+		if ctx.Err() != nil {
+			return nil, errors.Wrapf(err, "Failed to Rakuten http request")
+		}
+		return nil, UnavailableErr{err: errors.Wrapf(err, "Failed to Rakuten http request")}
 	}
 	defer func() {
 		_, _ = io.Copy(ioutil.Discard, resp.Body)
@@ -83,13 +117,24 @@ func (r *RakutenIchibaClient) GetItemsByItemId(ctx context.Context, itemCode str
 	// debug用
 	// r = io.TeeReader(r, os.Stderr)
 
+	switch {
+	case resp.StatusCode >= 500:
+		return nil, UnavailableErr{
+			err:        errors.New(fmt.Sprintf("Rakuten returned status %d", resp.StatusCode)),
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
+	case resp.StatusCode == http.StatusTooManyRequests:
+		// 楽天APIは 429 の場合に too_many_requests を返すが、本文によらずリクエスト数超過として扱う
+		return nil, TooManyRequestsErr{retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())}
+	}
+
 	if resp.StatusCode >= 400 {
 		// エラーメッセージをデコード
 		var rakutenError RakutenError
 		if err := json.NewDecoder(reader).Decode(&rakutenError); err != nil {
 			b, _ := ioutil.ReadAll(reader)
 			logger.Default().Warn("Failed to error decode.", zap.String("data", string(b)))
-			return nil, errors.Wrap(err, fmt.Sprintf("Failed to decode rakuten error. url:%s param:%+v", rakutenIchibaItemURL, param))
+			return nil, errors.Wrap(err, fmt.Sprintf("Failed to decode rakuten error. url:%s param:%+v", itemURL, param))
 		}
 		logger.Default().Warn("Failed to rakuten request.", zap.Reflect("error", rakutenError))
 
@@ -107,12 +152,27 @@ func (r *RakutenIchibaClient) GetItemsByItemId(ctx context.Context, itemCode str
 	// レスポンスをデコード
 	var data ItemResult
 	if err := json.NewDecoder(reader).Decode(&data); err != nil {
-		return nil, errors.Wrapf(err, "Failed to decode rakuten api. url:%s param:%+v", rakutenIchibaItemURL, param)
+		return nil, errors.Wrapf(err, "Failed to decode rakuten api. url:%s param:%+v", itemURL, param)
 	}
 
 	return &data, nil
 }
 
+// Concurrency は並行してリクエストできる最大数を返します。
+func (r *RakutenIchibaClient) Concurrency() int {
+	if r.config.Concurrency <= 0 {
+		return 1
+	}
+	return r.config.Concurrency
+}
+
+func (r *RakutenIchibaClient) itemSearchURL() string {
+	if r.config.RakutenIchiba.ItemSearchURL != "" {
+		return r.config.RakutenIchiba.ItemSearchURL
+	}
+	return rakutenIchibaItemURL
+}
+
 // doRequest は楽天商品検索API用のリクエストURLを生成します。
 func (r *RakutenIchibaClient) createRequest(ctx context.Context, targetUrl string, param RakutenSearchParam) (*http.Request, error) {
 	u, err := url.Parse(targetUrl)
@@ -129,5 +189,5 @@ func (r *RakutenIchibaClient) createRequest(ctx context.Context, targetUrl strin
 	// targetURL にパス変数が含まれないため Path をそのまま設定します
 	// パス (URL) を設定する箇所とヘッダーを設定する場所を分離しないように修正してください
 	req.Header.Add("X-Path-Pattern", u.Path)
-	return req, nil
+	return req.WithContext(ctx), nil
 }
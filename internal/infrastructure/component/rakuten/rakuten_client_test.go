@@ -0,0 +1,227 @@
+package rakuten
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	libtime "github.com/terui-ryota/offer-item/pkg/time"
+	"go.opencensus.io/stats/view"
+)
+
+// fakeResponse フェイクの楽天APIが返す応答。retryAfter が空の場合は Retry-After を返さない
+type fakeResponse struct {
+	status     int
+	retryAfter string
+}
+
+// fakeRakutenServer 指定した応答を順に返し、使い切った後は商品を返す
+type fakeRakutenServer struct {
+	mu        sync.Mutex
+	responses []fakeResponse
+	requests  int
+}
+
+func (f *fakeRakutenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests++
+	var res *fakeResponse
+	if len(f.responses) > 0 {
+		res = &f.responses[0]
+		f.responses = f.responses[1:]
+	}
+	f.mu.Unlock()
+
+	if res != nil {
+		if res.retryAfter != "" {
+			w.Header().Set("Retry-After", res.retryAfter)
+		}
+		w.WriteHeader(res.status)
+		_ = json.NewEncoder(w).Encode(RakutenError{Error: "error"})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(ItemResult{Items: []ItemResponse{{Item: Item{ItemCode: r.URL.Query().Get("itemCode")}}}})
+}
+
+func (f *fakeRakutenServer) Requests() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.requests
+}
+
+func newTestRakutenClient(t *testing.T, server *fakeRakutenServer, conf config.RakutenConfig) (*RakutenIchibaClient, *[]time.Duration) {
+	t.Helper()
+	s := httptest.NewServer(server)
+	t.Cleanup(s.Close)
+
+	conf.ApplicationID = []string{"app1"}
+	conf.RakutenIchiba = config.RakutenIchibaConfig{Format: "json", ItemSearchURL: s.URL}
+	client := NewRakutenIchibaClient(&conf, s.Client(), NewApplicationIDHelper(&conf))
+
+	// 待機時間を記録し、実際には待機しない
+	waits := make([]time.Duration, 0)
+	client.sleep = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return client, &waits
+}
+
+func TestRakutenIchibaClient_GetItemsByItemId_Retry(t *testing.T) {
+	retry := config.RakutenRetryConfig{
+		MaxAttempts:    3,
+		InitialBackoff: libtime.Duration{Duration: 100 * time.Millisecond},
+		MaxBackoff:     libtime.Duration{Duration: 2 * time.Second},
+	}
+
+	tests := []struct {
+		name         string
+		responses    []fakeResponse
+		wantErr      error
+		wantRequests int
+		// 各リトライの待機時間の範囲
+		wantWaits [][2]time.Duration
+	}{
+		{
+			name:         "5xx はリトライする",
+			responses:    []fakeResponse{{status: http.StatusServiceUnavailable}, {status: http.StatusBadGateway}},
+			wantRequests: 3,
+			wantWaits:    [][2]time.Duration{{50 * time.Millisecond, 100 * time.Millisecond}, {100 * time.Millisecond, 200 * time.Millisecond}},
+		},
+		{
+			name:         "Retry-After の秒数だけ待機する",
+			responses:    []fakeResponse{{status: http.StatusTooManyRequests, retryAfter: "1"}},
+			wantRequests: 2,
+			wantWaits:    [][2]time.Duration{{time.Second, time.Second}},
+		},
+		{
+			name:         "Retry-After が待機時間の上限を超える場合はリトライしない",
+			responses:    []fakeResponse{{status: http.StatusTooManyRequests, retryAfter: "60"}},
+			wantErr:      TooManyRequestsErr{retryAfter: time.Minute},
+			wantRequests: 1,
+		},
+		{
+			name:         "最大試行回数に達した場合はエラーを返す",
+			responses:    []fakeResponse{{status: http.StatusInternalServerError}, {status: http.StatusInternalServerError}, {status: http.StatusInternalServerError}},
+			wantErr:      UnavailableErr{},
+			wantRequests: 3,
+			wantWaits:    [][2]time.Duration{{50 * time.Millisecond, 100 * time.Millisecond}, {100 * time.Millisecond, 200 * time.Millisecond}},
+		},
+		{
+			name:         "4xx はリトライしない",
+			responses:    []fakeResponse{{status: http.StatusBadRequest}},
+			wantErr:      errors.New("Failed to rakuten request"),
+			wantRequests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &fakeRakutenServer{responses: tt.responses}
+			client, waits := newTestRakutenClient(t, server, config.RakutenConfig{Retry: retry})
+
+			result, err := client.GetItemsByItemId(context.Background(), "shop:1")
+			switch want := tt.wantErr.(type) {
+			case nil:
+				if assert.NoError(t, err) {
+					assert.Equal(t, "shop:1", result.Items[0].ItemCode)
+				}
+			case TooManyRequestsErr:
+				assert.Equal(t, want, err)
+			case UnavailableErr:
+				assert.ErrorAs(t, err, &UnavailableErr{})
+			default:
+				assert.ErrorContains(t, err, want.Error())
+			}
+			assert.Equal(t, tt.wantRequests, server.Requests())
+			if assert.Len(t, *waits, len(tt.wantWaits)) {
+				for i, w := range tt.wantWaits {
+					assert.GreaterOrEqual(t, (*waits)[i], w[0])
+					assert.LessOrEqual(t, (*waits)[i], w[1])
+				}
+			}
+		})
+	}
+}
+
+func TestRakutenIchibaClient_GetItemsByItemId_CircuitBreaker(t *testing.T) {
+	if err := view.Register(CircuitBreakerStateView); err != nil {
+		t.Fatal(err)
+	}
+	metricState := func() float64 {
+		rows, err := view.RetrieveData(CircuitBreakerStateView.Name)
+		if err != nil || len(rows) == 0 {
+			t.Fatalf("view.RetrieveData: %v", err)
+		}
+		return rows[0].Data.(*view.LastValueData).Value
+	}
+
+	server := &fakeRakutenServer{responses: []fakeResponse{
+		{status: http.StatusServiceUnavailable},
+		{status: http.StatusServiceUnavailable},
+		{status: http.StatusServiceUnavailable},
+	}}
+	client, _ := newTestRakutenClient(t, server, config.RakutenConfig{
+		CircuitBreaker: config.RakutenCircuitBreakerConfig{
+			FailureThreshold: 2,
+			OpenDuration:     libtime.Duration{Duration: 30 * time.Second},
+		},
+	})
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	client.breaker.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	// 連続して失敗すると遮断する
+	for i := 0; i < 2; i++ {
+		_, err := client.GetItemsByItemId(ctx, "shop:1")
+		assert.ErrorAs(t, err, &UnavailableErr{})
+	}
+	assert.Equal(t, CircuitBreakerOpen, client.CircuitBreakerState())
+	assert.Equal(t, float64(CircuitBreakerOpen), metricState())
+
+	_, err := client.GetItemsByItemId(ctx, "shop:1")
+	assert.Equal(t, CircuitOpenErr{}, err)
+	assert.Equal(t, 2, server.Requests())
+
+	// 遮断期間の経過後に試したリクエストが失敗すると、再び遮断する
+	now = now.Add(30 * time.Second)
+	_, err = client.GetItemsByItemId(ctx, "shop:1")
+	assert.ErrorAs(t, err, &UnavailableErr{})
+	assert.Equal(t, CircuitBreakerOpen, client.CircuitBreakerState())
+	_, err = client.GetItemsByItemId(ctx, "shop:1")
+	assert.Equal(t, CircuitOpenErr{}, err)
+
+	// 試したリクエストが成功すると遮断を解除する
+	now = now.Add(30 * time.Second)
+	_, err = client.GetItemsByItemId(ctx, "shop:1")
+	assert.NoError(t, err)
+	assert.Equal(t, CircuitBreakerClosed, client.CircuitBreakerState())
+	assert.Equal(t, float64(CircuitBreakerClosed), metricState())
+	assert.Equal(t, 4, server.Requests())
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{value: "", want: 0},
+		{value: "3", want: 3 * time.Second},
+		{value: "-1", want: 0},
+		{value: now.Add(5 * time.Second).Format(http.TimeFormat), want: 5 * time.Second},
+		{value: now.Add(-5 * time.Second).Format(http.TimeFormat), want: 0},
+		{value: "invalid", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseRetryAfter(tt.value, now))
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package rakuten
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/terui-ryota/offer-item/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+)
+
+// UnavailableErr 楽天APIに接続できない、または 5xx が返された場合のエラー
+type UnavailableErr struct {
+	err error
+	// Retry-After で指定された待機時間
+	retryAfter time.Duration
+}
+
+func (e UnavailableErr) Error() string {
+	return e.err.Error()
+}
+
+func (e UnavailableErr) Unwrap() error {
+	return e.err
+}
+
+// 接続エラー、5xx、429 の場合は、指数関数的に延ばした待機時間にゆらぎを加えてリトライする
+// Retry-After が指定されている場合は、その時間より前にはリトライしない
+func (r *RakutenIchibaClient) withRetry(ctx context.Context, f func() (*ItemResult, error)) (*ItemResult, error) {
+	conf := r.config.Retry
+	for attempt := 1; ; attempt++ {
+		result, err := f()
+		retryAfter, retryable := retryAfterOf(err)
+		if !retryable || attempt >= conf.MaxAttempts {
+			return result, err
+		}
+
+		wait := backoff(conf.InitialBackoff.Duration, conf.MaxBackoff.Duration, attempt)
+		if retryAfter > wait {
+			if retryAfter > maxBackoff(conf.MaxBackoff.Duration) {
+				// 待機するとリクエストがタイムアウトするため、リトライせずに返す
+				return result, err
+			}
+			wait = retryAfter
+		}
+		logger.Default().Info("Retry rakuten request.", zap.Int("attempt", attempt), zap.Duration("wait", wait), zap.Error(err))
+		if err := r.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// リトライできるエラーか判定し、Retry-After で指定された待機時間を返す
+func retryAfterOf(err error) (time.Duration, bool) {
+	var unavailable UnavailableErr
+	if errors.As(err, &unavailable) {
+		return unavailable.retryAfter, true
+	}
+	var tooManyRequests TooManyRequestsErr
+	if errors.As(err, &tooManyRequests) {
+		return tooManyRequests.retryAfter, true
+	}
+	return 0, false
+}
+
+// attempt 回目の失敗後の待機時間。上限までの範囲で 2 倍ずつ延ばし、後半の半分をランダムにする
+// 同時に失敗したリクエストが同時にリトライしないよう、ゆらぎを加える
+func backoff(initial, max time.Duration, attempt int) time.Duration {
+	if initial <= 0 {
+		initial = defaultInitialBackoff
+	}
+	max = maxBackoff(max)
+
+	d := initial
+	for i := 1; i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func maxBackoff(max time.Duration) time.Duration {
+	if max <= 0 {
+		return defaultMaxBackoff
+	}
+	return max
+}
+
+// Retry-After ヘッダーの秒数または日時を待機時間に変換する。指定が無い場合や解析できない場合は 0
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// サーキットブレーカーに報告する結果。リクエスト数超過は障害として扱わない
+func outcomeOf(ctx context.Context, err error) requestOutcome {
+	var unavailable UnavailableErr
+	switch {
+	case err == nil:
+		return requestSucceeded
+	case ctx.Err() != nil:
+		return requestAborted
+	case errors.As(err, &unavailable):
+		return requestFailed
+	default:
+		var tooManyRequests TooManyRequestsErr
+		if errors.As(err, &tooManyRequests) {
+			return requestAborted
+		}
+		return requestSucceeded
+	}
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"go.opencensus.io/trace"
+)
+
+// LocalStorage ローカルファイルシステムにオブジェクトを保存する。ローカル開発・テスト用
+type LocalStorage struct {
+	baseDir string
+	baseURL string
+}
+
+func NewLocalStorage(config config.LocalStorageConfig) (*LocalStorage, error) {
+	if config.BaseDir == "" {
+		return nil, fmt.Errorf("base_dir is required")
+	}
+	if err := os.MkdirAll(config.BaseDir, 0o755); err != nil {
+		return nil, fmt.Errorf("os.MkdirAll: %w", err)
+	}
+	baseURL := strings.TrimSuffix(config.BaseURL, "/")
+	if baseURL == "" {
+		baseDir, err := filepath.Abs(config.BaseDir)
+		if err != nil {
+			return nil, fmt.Errorf("filepath.Abs: %w", err)
+		}
+		baseURL = (&url.URL{Scheme: "file", Path: filepath.ToSlash(baseDir)}).String()
+	}
+	return &LocalStorage{
+		baseDir: config.BaseDir,
+		baseURL: baseURL,
+	}, nil
+}
+
+func (l *LocalStorage) Put(ctx context.Context, object model.StorageObject) error {
+	_, span := trace.StartSpan(ctx, "LocalStorage.Put")
+	defer span.End()
+
+	path, err := l.path(object.Key())
+	if err != nil {
+		return fmt.Errorf("l.path: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("os.MkdirAll: %w", err)
+	}
+	if err := os.WriteFile(path, object.Data(), 0o644); err != nil {
+		return fmt.Errorf("os.WriteFile: %w", err)
+	}
+	return nil
+}
+
+func (l *LocalStorage) Get(ctx context.Context, key model.ObjectKey) (*model.StorageObject, error) {
+	_, span := trace.StartSpan(ctx, "LocalStorage.Get")
+	defer span.End()
+
+	path, err := l.path(key)
+	if err != nil {
+		return nil, fmt.Errorf("l.path: %w", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, apperr.OfferItemStorageObjectNotFoundError
+		}
+		return nil, fmt.Errorf("os.ReadFile: %w", err)
+	}
+	// ローカルではコンテンツタイプを保存しないため拡張子から判定する
+	return model.NewStorageObjectFromRepository(key, mime.TypeByExtension(filepath.Ext(path)), data), nil
+}
+
+func (l *LocalStorage) Delete(ctx context.Context, key model.ObjectKey) error {
+	_, span := trace.StartSpan(ctx, "LocalStorage.Delete")
+	defer span.End()
+
+	path, err := l.path(key)
+	if err != nil {
+		return fmt.Errorf("l.path: %w", err)
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("os.Remove: %w", err)
+	}
+	return nil
+}
+
+func (l *LocalStorage) URL(ctx context.Context, key model.ObjectKey) (string, error) {
+	if _, err := l.path(key); err != nil {
+		return "", fmt.Errorf("l.path: %w", err)
+	}
+	return l.baseURL + "/" + key.String(), nil
+}
+
+func (l *LocalStorage) KeyFromURL(rawURL string) (model.ObjectKey, bool) {
+	key, ok := strings.CutPrefix(rawURL, l.baseURL+"/")
+	if !ok || key == "" {
+		return "", false
+	}
+	if _, err := l.path(model.ObjectKey(key)); err != nil {
+		return "", false
+	}
+	return model.ObjectKey(key), true
+}
+
+// path キーをファイルパスに変換する。base_dir の外を指すキーは許可しない
+func (l *LocalStorage) path(key model.ObjectKey) (string, error) {
+	path := filepath.Join(l.baseDir, filepath.FromSlash(key.String()))
+	rel, err := filepath.Rel(l.baseDir, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", fmt.Errorf("invalid object key: %s", key)
+	}
+	return path, nil
+}
@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+)
+
+func TestLocalStorage(t *testing.T) {
+	s, err := NewLocalStorage(config.LocalStorageConfig{BaseDir: t.TempDir()})
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	object, err := model.NewImageObject(model.ObjectKeyPrefixQuestionnaireImage, "image/png", []byte("\x89PNG\r\n\x1a\n0000"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Put(ctx, *object))
+	got, err := s.Get(ctx, object.Key())
+	assert.NoError(t, err)
+	assert.Equal(t, object, got)
+
+	assert.NoError(t, s.Delete(ctx, object.Key()))
+	_, err = s.Get(ctx, object.Key())
+	assert.True(t, errors.Is(err, apperr.OfferItemStorageObjectNotFoundError))
+
+	// base_dir の外を指すキーは扱わない
+	_, err = s.Get(ctx, "../outside.png")
+	assert.Error(t, err)
+}
+
+func TestLocalStorage_URL(t *testing.T) {
+	s, err := NewLocalStorage(config.LocalStorageConfig{BaseDir: t.TempDir(), BaseURL: "http://localhost:8080/storage/"})
+	assert.NoError(t, err)
+
+	key := model.ObjectKey("questionnaire_image/abc.png")
+	u, err := s.URL(context.Background(), key)
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/storage/questionnaire_image/abc.png", u)
+	got, ok := s.KeyFromURL(u)
+	assert.True(t, ok)
+	assert.Equal(t, key, got)
+
+	_, ok = s.KeyFromURL("https://example.com/questionnaire_image/abc.png")
+	assert.False(t, ok)
+	_, ok = s.KeyFromURL("http://localhost:8080/storage/../outside.png")
+	assert.False(t, ok)
+}
@@ -0,0 +1,163 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"go.opencensus.io/trace"
+)
+
+// S3Storage S3 互換のオブジェクトストレージにオブジェクトを保存する
+// パス形式(endpoint/bucket/key)でアクセスする
+type S3Storage struct {
+	client        *s3.Client
+	presignClient *s3.PresignClient
+	bucket        string
+	// パス形式のオブジェクトのURLの接頭辞(endpoint/bucket/)
+	objectURLPrefix *url.URL
+	publicBaseURL   string
+}
+
+func NewS3Storage(config config.S3StorageConfig, client *http.Client) (*S3Storage, error) {
+	if config.Endpoint == "" || config.Region == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("endpoint, region and bucket are required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("access_key_id and secret_access_key are required")
+	}
+	objectURLPrefix, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("url.Parse: %w", err)
+	}
+	objectURLPrefix.Path = strings.TrimSuffix(objectURLPrefix.Path, "/") + "/" + config.Bucket + "/"
+	s3Client := s3.New(s3.Options{
+		Region:       config.Region,
+		BaseEndpoint: aws.String(config.Endpoint),
+		UsePathStyle: true,
+		Credentials:  credentials.NewStaticCredentialsProvider(config.AccessKeyID, config.SecretAccessKey, ""),
+		HTTPClient:   client,
+	})
+	return &S3Storage{
+		client: s3Client,
+		presignClient: s3.NewPresignClient(s3Client, func(o *s3.PresignOptions) {
+			if config.PresignExpires.Duration > 0 {
+				o.Expires = config.PresignExpires.Duration
+			}
+		}),
+		bucket:          config.Bucket,
+		objectURLPrefix: objectURLPrefix,
+		publicBaseURL:   strings.TrimSuffix(config.PublicBaseURL, "/"),
+	}, nil
+}
+
+func (s *S3Storage) Put(ctx context.Context, object model.StorageObject) error {
+	ctx, span := trace.StartSpan(ctx, "S3Storage.Put")
+	defer span.End()
+
+	if _, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(object.Key().String()),
+		Body:        bytes.NewReader(object.Data()),
+		ContentType: aws.String(object.ContentType()),
+	}); err != nil {
+		return fmt.Errorf("s.client.PutObject: %w", err)
+	}
+	return nil
+}
+
+func (s *S3Storage) Get(ctx context.Context, key model.ObjectKey) (*model.StorageObject, error) {
+	ctx, span := trace.StartSpan(ctx, "S3Storage.Get")
+	defer span.End()
+
+	res, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key.String()),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, apperr.OfferItemStorageObjectNotFoundError
+		}
+		return nil, fmt.Errorf("s.client.GetObject: %w", err)
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("io.ReadAll: %w", err)
+	}
+	return model.NewStorageObjectFromRepository(key, aws.ToString(res.ContentType), data), nil
+}
+
+// 存在しないキーの削除はエラーにならない
+func (s *S3Storage) Delete(ctx context.Context, key model.ObjectKey) error {
+	ctx, span := trace.StartSpan(ctx, "S3Storage.Delete")
+	defer span.End()
+
+	if _, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key.String()),
+	}); err != nil {
+		return fmt.Errorf("s.client.DeleteObject: %w", err)
+	}
+	return nil
+}
+
+// 公開URLが設定されていない場合は署名付きURLを発行する
+func (s *S3Storage) URL(ctx context.Context, key model.ObjectKey) (string, error) {
+	ctx, span := trace.StartSpan(ctx, "S3Storage.URL")
+	defer span.End()
+
+	if s.publicBaseURL != "" {
+		return s.publicBaseURL + "/" + key.String(), nil
+	}
+	req, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key.String()),
+	})
+	if err != nil {
+		return "", fmt.Errorf("s.presignClient.PresignGetObject: %w", err)
+	}
+	return req.URL, nil
+}
+
+// 署名付きURLは有効期限が切れていてもキーを取り出す
+func (s *S3Storage) KeyFromURL(rawURL string) (model.ObjectKey, bool) {
+	if s.publicBaseURL != "" {
+		if key, ok := strings.CutPrefix(rawURL, s.publicBaseURL+"/"); ok && key != "" {
+			return model.ObjectKey(key), true
+		}
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != s.objectURLPrefix.Scheme || u.Host != s.objectURLPrefix.Host {
+		return "", false
+	}
+	key, ok := strings.CutPrefix(u.Path, s.objectURLPrefix.Path)
+	if !ok || key == "" {
+		return "", false
+	}
+	return model.ObjectKey(key), true
+}
+
+// isS3NotFound オブジェクトが存在しないことを表すエラーかどうか
+// S3 互換のストレージでは NoSuchKey のエラーコードを返さず、ステータスコードのみの場合がある
+func isS3NotFound(err error) bool {
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return true
+	}
+	var resErr *smithyhttp.ResponseError
+	return errors.As(err, &resErr) && resErr.HTTPStatusCode() == http.StatusNotFound
+}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+)
+
+// fakeS3 パス形式のリクエストを受け付けるインメモリの S3 互換サーバー
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if auth := r.Header.Get("Authorization"); !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=access_key/") || !strings.Contains(auth, "/ap-northeast-1/s3/aws4_request") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = data
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", f.types[r.URL.Path])
+		_, _ = w.Write(data)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Storage(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	s, err := NewS3Storage(config.S3StorageConfig{
+		Endpoint:        server.URL,
+		Region:          "ap-northeast-1",
+		Bucket:          "bucket",
+		AccessKeyID:     "access_key",
+		SecretAccessKey: "secret_key",
+	}, server.Client())
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	object, err := model.NewImageObject(model.ObjectKeyPrefixQuestionnaireImage, "image/png", []byte("\x89PNG\r\n\x1a\n0000"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, s.Put(ctx, *object))
+	assert.Contains(t, fake.objects, "/bucket/"+object.Key().String())
+
+	got, err := s.Get(ctx, object.Key())
+	assert.NoError(t, err)
+	assert.Equal(t, object, got)
+
+	assert.NoError(t, s.Delete(ctx, object.Key()))
+	_, err = s.Get(ctx, object.Key())
+	assert.True(t, errors.Is(err, apperr.OfferItemStorageObjectNotFoundError))
+}
+
+func TestS3Storage_URL(t *testing.T) {
+	ctx := context.Background()
+	key := model.ObjectKey("questionnaire_image/abc.png")
+	cfg := config.S3StorageConfig{
+		Endpoint:        "https://s3.example.com",
+		Region:          "ap-northeast-1",
+		Bucket:          "bucket",
+		AccessKeyID:     "access_key",
+		SecretAccessKey: "secret_key",
+	}
+
+	// 公開URLが設定されていない場合は署名付きURLを発行し、発行したURLからキーを取り出せる
+	s, err := NewS3Storage(cfg, http.DefaultClient)
+	assert.NoError(t, err)
+	u, err := s.URL(ctx, key)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(u, "https://s3.example.com/bucket/questionnaire_image/abc.png?"))
+	assert.Contains(t, u, "X-Amz-Signature=")
+	got, ok := s.KeyFromURL(u)
+	assert.True(t, ok)
+	assert.Equal(t, key, got)
+
+	_, ok = s.KeyFromURL("https://example.com/bucket/questionnaire_image/abc.png")
+	assert.False(t, ok)
+	_, ok = s.KeyFromURL("https://s3.example.com/other/questionnaire_image/abc.png")
+	assert.False(t, ok)
+
+	cfg.PublicBaseURL = "https://cdn.example.com/"
+	s, err = NewS3Storage(cfg, http.DefaultClient)
+	assert.NoError(t, err)
+	u, err = s.URL(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/questionnaire_image/abc.png", u)
+	got, ok = s.KeyFromURL(u)
+	assert.True(t, ok)
+	assert.Equal(t, key, got)
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/terui-ryota/offer-item/internal/app/grpcserver/config"
+	"github.com/terui-ryota/offer-item/internal/domain/adapter"
+)
+
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// NewObjectStorage 設定の driver に応じたオブジェクトストレージを生成する
+func NewObjectStorage(config *config.StorageConfig, client *http.Client) (adapter.ObjectStorage, error) {
+	if config == nil {
+		return nil, fmt.Errorf("storage config is required")
+	}
+	switch config.Driver {
+	case DriverLocal:
+		return NewLocalStorage(config.Local)
+	case DriverS3:
+		return NewS3Storage(config.S3, client)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", config.Driver)
+	}
+}
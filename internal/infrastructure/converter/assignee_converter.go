@@ -12,6 +12,12 @@ func AssigneeEntityToModel(e *entity.Assignee) *model.Assignee {
 		declineReason = &e.DeclineReason.String
 	}
 
+	var paymentBatchID *model.PaymentBatchID
+	if e.PaymentBatchID.Valid {
+		tmpPaymentBatchID := model.PaymentBatchID(e.PaymentBatchID.String)
+		paymentBatchID = &tmpPaymentBatchID
+	}
+
 	return model.NewAssigneeFromRepository(
 		model.AssigneeID(e.ID),
 		model.OfferItemID(e.OfferItemID),
@@ -20,16 +26,24 @@ func AssigneeEntityToModel(e *entity.Assignee) *model.Assignee {
 		model.Stage(e.Stage),
 		declineReason,
 		e.CreatedAt,
+		paymentBatchID,
 	)
 }
 
 func AssigneeModelToEntity(m *model.Assignee) *entity.Assignee {
+	var paymentBatchID *string
+	if m.PaymentBatchID() != nil {
+		tmpPaymentBatchID := m.PaymentBatchID().String()
+		paymentBatchID = &tmpPaymentBatchID
+	}
+
 	return &entity.Assignee{
-		ID:            m.ID().String(),
-		OfferItemID:   string(m.OfferItemID()),
-		AmebaID:       string(m.AmebaID()),
-		WritingFee:    m.WritingFee(),
-		Stage:         uint(m.Stage().Int()),
-		DeclineReason: null.StringFromPtr(m.DeclineReason()),
+		ID:             m.ID().String(),
+		OfferItemID:    string(m.OfferItemID()),
+		AmebaID:        string(m.AmebaID()),
+		WritingFee:     m.WritingFee(),
+		Stage:          uint(m.Stage().Int()),
+		DeclineReason:  null.StringFromPtr(m.DeclineReason()),
+		PaymentBatchID: null.StringFromPtr(paymentBatchID),
 	}
 }
@@ -42,6 +42,9 @@ func ExaminationEntityToModel(e *entity.Examination, count int) *model.Examinati
 		model.AssigneeID(e.AssigneeID),
 		model.EntryType(e.EntryType),
 		uint(count),
+		e.IsPassed.Ptr(),
+		model.ExaminationStatus(e.Status),
+		e.ConfirmerName.Ptr(),
 	)
 }
 
@@ -70,8 +73,11 @@ func ExaminationModelToEntity(examination *model.Examination) entity.Examination
 			SNSUserID:        null.StringFromPtr(examination.Sns().UserID()),
 			SNSScreenshotURL: null.StringFromPtr(snsScreenshotURL),
 		*/
-		ExaminerName: null.StringFromPtr(examination.ExaminerName()),
-		Reason:       null.StringFromPtr(examination.Reason()),
-		EntryType:    uint(examination.EntryType()),
+		ExaminerName:  null.StringFromPtr(examination.ExaminerName()),
+		Reason:        null.StringFromPtr(examination.Reason()),
+		IsPassed:      null.BoolFromPtr(examination.IsPassed()),
+		Status:        uint(examination.Status()),
+		ConfirmerName: null.StringFromPtr(examination.ConfirmerName()),
+		EntryType:     uint(examination.EntryType()),
 	}
 }
@@ -30,9 +30,13 @@ func ConvertOfferItemToModel(e *entity.OfferItem, schedules model.ScheduleList,
 		bannerIDs = append(bannerIDs, model.BannerID(e.CouponBannerID.String))
 	}
 
-	pickInfo, err := model.NewPickInfo(model.ItemID(e.ItemID), &DFItemID, bannerIDs)
-	if err != nil {
-		return nil, fmt.Errorf("model.NewPickInfoByDFItemID: %w", err)
+	// 下書きの場合は案件が未設定のことがある
+	var pickInfo *model.PickInfo
+	if item != nil {
+		pickInfo, err = model.NewPickInfo(model.ItemID(e.ItemID), &DFItemID, bannerIDs)
+		if err != nil {
+			return nil, fmt.Errorf("model.NewPickInfoByDFItemID: %w", err)
+		}
 	}
 
 	offerItem := model.NewOfferItemFromRepository(
@@ -46,6 +50,7 @@ func ConvertOfferItemToModel(e *entity.OfferItem, schedules model.ScheduleList,
 		e.HasSample,
 		e.NeedsPreliminaryReview,
 		e.NeedsAfterReview,
+		e.RequiresSecondApproval,
 		e.NeedsPRMark,
 		e.PostRequired,
 		e.HasCoupon,
@@ -63,7 +68,8 @@ func ConvertOfferItemToModel(e *entity.OfferItem, schedules model.ScheduleList,
 		e.IsArticlePostMailSent,
 		e.IsPassedAfterReviewMailSent,
 		e.IsFailedAfterReviewMailSent,
-		e.IsClosed,
+		model.OfferItemStatus(e.Status),
+		e.Version,
 		e.CreatedAt,
 		schedules,
 		draftedItemInfo,
@@ -77,11 +83,15 @@ func ConvertOfferItemModelToEntity(offerItem *model.OfferItem) entity.OfferItem
 	if offerItem.DfItem() != nil {
 		dfItemID = offerItem.DfItem().ID().String()
 	}
+	var itemID string
+	if offerItem.Item() != nil {
+		itemID = offerItem.Item().ID().String()
+	}
 
 	return entity.OfferItem{
 		ID:       offerItem.ID().String(),
 		Name:     offerItem.Name(),
-		ItemID:   offerItem.Item().ID().String(),
+		ItemID:   itemID,
 		DFItemID: null.StringFrom(dfItemID),
 		CouponBannerID: func() null.String {
 			if offerItem.CouponBannerID() == nil {
@@ -94,6 +104,7 @@ func ConvertOfferItemModelToEntity(offerItem *model.OfferItem) entity.OfferItem
 		HasSample:                         offerItem.HasSample(),
 		NeedsPreliminaryReview:            offerItem.NeedsPreliminaryReview(),
 		NeedsAfterReview:                  offerItem.NeedsAfterReview(),
+		RequiresSecondApproval:            offerItem.RequiresSecondApproval(),
 		NeedsPRMark:                       offerItem.NeedsPRMark(),
 		PostRequired:                      offerItem.PostRequired(),
 		PostTarget:                        uint(offerItem.PostTarget()),
@@ -111,6 +122,10 @@ func ConvertOfferItemModelToEntity(offerItem *model.OfferItem) entity.OfferItem
 		IsArticlePostMailSent:             offerItem.IsArticlePostMailSent(),
 		IsPassedAfterReviewMailSent:       offerItem.IsPassedAfterReviewMailSent(),
 		IsFailedAfterReviewMailSent:       offerItem.IsFailedAfterReviewMailSent(),
-		IsClosed:                          offerItem.IsClosed(),
+		Status:                            offerItem.Status().Int(),
+		Version:                           offerItem.Version(),
+		// 旧バージョンのサーバーが参照するため、is_closed を削除するまで状態と合わせて更新する
+		// TODO: 全てのサーバーが status を参照するようになったら、is_closed を削除するマイグレーションを追加してこの項目を削除する
+		IsClosed: offerItem.IsClosed(),
 	}
 }
@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	null "github.com/volatiletech/null/v8"
+)
+
+func PaymentBatchEntityToModel(e *entity.PaymentBatch, itemEntities entity.PaymentItemSlice) *model.PaymentBatch {
+	items := make([]model.PaymentItem, 0, len(itemEntities))
+	for _, ie := range itemEntities {
+		items = append(items, model.NewPaymentItemFromRepository(
+			model.PaymentItemID(ie.ID),
+			model.OfferItemID(ie.OfferItemID),
+			model.AssigneeID(ie.AssigneeID),
+			model.AmebaID(ie.AmebaID),
+			ie.WritingFee,
+			ie.SpecialAmount,
+			ie.Amount,
+		))
+	}
+	return model.NewPaymentBatchFromRepository(
+		model.PaymentBatchID(e.ID),
+		model.PaymentBatchStatus(e.Status),
+		items,
+		e.TotalAmount,
+		e.ConfirmedAt.Ptr(),
+		e.CreatedAt,
+	)
+}
+
+func PaymentBatchModelToEntity(m *model.PaymentBatch) *entity.PaymentBatch {
+	return &entity.PaymentBatch{
+		ID:          m.ID().String(),
+		Status:      uint(m.Status().Int()),
+		TotalAmount: m.TotalAmount(),
+		ConfirmedAt: null.TimeFromPtr(m.ConfirmedAt()),
+		CreatedAt:   m.CreatedAt(),
+	}
+}
+
+func PaymentItemModelToEntity(paymentBatchID model.PaymentBatchID, m model.PaymentItem) *entity.PaymentItem {
+	return &entity.PaymentItem{
+		ID:             m.ID().String(),
+		PaymentBatchID: paymentBatchID.String(),
+		OfferItemID:    m.OfferItemID().String(),
+		AssigneeID:     m.AssigneeID().String(),
+		AmebaID:        m.AmebaID().String(),
+		WritingFee:     m.WritingFee(),
+		SpecialAmount:  m.SpecialAmount(),
+		Amount:         m.Amount(),
+	}
+}
@@ -24,72 +24,82 @@ import (
 
 // Assignee is an object representing the database table.
 type Assignee struct {
-	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	OfferItemID   string      `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
-	AmebaID       string      `boil:"ameba_id" json:"ameba_id" toml:"ameba_id" yaml:"ameba_id"`
-	Stage         uint        `boil:"stage" json:"stage" toml:"stage" yaml:"stage"`
-	WritingFee    int         `boil:"writing_fee" json:"writing_fee" toml:"writing_fee" yaml:"writing_fee"`
-	DeclineReason null.String `boil:"decline_reason" json:"decline_reason,omitempty" toml:"decline_reason" yaml:"decline_reason,omitempty"`
-	CreatedAt     time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	CreatedBy     string      `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	UpdatedBy     string      `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
-	DeletedAt     null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OfferItemID    string      `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
+	AmebaID        string      `boil:"ameba_id" json:"ameba_id" toml:"ameba_id" yaml:"ameba_id"`
+	Stage          uint        `boil:"stage" json:"stage" toml:"stage" yaml:"stage"`
+	WritingFee     int         `boil:"writing_fee" json:"writing_fee" toml:"writing_fee" yaml:"writing_fee"`
+	DeclineReason  null.String `boil:"decline_reason" json:"decline_reason,omitempty" toml:"decline_reason" yaml:"decline_reason,omitempty"`
+	PaymentBatchID null.String `boil:"payment_batch_id" json:"payment_batch_id,omitempty" toml:"payment_batch_id" yaml:"payment_batch_id,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	CreatedBy      string      `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	UpdatedAt      time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UpdatedBy      string      `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	DeletedAt      null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedBy      null.String `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 
 	R *assigneeR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L assigneeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AssigneeColumns = struct {
-	ID            string
-	OfferItemID   string
-	AmebaID       string
-	Stage         string
-	WritingFee    string
-	DeclineReason string
-	CreatedAt     string
-	CreatedBy     string
-	UpdatedAt     string
-	UpdatedBy     string
-	DeletedAt     string
+	ID             string
+	OfferItemID    string
+	AmebaID        string
+	Stage          string
+	WritingFee     string
+	DeclineReason  string
+	PaymentBatchID string
+	CreatedAt      string
+	CreatedBy      string
+	UpdatedAt      string
+	UpdatedBy      string
+	DeletedAt      string
+	DeletedBy      string
 }{
-	ID:            "id",
-	OfferItemID:   "offer_item_id",
-	AmebaID:       "ameba_id",
-	Stage:         "stage",
-	WritingFee:    "writing_fee",
-	DeclineReason: "decline_reason",
-	CreatedAt:     "created_at",
-	CreatedBy:     "created_by",
-	UpdatedAt:     "updated_at",
-	UpdatedBy:     "updated_by",
-	DeletedAt:     "deleted_at",
+	ID:             "id",
+	OfferItemID:    "offer_item_id",
+	AmebaID:        "ameba_id",
+	Stage:          "stage",
+	WritingFee:     "writing_fee",
+	DeclineReason:  "decline_reason",
+	PaymentBatchID: "payment_batch_id",
+	CreatedAt:      "created_at",
+	CreatedBy:      "created_by",
+	UpdatedAt:      "updated_at",
+	UpdatedBy:      "updated_by",
+	DeletedAt:      "deleted_at",
+	DeletedBy:      "deleted_by",
 }
 
 var AssigneeTableColumns = struct {
-	ID            string
-	OfferItemID   string
-	AmebaID       string
-	Stage         string
-	WritingFee    string
-	DeclineReason string
-	CreatedAt     string
-	CreatedBy     string
-	UpdatedAt     string
-	UpdatedBy     string
-	DeletedAt     string
+	ID             string
+	OfferItemID    string
+	AmebaID        string
+	Stage          string
+	WritingFee     string
+	DeclineReason  string
+	PaymentBatchID string
+	CreatedAt      string
+	CreatedBy      string
+	UpdatedAt      string
+	UpdatedBy      string
+	DeletedAt      string
+	DeletedBy      string
 }{
-	ID:            "assignee.id",
-	OfferItemID:   "assignee.offer_item_id",
-	AmebaID:       "assignee.ameba_id",
-	Stage:         "assignee.stage",
-	WritingFee:    "assignee.writing_fee",
-	DeclineReason: "assignee.decline_reason",
-	CreatedAt:     "assignee.created_at",
-	CreatedBy:     "assignee.created_by",
-	UpdatedAt:     "assignee.updated_at",
-	UpdatedBy:     "assignee.updated_by",
-	DeletedAt:     "assignee.deleted_at",
+	ID:             "assignee.id",
+	OfferItemID:    "assignee.offer_item_id",
+	AmebaID:        "assignee.ameba_id",
+	Stage:          "assignee.stage",
+	WritingFee:     "assignee.writing_fee",
+	DeclineReason:  "assignee.decline_reason",
+	PaymentBatchID: "assignee.payment_batch_id",
+	CreatedAt:      "assignee.created_at",
+	CreatedBy:      "assignee.created_by",
+	UpdatedAt:      "assignee.updated_at",
+	UpdatedBy:      "assignee.updated_by",
+	DeletedAt:      "assignee.deleted_at",
+	DeletedBy:      "assignee.deleted_by",
 }
 
 // Generated where
@@ -255,29 +265,33 @@ func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsN
 func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var AssigneeWhere = struct {
-	ID            whereHelperstring
-	OfferItemID   whereHelperstring
-	AmebaID       whereHelperstring
-	Stage         whereHelperuint
-	WritingFee    whereHelperint
-	DeclineReason whereHelpernull_String
-	CreatedAt     whereHelpertime_Time
-	CreatedBy     whereHelperstring
-	UpdatedAt     whereHelpertime_Time
-	UpdatedBy     whereHelperstring
-	DeletedAt     whereHelpernull_Time
+	ID             whereHelperstring
+	OfferItemID    whereHelperstring
+	AmebaID        whereHelperstring
+	Stage          whereHelperuint
+	WritingFee     whereHelperint
+	DeclineReason  whereHelpernull_String
+	PaymentBatchID whereHelpernull_String
+	CreatedAt      whereHelpertime_Time
+	CreatedBy      whereHelperstring
+	UpdatedAt      whereHelpertime_Time
+	UpdatedBy      whereHelperstring
+	DeletedAt      whereHelpernull_Time
+	DeletedBy      whereHelpernull_String
 }{
-	ID:            whereHelperstring{field: "`assignee`.`id`"},
-	OfferItemID:   whereHelperstring{field: "`assignee`.`offer_item_id`"},
-	AmebaID:       whereHelperstring{field: "`assignee`.`ameba_id`"},
-	Stage:         whereHelperuint{field: "`assignee`.`stage`"},
-	WritingFee:    whereHelperint{field: "`assignee`.`writing_fee`"},
-	DeclineReason: whereHelpernull_String{field: "`assignee`.`decline_reason`"},
-	CreatedAt:     whereHelpertime_Time{field: "`assignee`.`created_at`"},
-	CreatedBy:     whereHelperstring{field: "`assignee`.`created_by`"},
-	UpdatedAt:     whereHelpertime_Time{field: "`assignee`.`updated_at`"},
-	UpdatedBy:     whereHelperstring{field: "`assignee`.`updated_by`"},
-	DeletedAt:     whereHelpernull_Time{field: "`assignee`.`deleted_at`"},
+	ID:             whereHelperstring{field: "`assignee`.`id`"},
+	OfferItemID:    whereHelperstring{field: "`assignee`.`offer_item_id`"},
+	AmebaID:        whereHelperstring{field: "`assignee`.`ameba_id`"},
+	Stage:          whereHelperuint{field: "`assignee`.`stage`"},
+	WritingFee:     whereHelperint{field: "`assignee`.`writing_fee`"},
+	DeclineReason:  whereHelpernull_String{field: "`assignee`.`decline_reason`"},
+	PaymentBatchID: whereHelpernull_String{field: "`assignee`.`payment_batch_id`"},
+	CreatedAt:      whereHelpertime_Time{field: "`assignee`.`created_at`"},
+	CreatedBy:      whereHelperstring{field: "`assignee`.`created_by`"},
+	UpdatedAt:      whereHelpertime_Time{field: "`assignee`.`updated_at`"},
+	UpdatedBy:      whereHelperstring{field: "`assignee`.`updated_by`"},
+	DeletedAt:      whereHelpernull_Time{field: "`assignee`.`deleted_at`"},
+	DeletedBy:      whereHelpernull_String{field: "`assignee`.`deleted_by`"},
 }
 
 // AssigneeRels is where relationship names are stored.
@@ -328,8 +342,8 @@ func (r *assigneeR) GetExaminations() ExaminationSlice {
 type assigneeL struct{}
 
 var (
-	assigneeAllColumns            = []string{"id", "offer_item_id", "ameba_id", "stage", "writing_fee", "decline_reason", "created_at", "created_by", "updated_at", "updated_by", "deleted_at"}
-	assigneeColumnsWithoutDefault = []string{"id", "offer_item_id", "ameba_id", "stage", "writing_fee", "decline_reason", "created_at", "created_by", "updated_at", "updated_by", "deleted_at"}
+	assigneeAllColumns            = []string{"id", "offer_item_id", "ameba_id", "stage", "writing_fee", "decline_reason", "payment_batch_id", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
+	assigneeColumnsWithoutDefault = []string{"id", "offer_item_id", "ameba_id", "stage", "writing_fee", "decline_reason", "payment_batch_id", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
 	assigneeColumnsWithDefault    = []string{}
 	assigneePrimaryKeyColumns     = []string{"id"}
 	assigneeGeneratedColumns      = []string{}
@@ -4,23 +4,37 @@
 package entity
 
 var TableNames = struct {
-	Assignee                    string
-	AssigneeLog                 string
-	DraftedItemInfo             string
-	Examination                 string
-	OfferItem                   string
-	Questionnaire               string
-	QuestionnaireQuestion       string
-	QuestionnaireQuestionAnswer string
-	Schedule                    string
+	Assignee                     string
+	AssigneeLog                  string
+	DraftedItemInfo              string
+	Examination                  string
+	OfferItem                    string
+	OfferItemArchive             string
+	OfferItemRevision            string
+	PaymentBatch                 string
+	PaymentItem                  string
+	Questionnaire                string
+	QuestionnaireQuestion        string
+	QuestionnaireQuestionAnswer  string
+	QuestionnaireQuestionHistory string
+	Schedule                     string
+	WritingFeeHistory            string
+	WritingFeeTier               string
 }{
-	Assignee:                    "assignee",
-	AssigneeLog:                 "assignee_log",
-	DraftedItemInfo:             "drafted_item_info",
-	Examination:                 "examination",
-	OfferItem:                   "offer_item",
-	Questionnaire:               "questionnaire",
-	QuestionnaireQuestion:       "questionnaire_question",
-	QuestionnaireQuestionAnswer: "questionnaire_question_answer",
-	Schedule:                    "schedule",
+	Assignee:                     "assignee",
+	AssigneeLog:                  "assignee_log",
+	DraftedItemInfo:              "drafted_item_info",
+	Examination:                  "examination",
+	OfferItem:                    "offer_item",
+	OfferItemArchive:             "offer_item_archive",
+	OfferItemRevision:            "offer_item_revision",
+	PaymentBatch:                 "payment_batch",
+	PaymentItem:                  "payment_item",
+	Questionnaire:                "questionnaire",
+	QuestionnaireQuestion:        "questionnaire_question",
+	QuestionnaireQuestionAnswer:  "questionnaire_question_answer",
+	QuestionnaireQuestionHistory: "questionnaire_question_history",
+	Schedule:                     "schedule",
+	WritingFeeHistory:            "writing_fee_history",
+	WritingFeeTier:               "writing_fee_tier",
 }
@@ -40,10 +40,11 @@ type DraftedItemInfo struct {
 	// 最大の報酬額。
 	MaxCommission float64 `boil:"max_commission" json:"max_commission" toml:"max_commission" yaml:"max_commission"`
 	// commissionのタイプ。
-	MaxCommissionType int       `boil:"max_commission_type" json:"max_commission_type" toml:"max_commission_type" yaml:"max_commission_type"`
-	CreatedAt         time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt         time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	DeletedAt         null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	MaxCommissionType int         `boil:"max_commission_type" json:"max_commission_type" toml:"max_commission_type" yaml:"max_commission_type"`
+	CreatedAt         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt         null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedBy         null.String `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 
 	R *draftedItemInfoR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L draftedItemInfoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -62,6 +63,7 @@ var DraftedItemInfoColumns = struct {
 	CreatedAt         string
 	UpdatedAt         string
 	DeletedAt         string
+	DeletedBy         string
 }{
 	OfferItemID:       "offer_item_id",
 	Name:              "name",
@@ -75,6 +77,7 @@ var DraftedItemInfoColumns = struct {
 	CreatedAt:         "created_at",
 	UpdatedAt:         "updated_at",
 	DeletedAt:         "deleted_at",
+	DeletedBy:         "deleted_by",
 }
 
 var DraftedItemInfoTableColumns = struct {
@@ -90,6 +93,7 @@ var DraftedItemInfoTableColumns = struct {
 	CreatedAt         string
 	UpdatedAt         string
 	DeletedAt         string
+	DeletedBy         string
 }{
 	OfferItemID:       "drafted_item_info.offer_item_id",
 	Name:              "drafted_item_info.name",
@@ -103,6 +107,7 @@ var DraftedItemInfoTableColumns = struct {
 	CreatedAt:         "drafted_item_info.created_at",
 	UpdatedAt:         "drafted_item_info.updated_at",
 	DeletedAt:         "drafted_item_info.deleted_at",
+	DeletedBy:         "drafted_item_info.deleted_by",
 }
 
 // Generated where
@@ -149,6 +154,7 @@ var DraftedItemInfoWhere = struct {
 	CreatedAt         whereHelpertime_Time
 	UpdatedAt         whereHelpertime_Time
 	DeletedAt         whereHelpernull_Time
+	DeletedBy         whereHelpernull_String
 }{
 	OfferItemID:       whereHelperstring{field: "`drafted_item_info`.`offer_item_id`"},
 	Name:              whereHelperstring{field: "`drafted_item_info`.`name`"},
@@ -162,6 +168,7 @@ var DraftedItemInfoWhere = struct {
 	CreatedAt:         whereHelpertime_Time{field: "`drafted_item_info`.`created_at`"},
 	UpdatedAt:         whereHelpertime_Time{field: "`drafted_item_info`.`updated_at`"},
 	DeletedAt:         whereHelpernull_Time{field: "`drafted_item_info`.`deleted_at`"},
+	DeletedBy:         whereHelpernull_String{field: "`drafted_item_info`.`deleted_by`"},
 }
 
 // DraftedItemInfoRels is where relationship names are stored.
@@ -192,8 +199,8 @@ func (r *draftedItemInfoR) GetOfferItem() *OfferItem {
 type draftedItemInfoL struct{}
 
 var (
-	draftedItemInfoAllColumns            = []string{"offer_item_id", "name", "content_name", "image_url", "url", "min_commission", "min_commission_type", "max_commission", "max_commission_type", "created_at", "updated_at", "deleted_at"}
-	draftedItemInfoColumnsWithoutDefault = []string{"offer_item_id", "name", "content_name", "image_url", "url", "min_commission", "min_commission_type", "max_commission", "max_commission_type", "created_at", "updated_at", "deleted_at"}
+	draftedItemInfoAllColumns            = []string{"offer_item_id", "name", "content_name", "image_url", "url", "min_commission", "min_commission_type", "max_commission", "max_commission_type", "created_at", "updated_at", "deleted_at", "deleted_by"}
+	draftedItemInfoColumnsWithoutDefault = []string{"offer_item_id", "name", "content_name", "image_url", "url", "min_commission", "min_commission_type", "max_commission", "max_commission_type", "created_at", "updated_at", "deleted_at", "deleted_by"}
 	draftedItemInfoColumnsWithDefault    = []string{}
 	draftedItemInfoPrimaryKeyColumns     = []string{"offer_item_id"}
 	draftedItemInfoGeneratedColumns      = []string{}
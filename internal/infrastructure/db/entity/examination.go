@@ -29,13 +29,17 @@ type Examination struct {
 	AssigneeID       string      `boil:"assignee_id" json:"assignee_id" toml:"assignee_id" yaml:"assignee_id"`
 	EntryID          null.String `boil:"entry_id" json:"entry_id,omitempty" toml:"entry_id" yaml:"entry_id,omitempty"`
 	SNSUserID        null.String `boil:"sns_user_id" json:"sns_user_id,omitempty" toml:"sns_user_id" yaml:"sns_user_id,omitempty"`
-	SNSScreenshotURL null.Bytes  `boil:"sns_screenshot_url" json:"sns_screenshot_url,omitempty" toml:"sns_screenshot_url" yaml:"sns_screenshot_url,omitempty"`
+	SNSScreenshotURL null.String `boil:"sns_screenshot_url" json:"sns_screenshot_url,omitempty" toml:"sns_screenshot_url" yaml:"sns_screenshot_url,omitempty"`
 	Reason           null.String `boil:"reason" json:"reason,omitempty" toml:"reason" yaml:"reason,omitempty"`
 	ExaminerName     null.String `boil:"examiner_name" json:"examiner_name,omitempty" toml:"examiner_name" yaml:"examiner_name,omitempty"`
+	IsPassed         null.Bool   `boil:"is_passed" json:"is_passed,omitempty" toml:"is_passed" yaml:"is_passed,omitempty"`
+	Status           uint        `boil:"status" json:"status" toml:"status" yaml:"status"`
+	ConfirmerName    null.String `boil:"confirmer_name" json:"confirmer_name,omitempty" toml:"confirmer_name" yaml:"confirmer_name,omitempty"`
 	EntryType        uint        `boil:"entry_type" json:"entry_type" toml:"entry_type" yaml:"entry_type"`
 	CreatedAt        time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt        time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedAt        null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedBy        null.String `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 
 	R *examinationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L examinationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,10 +54,14 @@ var ExaminationColumns = struct {
 	SNSScreenshotURL string
 	Reason           string
 	ExaminerName     string
+	IsPassed         string
+	Status           string
+	ConfirmerName    string
 	EntryType        string
 	CreatedAt        string
 	UpdatedAt        string
 	DeletedAt        string
+	DeletedBy        string
 }{
 	ID:               "id",
 	OfferItemID:      "offer_item_id",
@@ -63,10 +71,14 @@ var ExaminationColumns = struct {
 	SNSScreenshotURL: "sns_screenshot_url",
 	Reason:           "reason",
 	ExaminerName:     "examiner_name",
+	IsPassed:         "is_passed",
+	Status:           "status",
+	ConfirmerName:    "confirmer_name",
 	EntryType:        "entry_type",
 	CreatedAt:        "created_at",
 	UpdatedAt:        "updated_at",
 	DeletedAt:        "deleted_at",
+	DeletedBy:        "deleted_by",
 }
 
 var ExaminationTableColumns = struct {
@@ -78,10 +90,14 @@ var ExaminationTableColumns = struct {
 	SNSScreenshotURL string
 	Reason           string
 	ExaminerName     string
+	IsPassed         string
+	Status           string
+	ConfirmerName    string
 	EntryType        string
 	CreatedAt        string
 	UpdatedAt        string
 	DeletedAt        string
+	DeletedBy        string
 }{
 	ID:               "examination.id",
 	OfferItemID:      "examination.offer_item_id",
@@ -91,64 +107,52 @@ var ExaminationTableColumns = struct {
 	SNSScreenshotURL: "examination.sns_screenshot_url",
 	Reason:           "examination.reason",
 	ExaminerName:     "examination.examiner_name",
+	IsPassed:         "examination.is_passed",
+	Status:           "examination.status",
+	ConfirmerName:    "examination.confirmer_name",
 	EntryType:        "examination.entry_type",
 	CreatedAt:        "examination.created_at",
 	UpdatedAt:        "examination.updated_at",
 	DeletedAt:        "examination.deleted_at",
+	DeletedBy:        "examination.deleted_by",
 }
 
 // Generated where
 
-type whereHelpernull_Bytes struct{ field string }
-
-func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Bytes) NEQ(x null.Bytes) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Bytes) LT(x null.Bytes) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Bytes) LTE(x null.Bytes) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Bytes) GT(x null.Bytes) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Bytes) GTE(x null.Bytes) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Bytes) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Bytes) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var ExaminationWhere = struct {
 	ID               whereHelperstring
 	OfferItemID      whereHelperstring
 	AssigneeID       whereHelperstring
 	EntryID          whereHelpernull_String
 	SNSUserID        whereHelpernull_String
-	SNSScreenshotURL whereHelpernull_Bytes
+	SNSScreenshotURL whereHelpernull_String
 	Reason           whereHelpernull_String
 	ExaminerName     whereHelpernull_String
+	IsPassed         whereHelpernull_Bool
+	Status           whereHelperuint
+	ConfirmerName    whereHelpernull_String
 	EntryType        whereHelperuint
 	CreatedAt        whereHelpertime_Time
 	UpdatedAt        whereHelpertime_Time
 	DeletedAt        whereHelpernull_Time
+	DeletedBy        whereHelpernull_String
 }{
 	ID:               whereHelperstring{field: "`examination`.`id`"},
 	OfferItemID:      whereHelperstring{field: "`examination`.`offer_item_id`"},
 	AssigneeID:       whereHelperstring{field: "`examination`.`assignee_id`"},
 	EntryID:          whereHelpernull_String{field: "`examination`.`entry_id`"},
 	SNSUserID:        whereHelpernull_String{field: "`examination`.`sns_user_id`"},
-	SNSScreenshotURL: whereHelpernull_Bytes{field: "`examination`.`sns_screenshot_url`"},
+	SNSScreenshotURL: whereHelpernull_String{field: "`examination`.`sns_screenshot_url`"},
 	Reason:           whereHelpernull_String{field: "`examination`.`reason`"},
 	ExaminerName:     whereHelpernull_String{field: "`examination`.`examiner_name`"},
+	IsPassed:         whereHelpernull_Bool{field: "`examination`.`is_passed`"},
+	Status:           whereHelperuint{field: "`examination`.`status`"},
+	ConfirmerName:    whereHelpernull_String{field: "`examination`.`confirmer_name`"},
 	EntryType:        whereHelperuint{field: "`examination`.`entry_type`"},
 	CreatedAt:        whereHelpertime_Time{field: "`examination`.`created_at`"},
 	UpdatedAt:        whereHelpertime_Time{field: "`examination`.`updated_at`"},
 	DeletedAt:        whereHelpernull_Time{field: "`examination`.`deleted_at`"},
+	DeletedBy:        whereHelpernull_String{field: "`examination`.`deleted_by`"},
 }
 
 // ExaminationRels is where relationship names are stored.
@@ -189,9 +193,9 @@ func (r *examinationR) GetAssignee() *Assignee {
 type examinationL struct{}
 
 var (
-	examinationAllColumns            = []string{"id", "offer_item_id", "assignee_id", "entry_id", "sns_user_id", "sns_screenshot_url", "reason", "examiner_name", "entry_type", "created_at", "updated_at", "deleted_at"}
-	examinationColumnsWithoutDefault = []string{"id", "offer_item_id", "assignee_id", "entry_id", "sns_user_id", "sns_screenshot_url", "reason", "examiner_name", "entry_type", "created_at", "updated_at", "deleted_at"}
-	examinationColumnsWithDefault    = []string{}
+	examinationAllColumns            = []string{"id", "offer_item_id", "assignee_id", "entry_id", "sns_user_id", "sns_screenshot_url", "reason", "examiner_name", "is_passed", "status", "confirmer_name", "entry_type", "created_at", "updated_at", "deleted_at", "deleted_by"}
+	examinationColumnsWithoutDefault = []string{"id", "offer_item_id", "assignee_id", "entry_id", "sns_user_id", "sns_screenshot_url", "reason", "examiner_name", "is_passed", "confirmer_name", "entry_type", "created_at", "updated_at", "deleted_at", "deleted_by"}
+	examinationColumnsWithDefault    = []string{"status"}
 	examinationPrimaryKeyColumns     = []string{"id"}
 	examinationGeneratedColumns      = []string{}
 )
@@ -34,6 +34,7 @@ type OfferItem struct {
 	HasSample                         bool        `boil:"has_sample" json:"has_sample" toml:"has_sample" yaml:"has_sample"`
 	NeedsPreliminaryReview            bool        `boil:"needs_preliminary_review" json:"needs_preliminary_review" toml:"needs_preliminary_review" yaml:"needs_preliminary_review"`
 	NeedsAfterReview                  bool        `boil:"needs_after_review" json:"needs_after_review" toml:"needs_after_review" yaml:"needs_after_review"`
+	RequiresSecondApproval            bool        `boil:"requires_second_approval" json:"requires_second_approval" toml:"requires_second_approval" yaml:"requires_second_approval"`
 	NeedsPRMark                       bool        `boil:"needs_pr_mark" json:"needs_pr_mark" toml:"needs_pr_mark" yaml:"needs_pr_mark"`
 	PostRequired                      bool        `boil:"post_required" json:"post_required" toml:"post_required" yaml:"post_required"`
 	PostTarget                        uint        `boil:"post_target" json:"post_target" toml:"post_target" yaml:"post_target"`
@@ -51,6 +52,8 @@ type OfferItem struct {
 	IsArticlePostMailSent             bool        `boil:"is_article_post_mail_sent" json:"is_article_post_mail_sent" toml:"is_article_post_mail_sent" yaml:"is_article_post_mail_sent"`
 	IsPassedAfterReviewMailSent       bool        `boil:"is_passed_after_review_mail_sent" json:"is_passed_after_review_mail_sent" toml:"is_passed_after_review_mail_sent" yaml:"is_passed_after_review_mail_sent"`
 	IsFailedAfterReviewMailSent       bool        `boil:"is_failed_after_review_mail_sent" json:"is_failed_after_review_mail_sent" toml:"is_failed_after_review_mail_sent" yaml:"is_failed_after_review_mail_sent"`
+	Status                            int         `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Version                           int         `boil:"version" json:"version" toml:"version" yaml:"version"`
 	IsClosed                          bool        `boil:"is_closed" json:"is_closed" toml:"is_closed" yaml:"is_closed"`
 	CreatedAt                         time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	CreatedBy                         string      `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
@@ -74,6 +77,7 @@ var OfferItemColumns = struct {
 	HasSample                         string
 	NeedsPreliminaryReview            string
 	NeedsAfterReview                  string
+	RequiresSecondApproval            string
 	NeedsPRMark                       string
 	PostRequired                      string
 	PostTarget                        string
@@ -91,6 +95,8 @@ var OfferItemColumns = struct {
 	IsArticlePostMailSent             string
 	IsPassedAfterReviewMailSent       string
 	IsFailedAfterReviewMailSent       string
+	Status                            string
+	Version                           string
 	IsClosed                          string
 	CreatedAt                         string
 	CreatedBy                         string
@@ -109,6 +115,7 @@ var OfferItemColumns = struct {
 	HasSample:                         "has_sample",
 	NeedsPreliminaryReview:            "needs_preliminary_review",
 	NeedsAfterReview:                  "needs_after_review",
+	RequiresSecondApproval:            "requires_second_approval",
 	NeedsPRMark:                       "needs_pr_mark",
 	PostRequired:                      "post_required",
 	PostTarget:                        "post_target",
@@ -126,6 +133,8 @@ var OfferItemColumns = struct {
 	IsArticlePostMailSent:             "is_article_post_mail_sent",
 	IsPassedAfterReviewMailSent:       "is_passed_after_review_mail_sent",
 	IsFailedAfterReviewMailSent:       "is_failed_after_review_mail_sent",
+	Status:                            "status",
+	Version:                           "version",
 	IsClosed:                          "is_closed",
 	CreatedAt:                         "created_at",
 	CreatedBy:                         "created_by",
@@ -146,6 +155,7 @@ var OfferItemTableColumns = struct {
 	HasSample                         string
 	NeedsPreliminaryReview            string
 	NeedsAfterReview                  string
+	RequiresSecondApproval            string
 	NeedsPRMark                       string
 	PostRequired                      string
 	PostTarget                        string
@@ -163,6 +173,8 @@ var OfferItemTableColumns = struct {
 	IsArticlePostMailSent             string
 	IsPassedAfterReviewMailSent       string
 	IsFailedAfterReviewMailSent       string
+	Status                            string
+	Version                           string
 	IsClosed                          string
 	CreatedAt                         string
 	CreatedBy                         string
@@ -181,6 +193,7 @@ var OfferItemTableColumns = struct {
 	HasSample:                         "offer_item.has_sample",
 	NeedsPreliminaryReview:            "offer_item.needs_preliminary_review",
 	NeedsAfterReview:                  "offer_item.needs_after_review",
+	RequiresSecondApproval:            "offer_item.requires_second_approval",
 	NeedsPRMark:                       "offer_item.needs_pr_mark",
 	PostRequired:                      "offer_item.post_required",
 	PostTarget:                        "offer_item.post_target",
@@ -198,6 +211,8 @@ var OfferItemTableColumns = struct {
 	IsArticlePostMailSent:             "offer_item.is_article_post_mail_sent",
 	IsPassedAfterReviewMailSent:       "offer_item.is_passed_after_review_mail_sent",
 	IsFailedAfterReviewMailSent:       "offer_item.is_failed_after_review_mail_sent",
+	Status:                            "offer_item.status",
+	Version:                           "offer_item.version",
 	IsClosed:                          "offer_item.is_closed",
 	CreatedAt:                         "offer_item.created_at",
 	CreatedBy:                         "offer_item.created_by",
@@ -229,6 +244,7 @@ var OfferItemWhere = struct {
 	HasSample                         whereHelperbool
 	NeedsPreliminaryReview            whereHelperbool
 	NeedsAfterReview                  whereHelperbool
+	RequiresSecondApproval            whereHelperbool
 	NeedsPRMark                       whereHelperbool
 	PostRequired                      whereHelperbool
 	PostTarget                        whereHelperuint
@@ -246,6 +262,8 @@ var OfferItemWhere = struct {
 	IsArticlePostMailSent             whereHelperbool
 	IsPassedAfterReviewMailSent       whereHelperbool
 	IsFailedAfterReviewMailSent       whereHelperbool
+	Status                            whereHelperint
+	Version                           whereHelperint
 	IsClosed                          whereHelperbool
 	CreatedAt                         whereHelpertime_Time
 	CreatedBy                         whereHelperstring
@@ -264,6 +282,7 @@ var OfferItemWhere = struct {
 	HasSample:                         whereHelperbool{field: "`offer_item`.`has_sample`"},
 	NeedsPreliminaryReview:            whereHelperbool{field: "`offer_item`.`needs_preliminary_review`"},
 	NeedsAfterReview:                  whereHelperbool{field: "`offer_item`.`needs_after_review`"},
+	RequiresSecondApproval:            whereHelperbool{field: "`offer_item`.`requires_second_approval`"},
 	NeedsPRMark:                       whereHelperbool{field: "`offer_item`.`needs_pr_mark`"},
 	PostRequired:                      whereHelperbool{field: "`offer_item`.`post_required`"},
 	PostTarget:                        whereHelperuint{field: "`offer_item`.`post_target`"},
@@ -281,6 +300,8 @@ var OfferItemWhere = struct {
 	IsArticlePostMailSent:             whereHelperbool{field: "`offer_item`.`is_article_post_mail_sent`"},
 	IsPassedAfterReviewMailSent:       whereHelperbool{field: "`offer_item`.`is_passed_after_review_mail_sent`"},
 	IsFailedAfterReviewMailSent:       whereHelperbool{field: "`offer_item`.`is_failed_after_review_mail_sent`"},
+	Status:                            whereHelperint{field: "`offer_item`.`status`"},
+	Version:                           whereHelperint{field: "`offer_item`.`version`"},
 	IsClosed:                          whereHelperbool{field: "`offer_item`.`is_closed`"},
 	CreatedAt:                         whereHelpertime_Time{field: "`offer_item`.`created_at`"},
 	CreatedBy:                         whereHelperstring{field: "`offer_item`.`created_by`"},
@@ -378,9 +399,9 @@ func (r *offerItemR) GetSchedules() ScheduleSlice {
 type offerItemL struct{}
 
 var (
-	offerItemAllColumns            = []string{"id", "name", "item_id", "df_item_id", "coupon_banner_id", "special_rate", "special_amount", "has_sample", "needs_preliminary_review", "needs_after_review", "needs_pr_mark", "post_required", "post_target", "has_coupon", "has_special_commission", "has_lottery", "product_features", "cautionary_points", "reference_info", "other_info", "is_invitation_mail_sent", "is_offer_detail_mail_sent", "is_passed_preliminary_review_mail_sent", "is_failed_preliminary_review_mail_sent", "is_article_post_mail_sent", "is_passed_after_review_mail_sent", "is_failed_after_review_mail_sent", "is_closed", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
-	offerItemColumnsWithoutDefault = []string{"id", "name", "item_id", "df_item_id", "coupon_banner_id", "special_rate", "special_amount", "has_sample", "needs_preliminary_review", "needs_after_review", "post_required", "post_target", "has_coupon", "has_special_commission", "has_lottery", "product_features", "cautionary_points", "reference_info", "other_info", "is_invitation_mail_sent", "is_offer_detail_mail_sent", "is_passed_preliminary_review_mail_sent", "is_failed_preliminary_review_mail_sent", "is_article_post_mail_sent", "is_passed_after_review_mail_sent", "is_failed_after_review_mail_sent", "is_closed", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
-	offerItemColumnsWithDefault    = []string{"needs_pr_mark"}
+	offerItemAllColumns            = []string{"id", "name", "item_id", "df_item_id", "coupon_banner_id", "special_rate", "special_amount", "has_sample", "needs_preliminary_review", "needs_after_review", "requires_second_approval", "needs_pr_mark", "post_required", "post_target", "has_coupon", "has_special_commission", "has_lottery", "product_features", "cautionary_points", "reference_info", "other_info", "is_invitation_mail_sent", "is_offer_detail_mail_sent", "is_passed_preliminary_review_mail_sent", "is_failed_preliminary_review_mail_sent", "is_article_post_mail_sent", "is_passed_after_review_mail_sent", "is_failed_after_review_mail_sent", "status", "version", "is_closed", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
+	offerItemColumnsWithoutDefault = []string{"id", "name", "item_id", "df_item_id", "coupon_banner_id", "special_rate", "special_amount", "has_sample", "needs_preliminary_review", "needs_after_review", "post_required", "post_target", "has_coupon", "has_special_commission", "has_lottery", "product_features", "cautionary_points", "reference_info", "other_info", "is_invitation_mail_sent", "is_offer_detail_mail_sent", "is_passed_preliminary_review_mail_sent", "is_failed_preliminary_review_mail_sent", "is_article_post_mail_sent", "is_passed_after_review_mail_sent", "is_failed_after_review_mail_sent", "status", "is_closed", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
+	offerItemColumnsWithDefault    = []string{"requires_second_approval", "needs_pr_mark", "version"}
 	offerItemPrimaryKeyColumns     = []string{"id"}
 	offerItemGeneratedColumns      = []string{}
 )
@@ -958,6 +979,7 @@ func (offerItemL) LoadQuestionnaire(ctx context.Context, e boil.ContextExecutor,
 	query := NewQuery(
 		qm.From(`questionnaire`),
 		qm.WhereIn(`questionnaire.offer_item_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`questionnaire.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1302,6 +1324,7 @@ func (offerItemL) LoadQuestionnaireQuestions(ctx context.Context, e boil.Context
 	query := NewQuery(
 		qm.From(`questionnaire_question`),
 		qm.WhereIn(`questionnaire_question.offer_item_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`questionnaire_question.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1415,6 +1438,7 @@ func (offerItemL) LoadQuestionnaireQuestionAnswers(ctx context.Context, e boil.C
 	query := NewQuery(
 		qm.From(`questionnaire_question_answer`),
 		qm.WhereIn(`questionnaire_question_answer.offer_item_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`questionnaire_question_answer.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
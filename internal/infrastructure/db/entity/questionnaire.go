@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,8 +24,10 @@ import (
 
 // Questionnaire is an object representing the database table.
 type Questionnaire struct {
-	OfferItemID string `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
-	Description string `boil:"description" json:"description" toml:"description" yaml:"description"`
+	OfferItemID string      `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
+	Description string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedBy   null.String `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 
 	R *questionnaireR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L questionnaireL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -33,17 +36,25 @@ type Questionnaire struct {
 var QuestionnaireColumns = struct {
 	OfferItemID string
 	Description string
+	DeletedAt   string
+	DeletedBy   string
 }{
 	OfferItemID: "offer_item_id",
 	Description: "description",
+	DeletedAt:   "deleted_at",
+	DeletedBy:   "deleted_by",
 }
 
 var QuestionnaireTableColumns = struct {
 	OfferItemID string
 	Description string
+	DeletedAt   string
+	DeletedBy   string
 }{
 	OfferItemID: "questionnaire.offer_item_id",
 	Description: "questionnaire.description",
+	DeletedAt:   "questionnaire.deleted_at",
+	DeletedBy:   "questionnaire.deleted_by",
 }
 
 // Generated where
@@ -51,9 +62,13 @@ var QuestionnaireTableColumns = struct {
 var QuestionnaireWhere = struct {
 	OfferItemID whereHelperstring
 	Description whereHelperstring
+	DeletedAt   whereHelpernull_Time
+	DeletedBy   whereHelpernull_String
 }{
 	OfferItemID: whereHelperstring{field: "`questionnaire`.`offer_item_id`"},
 	Description: whereHelperstring{field: "`questionnaire`.`description`"},
+	DeletedAt:   whereHelpernull_Time{field: "`questionnaire`.`deleted_at`"},
+	DeletedBy:   whereHelpernull_String{field: "`questionnaire`.`deleted_by`"},
 }
 
 // QuestionnaireRels is where relationship names are stored.
@@ -84,8 +99,8 @@ func (r *questionnaireR) GetOfferItem() *OfferItem {
 type questionnaireL struct{}
 
 var (
-	questionnaireAllColumns            = []string{"offer_item_id", "description"}
-	questionnaireColumnsWithoutDefault = []string{"offer_item_id", "description"}
+	questionnaireAllColumns            = []string{"offer_item_id", "description", "deleted_at", "deleted_by"}
+	questionnaireColumnsWithoutDefault = []string{"offer_item_id", "description", "deleted_at", "deleted_by"}
 	questionnaireColumnsWithDefault    = []string{}
 	questionnairePrimaryKeyColumns     = []string{"offer_item_id"}
 	questionnaireGeneratedColumns      = []string{}
@@ -577,7 +592,7 @@ func (o *Questionnaire) SetOfferItem(ctx context.Context, exec boil.ContextExecu
 
 // Questionnaires retrieves all the records using an executor.
 func Questionnaires(mods ...qm.QueryMod) questionnaireQuery {
-	mods = append(mods, qm.From("`questionnaire`"))
+	mods = append(mods, qm.From("`questionnaire`"), qmhelper.WhereIsNull("`questionnaire`.`deleted_at`"))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"`questionnaire`.*"})
@@ -596,7 +611,7 @@ func FindQuestionnaire(ctx context.Context, exec boil.ContextExecutor, offerItem
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from `questionnaire` where `offer_item_id`=?", sel,
+		"select %s from `questionnaire` where `offer_item_id`=? and `deleted_at` is null", sel,
 	)
 
 	q := queries.Raw(query, offerItemID)
@@ -980,7 +995,7 @@ CacheNoHooks:
 
 // Delete deletes a single Questionnaire record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Questionnaire) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *Questionnaire) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("entity: no Questionnaire provided for delete")
 	}
@@ -989,8 +1004,26 @@ func (o *Questionnaire) Delete(ctx context.Context, exec boil.ContextExecutor) (
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), questionnairePrimaryKeyMapping)
-	sql := "DELETE FROM `questionnaire` WHERE `offer_item_id`=?"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), questionnairePrimaryKeyMapping)
+		sql = "DELETE FROM `questionnaire` WHERE `offer_item_id`=?"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `questionnaire` SET %s WHERE `offer_item_id`=?",
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		valueMapping, err := queries.BindMapping(questionnaireType, questionnaireMapping, append(wl, questionnairePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1015,12 +1048,17 @@ func (o *Questionnaire) Delete(ctx context.Context, exec boil.ContextExecutor) (
 }
 
 // DeleteAll deletes all matching rows.
-func (q questionnaireQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q questionnaireQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("entity: no questionnaireQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1036,7 +1074,7 @@ func (q questionnaireQuery) DeleteAll(ctx context.Context, exec boil.ContextExec
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o QuestionnaireSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o QuestionnaireSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1049,15 +1087,32 @@ func (o QuestionnaireSlice) DeleteAll(ctx context.Context, exec boil.ContextExec
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnairePrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnairePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM `questionnaire` WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnairePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnairePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `questionnaire` SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnairePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM `questionnaire` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnairePrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1111,7 +1166,8 @@ func (o *QuestionnaireSlice) ReloadAll(ctx context.Context, exec boil.ContextExe
 	}
 
 	sql := "SELECT `questionnaire`.* FROM `questionnaire` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnairePrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnairePrimaryKeyColumns, len(*o)) +
+		"and `deleted_at` is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1128,7 +1184,7 @@ func (o *QuestionnaireSlice) ReloadAll(ctx context.Context, exec boil.ContextExe
 // QuestionnaireExists checks if the Questionnaire row exists.
 func QuestionnaireExists(ctx context.Context, exec boil.ContextExecutor, offerItemID string) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from `questionnaire` where `offer_item_id`=? limit 1)"
+	sql := "select exists(select 1 from `questionnaire` where `offer_item_id`=? and `deleted_at` is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
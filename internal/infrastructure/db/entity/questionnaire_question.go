@@ -24,56 +24,134 @@ import (
 
 // QuestionnaireQuestion is an object representing the database table.
 type QuestionnaireQuestion struct {
-	ID            string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	OfferItemID   string    `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
-	Title         string    `boil:"title" json:"title" toml:"title" yaml:"title"`
-	Type          int       `boil:"type" json:"type" toml:"type" yaml:"type"`
-	Image         string    `boil:"image" json:"image" toml:"image" yaml:"image"`
-	AnswerOptions null.JSON `boil:"answer_options" json:"answer_options,omitempty" toml:"answer_options" yaml:"answer_options,omitempty"`
-	Priority      int       `boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	ID                         string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OfferItemID                string       `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
+	Title                      string       `boil:"title" json:"title" toml:"title" yaml:"title"`
+	Type                       int          `boil:"type" json:"type" toml:"type" yaml:"type"`
+	Image                      string       `boil:"image" json:"image" toml:"image" yaml:"image"`
+	AnswerOptions              null.JSON    `boil:"answer_options" json:"answer_options,omitempty" toml:"answer_options" yaml:"answer_options,omitempty"`
+	IsOptional                 bool         `boil:"is_optional" json:"is_optional" toml:"is_optional" yaml:"is_optional"`
+	MinValue                   null.Float64 `boil:"min_value" json:"min_value,omitempty" toml:"min_value" yaml:"min_value,omitempty"`
+	MaxValue                   null.Float64 `boil:"max_value" json:"max_value,omitempty" toml:"max_value" yaml:"max_value,omitempty"`
+	DisplayConditionQuestionID null.String  `boil:"display_condition_question_id" json:"display_condition_question_id,omitempty" toml:"display_condition_question_id" yaml:"display_condition_question_id,omitempty"`
+	DisplayConditionOption     null.String  `boil:"display_condition_option" json:"display_condition_option,omitempty" toml:"display_condition_option" yaml:"display_condition_option,omitempty"`
+	Priority                   int          `boil:"priority" json:"priority" toml:"priority" yaml:"priority"`
+	Version                    uint         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	DeletedAt                  null.Time    `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedBy                  null.String  `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 
 	R *questionnaireQuestionR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L questionnaireQuestionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var QuestionnaireQuestionColumns = struct {
-	ID            string
-	OfferItemID   string
-	Title         string
-	Type          string
-	Image         string
-	AnswerOptions string
-	Priority      string
+	ID                         string
+	OfferItemID                string
+	Title                      string
+	Type                       string
+	Image                      string
+	AnswerOptions              string
+	IsOptional                 string
+	MinValue                   string
+	MaxValue                   string
+	DisplayConditionQuestionID string
+	DisplayConditionOption     string
+	Priority                   string
+	Version                    string
+	DeletedAt                  string
+	DeletedBy                  string
 }{
-	ID:            "id",
-	OfferItemID:   "offer_item_id",
-	Title:         "title",
-	Type:          "type",
-	Image:         "image",
-	AnswerOptions: "answer_options",
-	Priority:      "priority",
+	ID:                         "id",
+	OfferItemID:                "offer_item_id",
+	Title:                      "title",
+	Type:                       "type",
+	Image:                      "image",
+	AnswerOptions:              "answer_options",
+	IsOptional:                 "is_optional",
+	MinValue:                   "min_value",
+	MaxValue:                   "max_value",
+	DisplayConditionQuestionID: "display_condition_question_id",
+	DisplayConditionOption:     "display_condition_option",
+	Priority:                   "priority",
+	Version:                    "version",
+	DeletedAt:                  "deleted_at",
+	DeletedBy:                  "deleted_by",
 }
 
 var QuestionnaireQuestionTableColumns = struct {
-	ID            string
-	OfferItemID   string
-	Title         string
-	Type          string
-	Image         string
-	AnswerOptions string
-	Priority      string
+	ID                         string
+	OfferItemID                string
+	Title                      string
+	Type                       string
+	Image                      string
+	AnswerOptions              string
+	IsOptional                 string
+	MinValue                   string
+	MaxValue                   string
+	DisplayConditionQuestionID string
+	DisplayConditionOption     string
+	Priority                   string
+	Version                    string
+	DeletedAt                  string
+	DeletedBy                  string
 }{
-	ID:            "questionnaire_question.id",
-	OfferItemID:   "questionnaire_question.offer_item_id",
-	Title:         "questionnaire_question.title",
-	Type:          "questionnaire_question.type",
-	Image:         "questionnaire_question.image",
-	AnswerOptions: "questionnaire_question.answer_options",
-	Priority:      "questionnaire_question.priority",
+	ID:                         "questionnaire_question.id",
+	OfferItemID:                "questionnaire_question.offer_item_id",
+	Title:                      "questionnaire_question.title",
+	Type:                       "questionnaire_question.type",
+	Image:                      "questionnaire_question.image",
+	AnswerOptions:              "questionnaire_question.answer_options",
+	IsOptional:                 "questionnaire_question.is_optional",
+	MinValue:                   "questionnaire_question.min_value",
+	MaxValue:                   "questionnaire_question.max_value",
+	DisplayConditionQuestionID: "questionnaire_question.display_condition_question_id",
+	DisplayConditionOption:     "questionnaire_question.display_condition_option",
+	Priority:                   "questionnaire_question.priority",
+	Version:                    "questionnaire_question.version",
+	DeletedAt:                  "questionnaire_question.deleted_at",
+	DeletedBy:                  "questionnaire_question.deleted_by",
 }
 
 // Generated where
 
+type whereHelpernull_Float64 struct{ field string }
+
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 type whereHelpernull_JSON struct{ field string }
 
 func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
@@ -99,21 +177,37 @@ func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsN
 func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var QuestionnaireQuestionWhere = struct {
-	ID            whereHelperstring
-	OfferItemID   whereHelperstring
-	Title         whereHelperstring
-	Type          whereHelperint
-	Image         whereHelperstring
-	AnswerOptions whereHelpernull_JSON
-	Priority      whereHelperint
+	ID                         whereHelperstring
+	OfferItemID                whereHelperstring
+	Title                      whereHelperstring
+	Type                       whereHelperint
+	Image                      whereHelperstring
+	AnswerOptions              whereHelpernull_JSON
+	IsOptional                 whereHelperbool
+	MinValue                   whereHelpernull_Float64
+	MaxValue                   whereHelpernull_Float64
+	DisplayConditionQuestionID whereHelpernull_String
+	DisplayConditionOption     whereHelpernull_String
+	Priority                   whereHelperint
+	Version                    whereHelperuint
+	DeletedAt                  whereHelpernull_Time
+	DeletedBy                  whereHelpernull_String
 }{
-	ID:            whereHelperstring{field: "`questionnaire_question`.`id`"},
-	OfferItemID:   whereHelperstring{field: "`questionnaire_question`.`offer_item_id`"},
-	Title:         whereHelperstring{field: "`questionnaire_question`.`title`"},
-	Type:          whereHelperint{field: "`questionnaire_question`.`type`"},
-	Image:         whereHelperstring{field: "`questionnaire_question`.`image`"},
-	AnswerOptions: whereHelpernull_JSON{field: "`questionnaire_question`.`answer_options`"},
-	Priority:      whereHelperint{field: "`questionnaire_question`.`priority`"},
+	ID:                         whereHelperstring{field: "`questionnaire_question`.`id`"},
+	OfferItemID:                whereHelperstring{field: "`questionnaire_question`.`offer_item_id`"},
+	Title:                      whereHelperstring{field: "`questionnaire_question`.`title`"},
+	Type:                       whereHelperint{field: "`questionnaire_question`.`type`"},
+	Image:                      whereHelperstring{field: "`questionnaire_question`.`image`"},
+	AnswerOptions:              whereHelpernull_JSON{field: "`questionnaire_question`.`answer_options`"},
+	IsOptional:                 whereHelperbool{field: "`questionnaire_question`.`is_optional`"},
+	MinValue:                   whereHelpernull_Float64{field: "`questionnaire_question`.`min_value`"},
+	MaxValue:                   whereHelpernull_Float64{field: "`questionnaire_question`.`max_value`"},
+	DisplayConditionQuestionID: whereHelpernull_String{field: "`questionnaire_question`.`display_condition_question_id`"},
+	DisplayConditionOption:     whereHelpernull_String{field: "`questionnaire_question`.`display_condition_option`"},
+	Priority:                   whereHelperint{field: "`questionnaire_question`.`priority`"},
+	Version:                    whereHelperuint{field: "`questionnaire_question`.`version`"},
+	DeletedAt:                  whereHelpernull_Time{field: "`questionnaire_question`.`deleted_at`"},
+	DeletedBy:                  whereHelpernull_String{field: "`questionnaire_question`.`deleted_by`"},
 }
 
 // QuestionnaireQuestionRels is where relationship names are stored.
@@ -144,9 +238,9 @@ func (r *questionnaireQuestionR) GetOfferItem() *OfferItem {
 type questionnaireQuestionL struct{}
 
 var (
-	questionnaireQuestionAllColumns            = []string{"id", "offer_item_id", "title", "type", "image", "answer_options", "priority"}
-	questionnaireQuestionColumnsWithoutDefault = []string{"id", "offer_item_id", "title", "type", "image", "answer_options", "priority"}
-	questionnaireQuestionColumnsWithDefault    = []string{}
+	questionnaireQuestionAllColumns            = []string{"id", "offer_item_id", "title", "type", "image", "answer_options", "is_optional", "min_value", "max_value", "display_condition_question_id", "display_condition_option", "priority", "version", "deleted_at", "deleted_by"}
+	questionnaireQuestionColumnsWithoutDefault = []string{"id", "offer_item_id", "title", "type", "image", "answer_options", "min_value", "max_value", "display_condition_question_id", "display_condition_option", "priority", "deleted_at", "deleted_by"}
+	questionnaireQuestionColumnsWithDefault    = []string{"is_optional", "version"}
 	questionnaireQuestionPrimaryKeyColumns     = []string{"id"}
 	questionnaireQuestionGeneratedColumns      = []string{}
 )
@@ -637,7 +731,7 @@ func (o *QuestionnaireQuestion) SetOfferItem(ctx context.Context, exec boil.Cont
 
 // QuestionnaireQuestions retrieves all the records using an executor.
 func QuestionnaireQuestions(mods ...qm.QueryMod) questionnaireQuestionQuery {
-	mods = append(mods, qm.From("`questionnaire_question`"))
+	mods = append(mods, qm.From("`questionnaire_question`"), qmhelper.WhereIsNull("`questionnaire_question`.`deleted_at`"))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"`questionnaire_question`.*"})
@@ -656,7 +750,7 @@ func FindQuestionnaireQuestion(ctx context.Context, exec boil.ContextExecutor, i
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from `questionnaire_question` where `id`=?", sel,
+		"select %s from `questionnaire_question` where `id`=? and `deleted_at` is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -1040,7 +1134,7 @@ CacheNoHooks:
 
 // Delete deletes a single QuestionnaireQuestion record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *QuestionnaireQuestion) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *QuestionnaireQuestion) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("entity: no QuestionnaireQuestion provided for delete")
 	}
@@ -1049,8 +1143,26 @@ func (o *QuestionnaireQuestion) Delete(ctx context.Context, exec boil.ContextExe
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), questionnaireQuestionPrimaryKeyMapping)
-	sql := "DELETE FROM `questionnaire_question` WHERE `id`=?"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), questionnaireQuestionPrimaryKeyMapping)
+		sql = "DELETE FROM `questionnaire_question` WHERE `id`=?"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `questionnaire_question` SET %s WHERE `id`=?",
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		valueMapping, err := queries.BindMapping(questionnaireQuestionType, questionnaireQuestionMapping, append(wl, questionnaireQuestionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1075,12 +1187,17 @@ func (o *QuestionnaireQuestion) Delete(ctx context.Context, exec boil.ContextExe
 }
 
 // DeleteAll deletes all matching rows.
-func (q questionnaireQuestionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q questionnaireQuestionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("entity: no questionnaireQuestionQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1096,7 +1213,7 @@ func (q questionnaireQuestionQuery) DeleteAll(ctx context.Context, exec boil.Con
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o QuestionnaireQuestionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o QuestionnaireQuestionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1109,15 +1226,32 @@ func (o QuestionnaireQuestionSlice) DeleteAll(ctx context.Context, exec boil.Con
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnaireQuestionPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnaireQuestionPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM `questionnaire_question` WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnaireQuestionPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `questionnaire_question` SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM `questionnaire_question` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1125,7 +1259,7 @@ func (o QuestionnaireQuestionSlice) DeleteAll(ctx context.Context, exec boil.Con
 	}
 	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
-		return 0, errors.Wrap(err, "entity: unable to delete all from questionnaireQuestion slice")
+		return 0, errors.Wrap(err, "entity: unable to delete all from questionnaire_question slice")
 	}
 
 	rowsAff, err := result.RowsAffected()
@@ -1171,7 +1305,8 @@ func (o *QuestionnaireQuestionSlice) ReloadAll(ctx context.Context, exec boil.Co
 	}
 
 	sql := "SELECT `questionnaire_question`.* FROM `questionnaire_question` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionPrimaryKeyColumns, len(*o)) +
+		"and `deleted_at` is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1188,7 +1323,7 @@ func (o *QuestionnaireQuestionSlice) ReloadAll(ctx context.Context, exec boil.Co
 // QuestionnaireQuestionExists checks if the QuestionnaireQuestion row exists.
 func QuestionnaireQuestionExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from `questionnaire_question` where `id`=? limit 1)"
+	sql := "select exists(select 1 from `questionnaire_question` where `id`=? and `deleted_at` is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,10 +24,14 @@ import (
 
 // QuestionnaireQuestionAnswer is an object representing the database table.
 type QuestionnaireQuestionAnswer struct {
-	AssigneeID              string `boil:"assignee_id" json:"assignee_id" toml:"assignee_id" yaml:"assignee_id"`
-	QuestionnaireQuestionID string `boil:"questionnaire_question_id" json:"questionnaire_question_id" toml:"questionnaire_question_id" yaml:"questionnaire_question_id"`
-	OfferItemID             string `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
-	Answer                  string `boil:"answer" json:"answer" toml:"answer" yaml:"answer"`
+	AssigneeID              string      `boil:"assignee_id" json:"assignee_id" toml:"assignee_id" yaml:"assignee_id"`
+	QuestionnaireQuestionID string      `boil:"questionnaire_question_id" json:"questionnaire_question_id" toml:"questionnaire_question_id" yaml:"questionnaire_question_id"`
+	OfferItemID             string      `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
+	Answer                  string      `boil:"answer" json:"answer" toml:"answer" yaml:"answer"`
+	QuestionVersion         uint        `boil:"question_version" json:"question_version" toml:"question_version" yaml:"question_version"`
+	SelectedOptions         null.JSON   `boil:"selected_options" json:"selected_options,omitempty" toml:"selected_options" yaml:"selected_options,omitempty"`
+	DeletedAt               null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedBy               null.String `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 
 	R *questionnaireQuestionAnswerR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L questionnaireQuestionAnswerL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -37,11 +42,19 @@ var QuestionnaireQuestionAnswerColumns = struct {
 	QuestionnaireQuestionID string
 	OfferItemID             string
 	Answer                  string
+	QuestionVersion         string
+	SelectedOptions         string
+	DeletedAt               string
+	DeletedBy               string
 }{
 	AssigneeID:              "assignee_id",
 	QuestionnaireQuestionID: "questionnaire_question_id",
 	OfferItemID:             "offer_item_id",
 	Answer:                  "answer",
+	QuestionVersion:         "question_version",
+	SelectedOptions:         "selected_options",
+	DeletedAt:               "deleted_at",
+	DeletedBy:               "deleted_by",
 }
 
 var QuestionnaireQuestionAnswerTableColumns = struct {
@@ -49,11 +62,19 @@ var QuestionnaireQuestionAnswerTableColumns = struct {
 	QuestionnaireQuestionID string
 	OfferItemID             string
 	Answer                  string
+	QuestionVersion         string
+	SelectedOptions         string
+	DeletedAt               string
+	DeletedBy               string
 }{
 	AssigneeID:              "questionnaire_question_answer.assignee_id",
 	QuestionnaireQuestionID: "questionnaire_question_answer.questionnaire_question_id",
 	OfferItemID:             "questionnaire_question_answer.offer_item_id",
 	Answer:                  "questionnaire_question_answer.answer",
+	QuestionVersion:         "questionnaire_question_answer.question_version",
+	SelectedOptions:         "questionnaire_question_answer.selected_options",
+	DeletedAt:               "questionnaire_question_answer.deleted_at",
+	DeletedBy:               "questionnaire_question_answer.deleted_by",
 }
 
 // Generated where
@@ -63,11 +84,19 @@ var QuestionnaireQuestionAnswerWhere = struct {
 	QuestionnaireQuestionID whereHelperstring
 	OfferItemID             whereHelperstring
 	Answer                  whereHelperstring
+	QuestionVersion         whereHelperuint
+	SelectedOptions         whereHelpernull_JSON
+	DeletedAt               whereHelpernull_Time
+	DeletedBy               whereHelpernull_String
 }{
 	AssigneeID:              whereHelperstring{field: "`questionnaire_question_answer`.`assignee_id`"},
 	QuestionnaireQuestionID: whereHelperstring{field: "`questionnaire_question_answer`.`questionnaire_question_id`"},
 	OfferItemID:             whereHelperstring{field: "`questionnaire_question_answer`.`offer_item_id`"},
 	Answer:                  whereHelperstring{field: "`questionnaire_question_answer`.`answer`"},
+	QuestionVersion:         whereHelperuint{field: "`questionnaire_question_answer`.`question_version`"},
+	SelectedOptions:         whereHelpernull_JSON{field: "`questionnaire_question_answer`.`selected_options`"},
+	DeletedAt:               whereHelpernull_Time{field: "`questionnaire_question_answer`.`deleted_at`"},
+	DeletedBy:               whereHelpernull_String{field: "`questionnaire_question_answer`.`deleted_by`"},
 }
 
 // QuestionnaireQuestionAnswerRels is where relationship names are stored.
@@ -98,9 +127,9 @@ func (r *questionnaireQuestionAnswerR) GetOfferItem() *OfferItem {
 type questionnaireQuestionAnswerL struct{}
 
 var (
-	questionnaireQuestionAnswerAllColumns            = []string{"assignee_id", "questionnaire_question_id", "offer_item_id", "answer"}
-	questionnaireQuestionAnswerColumnsWithoutDefault = []string{"assignee_id", "questionnaire_question_id", "offer_item_id", "answer"}
-	questionnaireQuestionAnswerColumnsWithDefault    = []string{}
+	questionnaireQuestionAnswerAllColumns            = []string{"assignee_id", "questionnaire_question_id", "offer_item_id", "answer", "question_version", "selected_options", "deleted_at", "deleted_by"}
+	questionnaireQuestionAnswerColumnsWithoutDefault = []string{"assignee_id", "questionnaire_question_id", "offer_item_id", "answer", "selected_options", "deleted_at", "deleted_by"}
+	questionnaireQuestionAnswerColumnsWithDefault    = []string{"question_version"}
 	questionnaireQuestionAnswerPrimaryKeyColumns     = []string{"assignee_id", "questionnaire_question_id"}
 	questionnaireQuestionAnswerGeneratedColumns      = []string{}
 )
@@ -591,7 +620,7 @@ func (o *QuestionnaireQuestionAnswer) SetOfferItem(ctx context.Context, exec boi
 
 // QuestionnaireQuestionAnswers retrieves all the records using an executor.
 func QuestionnaireQuestionAnswers(mods ...qm.QueryMod) questionnaireQuestionAnswerQuery {
-	mods = append(mods, qm.From("`questionnaire_question_answer`"))
+	mods = append(mods, qm.From("`questionnaire_question_answer`"), qmhelper.WhereIsNull("`questionnaire_question_answer`.`deleted_at`"))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"`questionnaire_question_answer`.*"})
@@ -610,7 +639,7 @@ func FindQuestionnaireQuestionAnswer(ctx context.Context, exec boil.ContextExecu
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from `questionnaire_question_answer` where `assignee_id`=? AND `questionnaire_question_id`=?", sel,
+		"select %s from `questionnaire_question_answer` where `assignee_id`=? AND `questionnaire_question_id`=? and `deleted_at` is null", sel,
 	)
 
 	q := queries.Raw(query, assigneeID, questionnaireQuestionID)
@@ -993,7 +1022,7 @@ CacheNoHooks:
 
 // Delete deletes a single QuestionnaireQuestionAnswer record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *QuestionnaireQuestionAnswer) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *QuestionnaireQuestionAnswer) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("entity: no QuestionnaireQuestionAnswer provided for delete")
 	}
@@ -1002,8 +1031,26 @@ func (o *QuestionnaireQuestionAnswer) Delete(ctx context.Context, exec boil.Cont
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), questionnaireQuestionAnswerPrimaryKeyMapping)
-	sql := "DELETE FROM `questionnaire_question_answer` WHERE `assignee_id`=? AND `questionnaire_question_id`=?"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), questionnaireQuestionAnswerPrimaryKeyMapping)
+		sql = "DELETE FROM `questionnaire_question_answer` WHERE `assignee_id`=? AND `questionnaire_question_id`=?"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `questionnaire_question_answer` SET %s WHERE `assignee_id`=? AND `questionnaire_question_id`=?",
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		valueMapping, err := queries.BindMapping(questionnaireQuestionAnswerType, questionnaireQuestionAnswerMapping, append(wl, questionnaireQuestionAnswerPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1028,12 +1075,17 @@ func (o *QuestionnaireQuestionAnswer) Delete(ctx context.Context, exec boil.Cont
 }
 
 // DeleteAll deletes all matching rows.
-func (q questionnaireQuestionAnswerQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q questionnaireQuestionAnswerQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("entity: no questionnaireQuestionAnswerQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1049,7 +1101,7 @@ func (q questionnaireQuestionAnswerQuery) DeleteAll(ctx context.Context, exec bo
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o QuestionnaireQuestionAnswerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o QuestionnaireQuestionAnswerSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1062,15 +1114,32 @@ func (o QuestionnaireQuestionAnswerSlice) DeleteAll(ctx context.Context, exec bo
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnaireQuestionAnswerPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnaireQuestionAnswerPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM `questionnaire_question_answer` WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionAnswerPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), questionnaireQuestionAnswerPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE `questionnaire_question_answer` SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionAnswerPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("`", "`", 0, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM `questionnaire_question_answer` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionAnswerPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1078,7 +1147,7 @@ func (o QuestionnaireQuestionAnswerSlice) DeleteAll(ctx context.Context, exec bo
 	}
 	result, err := exec.ExecContext(ctx, sql, args...)
 	if err != nil {
-		return 0, errors.Wrap(err, "entity: unable to delete all from questionnaireQuestionAnswer slice")
+		return 0, errors.Wrap(err, "entity: unable to delete all from questionnaire_question_answer slice")
 	}
 
 	rowsAff, err := result.RowsAffected()
@@ -1124,7 +1193,8 @@ func (o *QuestionnaireQuestionAnswerSlice) ReloadAll(ctx context.Context, exec b
 	}
 
 	sql := "SELECT `questionnaire_question_answer`.* FROM `questionnaire_question_answer` WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionAnswerPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, questionnaireQuestionAnswerPrimaryKeyColumns, len(*o)) +
+		"and `deleted_at` is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1141,7 +1211,7 @@ func (o *QuestionnaireQuestionAnswerSlice) ReloadAll(ctx context.Context, exec b
 // QuestionnaireQuestionAnswerExists checks if the QuestionnaireQuestionAnswer row exists.
 func QuestionnaireQuestionAnswerExists(ctx context.Context, exec boil.ContextExecutor, assigneeID string, questionnaireQuestionID string) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from `questionnaire_question_answer` where `assignee_id`=? AND `questionnaire_question_id`=? limit 1)"
+	sql := "select exists(select 1 from `questionnaire_question_answer` where `assignee_id`=? AND `questionnaire_question_id`=? and `deleted_at` is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
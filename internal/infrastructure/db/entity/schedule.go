@@ -24,16 +24,17 @@ import (
 
 // Schedule is an object representing the database table.
 type Schedule struct {
-	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	OfferItemID  string    `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
-	ScheduleType uint      `boil:"schedule_type" json:"schedule_type" toml:"schedule_type" yaml:"schedule_type"`
-	StartDate    null.Time `boil:"start_date" json:"start_date,omitempty" toml:"start_date" yaml:"start_date,omitempty"`
-	EndDate      null.Time `boil:"end_date" json:"end_date,omitempty" toml:"end_date" yaml:"end_date,omitempty"`
-	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	CreatedBy    string    `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
-	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	UpdatedBy    string    `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
-	DeletedAt    null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	OfferItemID  string      `boil:"offer_item_id" json:"offer_item_id" toml:"offer_item_id" yaml:"offer_item_id"`
+	ScheduleType uint        `boil:"schedule_type" json:"schedule_type" toml:"schedule_type" yaml:"schedule_type"`
+	StartDate    null.Time   `boil:"start_date" json:"start_date,omitempty" toml:"start_date" yaml:"start_date,omitempty"`
+	EndDate      null.Time   `boil:"end_date" json:"end_date,omitempty" toml:"end_date" yaml:"end_date,omitempty"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	CreatedBy    string      `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	UpdatedBy    string      `boil:"updated_by" json:"updated_by" toml:"updated_by" yaml:"updated_by"`
+	DeletedAt    null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	DeletedBy    null.String `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
 
 	R *scheduleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L scheduleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +51,7 @@ var ScheduleColumns = struct {
 	UpdatedAt    string
 	UpdatedBy    string
 	DeletedAt    string
+	DeletedBy    string
 }{
 	ID:           "id",
 	OfferItemID:  "offer_item_id",
@@ -61,6 +63,7 @@ var ScheduleColumns = struct {
 	UpdatedAt:    "updated_at",
 	UpdatedBy:    "updated_by",
 	DeletedAt:    "deleted_at",
+	DeletedBy:    "deleted_by",
 }
 
 var ScheduleTableColumns = struct {
@@ -74,6 +77,7 @@ var ScheduleTableColumns = struct {
 	UpdatedAt    string
 	UpdatedBy    string
 	DeletedAt    string
+	DeletedBy    string
 }{
 	ID:           "schedule.id",
 	OfferItemID:  "schedule.offer_item_id",
@@ -85,6 +89,7 @@ var ScheduleTableColumns = struct {
 	UpdatedAt:    "schedule.updated_at",
 	UpdatedBy:    "schedule.updated_by",
 	DeletedAt:    "schedule.deleted_at",
+	DeletedBy:    "schedule.deleted_by",
 }
 
 // Generated where
@@ -100,6 +105,7 @@ var ScheduleWhere = struct {
 	UpdatedAt    whereHelpertime_Time
 	UpdatedBy    whereHelperstring
 	DeletedAt    whereHelpernull_Time
+	DeletedBy    whereHelpernull_String
 }{
 	ID:           whereHelperstring{field: "`schedule`.`id`"},
 	OfferItemID:  whereHelperstring{field: "`schedule`.`offer_item_id`"},
@@ -111,6 +117,7 @@ var ScheduleWhere = struct {
 	UpdatedAt:    whereHelpertime_Time{field: "`schedule`.`updated_at`"},
 	UpdatedBy:    whereHelperstring{field: "`schedule`.`updated_by`"},
 	DeletedAt:    whereHelpernull_Time{field: "`schedule`.`deleted_at`"},
+	DeletedBy:    whereHelpernull_String{field: "`schedule`.`deleted_by`"},
 }
 
 // ScheduleRels is where relationship names are stored.
@@ -141,8 +148,8 @@ func (r *scheduleR) GetOfferItem() *OfferItem {
 type scheduleL struct{}
 
 var (
-	scheduleAllColumns            = []string{"id", "offer_item_id", "schedule_type", "start_date", "end_date", "created_at", "created_by", "updated_at", "updated_by", "deleted_at"}
-	scheduleColumnsWithoutDefault = []string{"id", "offer_item_id", "schedule_type", "start_date", "end_date", "created_at", "created_by", "updated_at", "updated_by", "deleted_at"}
+	scheduleAllColumns            = []string{"id", "offer_item_id", "schedule_type", "start_date", "end_date", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
+	scheduleColumnsWithoutDefault = []string{"id", "offer_item_id", "schedule_type", "start_date", "end_date", "created_at", "created_by", "updated_at", "updated_by", "deleted_at", "deleted_by"}
 	scheduleColumnsWithDefault    = []string{}
 	schedulePrimaryKeyColumns     = []string{"id"}
 	scheduleGeneratedColumns      = []string{}
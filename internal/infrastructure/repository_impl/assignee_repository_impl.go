@@ -10,11 +10,14 @@ import (
 	"github.com/terui-ryota/offer-item/internal/domain/repository"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/converter"
 	"github.com/terui-ryota/offer-item/pkg/apperr"
+	pkgid "github.com/terui-ryota/offer-item/pkg/id"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"go.opencensus.io/trace"
 
 	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/util/dbhelper"
 )
 
 func NewAssigneeRepositoryImpl() repository.AssigneeRepository {
@@ -119,9 +122,45 @@ func (a *AssigneeRepositoryImpl) Update(ctx context.Context, exec boil.ContextEx
 		return fmt.Errorf("entity.Assignees.Update: %w", err)
 	}
 
+	// 執筆報酬の変更履歴を監査ログとして保存する
+	changedBy := requestmeta.RequestedByFromContext(ctx)
+	for _, change := range assignee.WritingFeeChanges() {
+		historyEntity := &entity.WritingFeeHistory{
+			ID:                 pkgid.New(),
+			AssigneeID:         assignee.ID().String(),
+			PreviousWritingFee: change.PreviousWritingFee(),
+			WritingFee:         change.WritingFee(),
+			ChangedBy:          changedBy,
+			ChangedAt:          change.ChangedAt(),
+		}
+		if err := historyEntity.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("entity.WritingFeeHistory.Insert: %w", err)
+		}
+	}
+	assignee.ClearWritingFeeChanges()
+
 	return nil
 }
 
+// 執筆報酬の変更履歴を変更日時の昇順で取得する
+func (a *AssigneeRepositoryImpl) ListWritingFeeChanges(ctx context.Context, exec boil.ContextExecutor, assigneeID model.AssigneeID) ([]model.WritingFeeChange, error) {
+	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.ListWritingFeeChanges")
+	defer span.End()
+
+	historyEntities, err := entity.WritingFeeHistories(
+		entity.WritingFeeHistoryWhere.AssigneeID.EQ(assigneeID.String()),
+		qm.OrderBy(entity.WritingFeeHistoryColumns.ChangedAt),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.WritingFeeHistories.All: %w", err)
+	}
+	changes := make([]model.WritingFeeChange, 0, len(historyEntities))
+	for _, e := range historyEntities {
+		changes = append(changes, model.NewWritingFeeChangeFromRepository(e.PreviousWritingFee, e.WritingFee, e.ChangedBy, e.ChangedAt))
+	}
+	return changes, nil
+}
+
 // アサイニーを作成する
 func (a *AssigneeRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, assignee *model.Assignee) error {
 	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.Create")
@@ -180,6 +219,96 @@ func (a *AssigneeRepositoryImpl) ListUnderExamination(ctx context.Context, db bo
 	return assignees, nil
 }
 
+// 指定されたOfferItemIDとStageに紐づくAssigneeを、リスト条件に従ってページ単位で取得する
+func (a *AssigneeRepositoryImpl) ListPageByOfferItemIDStage(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, stage model.Stage, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
+	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.ListPageByOfferItemIDStage")
+	defer span.End()
+
+	result, err := listAssigneePage(ctx, exec, []qm.QueryMod{
+		entity.AssigneeWhere.OfferItemID.EQ(offerItemID.String()),
+		entity.AssigneeWhere.Stage.EQ(uint(stage)),
+	}, condition)
+	if err != nil {
+		return nil, fmt.Errorf("listAssigneePage: %w", err)
+	}
+	return result, nil
+}
+
+// 下書き審査、記事審査ステージのアサイニーを、リスト条件に従ってページ単位で取得する
+func (a *AssigneeRepositoryImpl) ListPageUnderExamination(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
+	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.ListPageUnderExamination")
+	defer span.End()
+
+	result, err := listAssigneePage(ctx, exec, []qm.QueryMod{
+		entity.AssigneeWhere.Stage.IN([]uint{uint(model.StagePreExamination), uint(model.StageExamination)}),
+	}, condition)
+	if err != nil {
+		return nil, fmt.Errorf("listAssigneePage: %w", err)
+	}
+	return result, nil
+}
+
+// 指定されたOfferItemIDに紐づくAssigneeを、リスト条件に従ってページ単位で取得する
+func (a *AssigneeRepositoryImpl) ListPageByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
+	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.ListPageByOfferItemID")
+	defer span.End()
+
+	result, err := listAssigneePage(ctx, exec, []qm.QueryMod{
+		entity.AssigneeWhere.OfferItemID.EQ(offerItemID.String()),
+	}, condition)
+	if err != nil {
+		return nil, fmt.Errorf("listAssigneePage: %w", err)
+	}
+	return result, nil
+}
+
+// アサイニー一覧のソートキーと ORDER BY の式の対応
+var assigneeSortExpressions = dbhelper.SortExpressions{
+	model.AssigneeSortKeyAmebaID.String():   dbhelper.ColumnSortExpression(entity.TableNames.Assignee, entity.AssigneeColumns.AmebaID),
+	model.AssigneeSortKeyCreatedAt.String(): dbhelper.ColumnSortExpression(entity.TableNames.Assignee, entity.AssigneeColumns.CreatedAt),
+	model.AssigneeSortKeyUpdatedAt.String(): dbhelper.ColumnSortExpression(entity.TableNames.Assignee, entity.AssigneeColumns.UpdatedAt),
+}
+
+func listAssigneePage(ctx context.Context, exec boil.ContextExecutor, queries []qm.QueryMod, condition *model.ListCondition) (*model.ListAssigneeResult, error) {
+	var totalCount int64
+	if condition.WithTotalCount() {
+		count, err := entity.Assignees(queries...).Count(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("entity.Assignees.Count: %w", err)
+		}
+		totalCount = count
+	}
+
+	listQueries, pagination, err := dbhelper.ListConditionQueryMods(entity.TableNames.Assignee, assigneeSortExpressions, condition)
+	if err != nil {
+		return nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("dbhelper.ListConditionQueryMods: %w", err))
+	}
+	assigneeEntities, err := entity.Assignees(append(queries, listQueries...)...).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.Assignees.All: %w", err)
+	}
+
+	var nextCursor *model.Cursor
+	if pagination != nil && len(assigneeEntities) > condition.Limit() {
+		assigneeEntities = assigneeEntities[:condition.Limit()]
+		if nextCursor, err = pagination.NextCursor(assigneeEntities[len(assigneeEntities)-1]); err != nil {
+			return nil, fmt.Errorf("pagination.NextCursor: %w", err)
+		}
+	}
+
+	assignees := make(model.AssigneeList, len(assigneeEntities))
+	for i, assigneeEntity := range assigneeEntities {
+		assignees[i] = converter.AssigneeEntityToModel(assigneeEntity)
+	}
+
+	result, err := model.NewListAssigneeResult(assignees, int(totalCount))
+	if err != nil {
+		return nil, fmt.Errorf("model.NewListAssigneeResult: %w", err)
+	}
+	result.ListResult().SetNextCursor(nextCursor)
+	return result, nil
+}
+
 // ステージごとのアサイニー数を取得する
 func (a *AssigneeRepositoryImpl) ListCount(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) ([]model.AssigneeCount, error) {
 	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.ListCount")
@@ -217,6 +346,27 @@ func (a *AssigneeRepositoryImpl) ListCount(ctx context.Context, exec boil.Contex
 	return assigneeCounts, nil
 }
 
+// 全てのオファー案件から指定したステージのアサイニーを取得する
+func (a *AssigneeRepositoryImpl) ListByStage(ctx context.Context, exec boil.ContextExecutor, stage model.Stage) (model.AssigneeList, error) {
+	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.ListByStage")
+	defer span.End()
+
+	assigneeEntities, err := entity.Assignees(
+		entity.AssigneeWhere.Stage.EQ(uint(stage)),
+		qm.OrderBy(entity.AssigneeColumns.OfferItemID),
+		qm.OrderBy(entity.AssigneeColumns.AmebaID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.Assignees.All: %w", err)
+	}
+
+	assignees := make(model.AssigneeList, 0, len(assigneeEntities))
+	for _, assigneeEntity := range assigneeEntities {
+		assignees = append(assignees, converter.AssigneeEntityToModel(assigneeEntity))
+	}
+	return assignees, nil
+}
+
 // ステージが「支払い中のアサイニーを取得する
 func (a *AssigneeRepositoryImpl) ListUnderPaying(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, amebaIDs []model.AmebaID) (model.AssigneeList, error) {
 	ctx, span := trace.StartSpan(ctx, "AssigneeRepositoryImpl.ListUnderPaying")
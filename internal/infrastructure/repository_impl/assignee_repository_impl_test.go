@@ -0,0 +1,35 @@
+package repository_impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/util/dbtest"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
+)
+
+func TestAssigneeRepositoryImpl_Update_WritingFeeHistory(t *testing.T) {
+	db, recorder := dbtest.OpenRecordingStubDB(t, nil)
+	ctx := requestmeta.WithRequestedBy(context.Background(), "operator")
+	assignee, err := model.NewAssignee("offer_item_id", "ameba_id", 1000, model.StageLottery)
+	assert.NoError(t, err)
+	assert.NoError(t, assignee.SetWritingFee(2000))
+	assert.NoError(t, assignee.SetWritingFee(3000))
+
+	repository := NewAssigneeRepositoryImpl()
+	assert.NoError(t, repository.Update(ctx, db, assignee))
+	// 変更履歴ごとに操作者を記録し、保存後は変更履歴を破棄する
+	statements := recorder.Statements()
+	assert.Equal(t, []string{entity.TableNames.Assignee, entity.TableNames.WritingFeeHistory, entity.TableNames.WritingFeeHistory}, statementTables(statements))
+	for _, s := range statements[1:] {
+		assert.Contains(t, s.Args, "operator")
+	}
+	assert.Empty(t, assignee.WritingFeeChanges())
+
+	// 続けて保存しても変更履歴は重複して記録されない
+	assert.NoError(t, repository.Update(ctx, db, assignee))
+	assert.Equal(t, []string{entity.TableNames.Assignee, entity.TableNames.WritingFeeHistory, entity.TableNames.WritingFeeHistory, entity.TableNames.Assignee}, statementTables(recorder.Statements()))
+}
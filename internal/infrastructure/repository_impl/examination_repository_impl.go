@@ -73,17 +73,87 @@ func (e *ExaminationRepositoryImpl) BulkGetByOfferItemID(ctx context.Context, ex
 	return examinationMap, nil
 }
 
-func (e *ExaminationRepositoryImpl) Get(ctx context.Context, exec *sql.DB, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType) (*model.Examination, error) {
+// BulkGetLatestByAssigneeIDs アサイニーごとに、記事タイプに関わらず最新の審査を取得する
+func (e *ExaminationRepositoryImpl) BulkGetLatestByAssigneeIDs(ctx context.Context, exec boil.ContextExecutor, assigneeIDs []model.AssigneeID) (map[model.AssigneeID]*model.Examination, error) {
+	ctx, span := trace.StartSpan(ctx, "ExaminationRepositoryImpl.BulkGetLatestByAssigneeIDs")
+	defer span.End()
+
+	if len(assigneeIDs) == 0 {
+		return map[model.AssigneeID]*model.Examination{}, nil
+	}
+	ids := make([]string, 0, len(assigneeIDs))
+	for _, id := range assigneeIDs {
+		ids = append(ids, id.String())
+	}
+
+	// アサイニーごとに作成日時が最新の審査のみを取得する
+	latestCreatedAt := fmt.Sprintf("%s = (SELECT MAX(latest.%s) FROM %s AS latest WHERE latest.%s = %s AND latest.%s IS NULL)",
+		entity.ExaminationTableColumns.CreatedAt,
+		entity.ExaminationColumns.CreatedAt,
+		entity.TableNames.Examination,
+		entity.ExaminationColumns.AssigneeID,
+		entity.ExaminationTableColumns.AssigneeID,
+		entity.ExaminationColumns.DeletedAt,
+	)
+	examinationEntities, err := entity.Examinations(
+		entity.ExaminationWhere.AssigneeID.IN(ids),
+		qm.Where(latestCreatedAt),
+		qm.OrderBy(entity.ExaminationColumns.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.Examinations.All: %w", err)
+	}
+
+	// 作成日時が同じ審査が複数ある場合は、ID の昇順で後に出現した審査で上書きする
+	latestExaminations := make(map[string]*entity.Examination, len(assigneeIDs))
+	for _, e := range examinationEntities {
+		latestExaminations[e.AssigneeID] = e
+	}
+
+	// 記事提出数は記事タイプごとに数える
+	var records []struct {
+		AssigneeID string `boil:"assignee_id"`
+		EntryType  uint   `boil:"entry_type"`
+		Count      int    `boil:"count"`
+	}
+	if err := entity.Examinations(
+		qm.Select(
+			entity.ExaminationColumns.AssigneeID,
+			entity.ExaminationColumns.EntryType,
+			"COUNT(*) AS count",
+		),
+		entity.ExaminationWhere.AssigneeID.IN(ids),
+		qm.GroupBy(entity.ExaminationColumns.AssigneeID+", "+entity.ExaminationColumns.EntryType),
+	).Bind(ctx, exec, &records); err != nil {
+		return nil, fmt.Errorf("entity.Examinations.Bind: %w", err)
+	}
+	submissionCounts := make(map[string]int, len(records))
+	for _, record := range records {
+		submissionCounts[fmt.Sprintf("%s:%d", record.AssigneeID, record.EntryType)] = record.Count
+	}
+
+	examinationMap := make(map[model.AssigneeID]*model.Examination, len(latestExaminations))
+	for assigneeID, e := range latestExaminations {
+		examinationMap[model.AssigneeID(assigneeID)] = converter.ExaminationEntityToModel(e, submissionCounts[fmt.Sprintf("%s:%d", e.AssigneeID, e.EntryType)])
+	}
+	return examinationMap, nil
+}
+
+func (e *ExaminationRepositoryImpl) Get(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, assigneeID model.AssigneeID, entryType model.EntryType, withLock bool) (*model.Examination, error) {
 	ctx, span := trace.StartSpan(ctx, "ExaminationRepositoryImpl.Get")
 	defer span.End()
 
-	entities, err := entity.Examinations(
+	queries := []qm.QueryMod{
 		entity.ExaminationWhere.OfferItemID.EQ(offerItemID.String()),
 		entity.ExaminationWhere.AssigneeID.EQ(assigneeID.String()),
 		entity.ExaminationWhere.EntryType.EQ(uint(entryType)),
 		qm.Load(entity.ExaminationRels.Assignee),
-		qm.OrderBy(entity.ExaminationColumns.CreatedAt+" DESC"),
-	).All(ctx, exec)
+		qm.OrderBy(entity.ExaminationColumns.CreatedAt + " DESC"),
+	}
+	if withLock {
+		queries = append(queries, qm.For("UPDATE"))
+	}
+	entities, err := entity.Examinations(queries...).All(ctx, exec)
 	if err != nil {
 		return nil, fmt.Errorf("entity.Examinations.All: %w", err)
 	}
@@ -98,7 +168,7 @@ func (e *ExaminationRepositoryImpl) Get(ctx context.Context, exec *sql.DB, offer
 	return examination, nil
 }
 
-func (e *ExaminationRepositoryImpl) Update(ctx context.Context, exec *sql.DB, examination *model.Examination) error {
+func (e *ExaminationRepositoryImpl) Update(ctx context.Context, exec boil.ContextExecutor, examination *model.Examination) error {
 	ctx, span := trace.StartSpan(ctx, "ExaminationRepositoryImpl.Update")
 	defer span.End()
 
@@ -0,0 +1,125 @@
+package repository_impl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/domain/repository"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.opencensus.io/trace"
+)
+
+func NewLegacyImageRepositoryImpl() repository.LegacyImageRepository {
+	return &LegacyImageRepositoryImpl{}
+}
+
+type LegacyImageRepositoryImpl struct{}
+
+// 論理削除された行も復元される可能性があるため対象にする
+// 外部のURLはデコードできる画像データではないため対象にしない
+func (l *LegacyImageRepositoryImpl) ListByColumn(ctx context.Context, exec boil.ContextExecutor, column model.LegacyImageColumn, afterID string, limit int) ([]*model.LegacyImage, error) {
+	ctx, span := trace.StartSpan(ctx, "LegacyImageRepositoryImpl.ListByColumn")
+	defer span.End()
+
+	keyPattern := column.ObjectKeyPrefix() + "/%"
+	images := make([]*model.LegacyImage, 0, limit)
+	switch column {
+	case model.LegacyImageColumnQuestionnaireQuestionImage:
+		entities, err := entity.QuestionnaireQuestions(
+			qm.WithDeleted(),
+			qm.Select(entity.QuestionnaireQuestionColumns.ID, entity.QuestionnaireQuestionColumns.Image),
+			entity.QuestionnaireQuestionWhere.ID.GT(afterID),
+			entity.QuestionnaireQuestionWhere.Image.NEQ(""),
+			qm.Where(entity.QuestionnaireQuestionColumns.Image+" NOT LIKE ?", keyPattern),
+			excludeExternalURL(entity.QuestionnaireQuestionColumns.Image),
+			qm.OrderBy(entity.QuestionnaireQuestionColumns.ID),
+			qm.Limit(limit),
+		).All(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("entity.QuestionnaireQuestions.All: %w", err)
+		}
+		for _, e := range entities {
+			images = append(images, model.NewLegacyImageFromRepository(column, e.ID, []byte(e.Image)))
+		}
+	case model.LegacyImageColumnQuestionnaireQuestionHistoryImage:
+		entities, err := entity.QuestionnaireQuestionHistories(
+			qm.Select(entity.QuestionnaireQuestionHistoryColumns.ID, entity.QuestionnaireQuestionHistoryColumns.Image),
+			entity.QuestionnaireQuestionHistoryWhere.ID.GT(afterID),
+			entity.QuestionnaireQuestionHistoryWhere.Image.NEQ(""),
+			qm.Where(entity.QuestionnaireQuestionHistoryColumns.Image+" NOT LIKE ?", keyPattern),
+			excludeExternalURL(entity.QuestionnaireQuestionHistoryColumns.Image),
+			qm.OrderBy(entity.QuestionnaireQuestionHistoryColumns.ID),
+			qm.Limit(limit),
+		).All(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("entity.QuestionnaireQuestionHistories.All: %w", err)
+		}
+		for _, e := range entities {
+			images = append(images, model.NewLegacyImageFromRepository(column, e.ID, []byte(e.Image)))
+		}
+	case model.LegacyImageColumnExaminationSNSScreenshot:
+		entities, err := entity.Examinations(
+			qm.WithDeleted(),
+			qm.Select(entity.ExaminationColumns.ID, entity.ExaminationColumns.SNSScreenshotURL),
+			entity.ExaminationWhere.ID.GT(afterID),
+			qm.Where(entity.ExaminationColumns.SNSScreenshotURL+" IS NOT NULL"),
+			qm.Where(entity.ExaminationColumns.SNSScreenshotURL+" NOT LIKE ?", keyPattern),
+			excludeExternalURL(entity.ExaminationColumns.SNSScreenshotURL),
+			qm.OrderBy(entity.ExaminationColumns.ID),
+			qm.Limit(limit),
+		).All(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("entity.Examinations.All: %w", err)
+		}
+		for _, e := range entities {
+			images = append(images, model.NewLegacyImageFromRepository(column, e.ID, []byte(e.SNSScreenshotURL.String)))
+		}
+	default:
+		return nil, fmt.Errorf("unknown legacy image column: %d", column)
+	}
+	return images, nil
+}
+
+// excludeExternalURL http(s) のURLを保存している行を除く
+func excludeExternalURL(column string) qm.QueryMod {
+	return qm.Where(column+" NOT LIKE ? AND "+column+" NOT LIKE ?", "http://%", "https://%")
+}
+
+// 取得時の値を条件に含め、移行中に更新された値を上書きしない
+func (l *LegacyImageRepositoryImpl) ReplaceWithObjectKey(ctx context.Context, exec boil.ContextExecutor, image *model.LegacyImage, key model.ObjectKey) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "LegacyImageRepositoryImpl.ReplaceWithObjectKey")
+	defer span.End()
+
+	var (
+		updated int64
+		err     error
+	)
+	switch image.Column() {
+	case model.LegacyImageColumnQuestionnaireQuestionImage:
+		updated, err = entity.QuestionnaireQuestions(
+			qm.WithDeleted(),
+			entity.QuestionnaireQuestionWhere.ID.EQ(image.ID()),
+			entity.QuestionnaireQuestionWhere.Image.EQ(string(image.Value())),
+		).UpdateAll(ctx, exec, entity.M{entity.QuestionnaireQuestionColumns.Image: key.String()})
+	case model.LegacyImageColumnQuestionnaireQuestionHistoryImage:
+		updated, err = entity.QuestionnaireQuestionHistories(
+			entity.QuestionnaireQuestionHistoryWhere.ID.EQ(image.ID()),
+			entity.QuestionnaireQuestionHistoryWhere.Image.EQ(string(image.Value())),
+		).UpdateAll(ctx, exec, entity.M{entity.QuestionnaireQuestionHistoryColumns.Image: key.String()})
+	case model.LegacyImageColumnExaminationSNSScreenshot:
+		updated, err = entity.Examinations(
+			qm.WithDeleted(),
+			entity.ExaminationWhere.ID.EQ(image.ID()),
+			qm.Where(entity.ExaminationColumns.SNSScreenshotURL+" = ?", image.Value()),
+		).UpdateAll(ctx, exec, entity.M{entity.ExaminationColumns.SNSScreenshotURL: key.String()})
+	default:
+		return false, fmt.Errorf("unknown legacy image column: %d", image.Column())
+	}
+	if err != nil {
+		return false, fmt.Errorf("UpdateAll(%s): %w", image.Column(), err)
+	}
+	return updated > 0, nil
+}
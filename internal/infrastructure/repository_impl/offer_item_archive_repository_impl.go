@@ -0,0 +1,539 @@
+package repository_impl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/domain/adapter"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/domain/repository"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/converter"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	pkgid "github.com/terui-ryota/offer-item/pkg/id"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.opencensus.io/trace"
+)
+
+// アーカイブのコンテンツタイプ。1行目にヘッダー、2行目以降に1行1レコードでテーブルの行を保存する
+const offerItemArchiveContentType = "application/x-ndjson"
+
+func NewOfferItemArchiveRepositoryImpl(objectStorage adapter.ObjectStorage) repository.OfferItemArchiveRepository {
+	return &OfferItemArchiveRepositoryImpl{
+		objectStorage: objectStorage,
+	}
+}
+
+type OfferItemArchiveRepositoryImpl struct {
+	objectStorage adapter.ObjectStorage
+}
+
+// アーカイブの1行目に保存するヘッダー
+type offerItemArchiveHeader struct {
+	FormatVersion int       `json:"format_version"`
+	OfferItemID   string    `json:"offer_item_id"`
+	ArchivedBy    string    `json:"archived_by"`
+	ArchivedAt    time.Time `json:"archived_at"`
+}
+
+// アーカイブの2行目以降に保存するテーブルの行。行はエンティティをそのまま保存する
+type offerItemArchiveRecord struct {
+	Table string          `json:"table"`
+	Row   json.RawMessage `json:"row"`
+}
+
+// アーカイブに含めるオファー案件の集約。論理削除済みのレコードも含める
+type offerItemArchiveContent struct {
+	offerItem                      *entity.OfferItem
+	schedules                      entity.ScheduleSlice
+	draftedItemInfos               entity.DraftedItemInfoSlice
+	questionnaires                 entity.QuestionnaireSlice
+	questionnaireQuestions         entity.QuestionnaireQuestionSlice
+	questionnaireQuestionHistories entity.QuestionnaireQuestionHistorySlice
+	assignees                      entity.AssigneeSlice
+	assigneeLogs                   entity.AssigneeLogSlice
+	writingFeeHistories            entity.WritingFeeHistorySlice
+	writingFeeTiers                entity.WritingFeeTierSlice
+	examinations                   entity.ExaminationSlice
+	questionnaireQuestionAnswers   entity.QuestionnaireQuestionAnswerSlice
+	offerItemRevisions             entity.OfferItemRevisionSlice
+}
+
+// 再取り込み時に挿入するテーブルの行
+type offerItemArchiveRow interface {
+	Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error
+}
+
+type offerItemArchiveTableRow struct {
+	table string
+	row   offerItemArchiveRow
+}
+
+// rows 外部キーの参照先から順にテーブルの行を返す
+func (c *offerItemArchiveContent) rows() []offerItemArchiveTableRow {
+	rows := []offerItemArchiveTableRow{{entity.TableNames.OfferItem, c.offerItem}}
+	for _, r := range c.schedules {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.Schedule, r})
+	}
+	for _, r := range c.draftedItemInfos {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.DraftedItemInfo, r})
+	}
+	for _, r := range c.questionnaires {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.Questionnaire, r})
+	}
+	for _, r := range c.questionnaireQuestions {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.QuestionnaireQuestion, r})
+	}
+	for _, r := range c.questionnaireQuestionHistories {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.QuestionnaireQuestionHistory, r})
+	}
+	for _, r := range c.assignees {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.Assignee, r})
+	}
+	for _, r := range c.assigneeLogs {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.AssigneeLog, r})
+	}
+	for _, r := range c.writingFeeHistories {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.WritingFeeHistory, r})
+	}
+	for _, r := range c.writingFeeTiers {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.WritingFeeTier, r})
+	}
+	for _, r := range c.examinations {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.Examination, r})
+	}
+	for _, r := range c.questionnaireQuestionAnswers {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.QuestionnaireQuestionAnswer, r})
+	}
+	for _, r := range c.offerItemRevisions {
+		rows = append(rows, offerItemArchiveTableRow{entity.TableNames.OfferItemRevision, r})
+	}
+	return rows
+}
+
+// addRow テーブル名に応じたエンティティに行を復元して追加する
+func (c *offerItemArchiveContent) addRow(table string, raw json.RawMessage) error {
+	switch table {
+	case entity.TableNames.OfferItem:
+		r := &entity.OfferItem{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.offerItem = r
+	case entity.TableNames.Schedule:
+		r := &entity.Schedule{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.schedules = append(c.schedules, r)
+	case entity.TableNames.DraftedItemInfo:
+		r := &entity.DraftedItemInfo{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.draftedItemInfos = append(c.draftedItemInfos, r)
+	case entity.TableNames.Questionnaire:
+		r := &entity.Questionnaire{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.questionnaires = append(c.questionnaires, r)
+	case entity.TableNames.QuestionnaireQuestion:
+		r := &entity.QuestionnaireQuestion{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.questionnaireQuestions = append(c.questionnaireQuestions, r)
+	case entity.TableNames.QuestionnaireQuestionHistory:
+		r := &entity.QuestionnaireQuestionHistory{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.questionnaireQuestionHistories = append(c.questionnaireQuestionHistories, r)
+	case entity.TableNames.Assignee:
+		r := &entity.Assignee{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.assignees = append(c.assignees, r)
+	case entity.TableNames.AssigneeLog:
+		r := &entity.AssigneeLog{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.assigneeLogs = append(c.assigneeLogs, r)
+	case entity.TableNames.WritingFeeHistory:
+		r := &entity.WritingFeeHistory{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.writingFeeHistories = append(c.writingFeeHistories, r)
+	case entity.TableNames.WritingFeeTier:
+		r := &entity.WritingFeeTier{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.writingFeeTiers = append(c.writingFeeTiers, r)
+	case entity.TableNames.Examination:
+		r := &entity.Examination{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.examinations = append(c.examinations, r)
+	case entity.TableNames.QuestionnaireQuestionAnswer:
+		r := &entity.QuestionnaireQuestionAnswer{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.questionnaireQuestionAnswers = append(c.questionnaireQuestionAnswers, r)
+	case entity.TableNames.OfferItemRevision:
+		r := &entity.OfferItemRevision{}
+		if err := json.Unmarshal(raw, r); err != nil {
+			return fmt.Errorf("json.Unmarshal: %w. table: %s", err, table)
+		}
+		c.offerItemRevisions = append(c.offerItemRevisions, r)
+	default:
+		return fmt.Errorf("unknown table: %s", table)
+	}
+	return nil
+}
+
+// オファー案件の集約をアーカイブとしてオブジェクトストレージに保存し、DBから削除する
+// 保存後にトランザクションがロールバックされた場合はオブジェクトが残るが、同じキーで上書きされるため問題ない
+func (o *OfferItemArchiveRepositoryImpl) Archive(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) (*model.OfferItemArchive, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemArchiveRepository.Archive")
+	defer span.End()
+
+	content, err := loadOfferItemArchiveContent(ctx, tx, offerItemID)
+	if err != nil {
+		return nil, fmt.Errorf("loadOfferItemArchiveContent: %w", err)
+	}
+
+	header := offerItemArchiveHeader{
+		FormatVersion: model.OfferItemArchiveFormatVersion,
+		OfferItemID:   offerItemID.String(),
+		ArchivedBy:    requestmeta.RequestedByFromContext(ctx),
+		ArchivedAt:    time.Now().Truncate(time.Second),
+	}
+	data, err := encodeOfferItemArchive(header, content)
+	if err != nil {
+		return nil, fmt.Errorf("encodeOfferItemArchive: %w", err)
+	}
+	objectKey := model.NewOfferItemArchiveObjectKey(offerItemID, header.FormatVersion)
+	if err := o.objectStorage.Put(ctx, *model.NewStorageObjectFromRepository(objectKey, offerItemArchiveContentType, data)); err != nil {
+		return nil, fmt.Errorf("o.objectStorage.Put: %w", err)
+	}
+
+	archiveEntity := &entity.OfferItemArchive{
+		ID:            pkgid.New(),
+		OfferItemID:   offerItemID.String(),
+		FormatVersion: header.FormatVersion,
+		ObjectKey:     objectKey.String(),
+		ArchivedBy:    header.ArchivedBy,
+		ArchivedAt:    header.ArchivedAt,
+	}
+	if err := archiveEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, apperr.OfferItemInternalError.Wrap(err)
+	}
+	if err := deleteOfferItemAggregate(ctx, tx, content.offerItem); err != nil {
+		return nil, fmt.Errorf("deleteOfferItemAggregate: %w", err)
+	}
+
+	archive, err := convertOfferItemArchiveToModel(ctx, archiveEntity, content)
+	if err != nil {
+		return nil, fmt.Errorf("convertOfferItemArchiveToModel: %w", err)
+	}
+	return archive, nil
+}
+
+// 退避したオファー案件をオブジェクトストレージから取得する
+func (o *OfferItemArchiveRepositoryImpl) Get(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (*model.OfferItemArchive, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemArchiveRepository.Get")
+	defer span.End()
+
+	archiveEntity, err := entity.OfferItemArchives(
+		entity.OfferItemArchiveWhere.OfferItemID.EQ(offerItemID.String()),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperr.OfferItemNotFoundError.Wrap(err)
+		}
+		return nil, fmt.Errorf("entity.OfferItemArchives.One: %w", err)
+	}
+	content, err := o.loadArchiveObject(ctx, archiveEntity)
+	if err != nil {
+		return nil, fmt.Errorf("o.loadArchiveObject: %w", err)
+	}
+	archive, err := convertOfferItemArchiveToModel(ctx, archiveEntity, content)
+	if err != nil {
+		return nil, fmt.Errorf("convertOfferItemArchiveToModel: %w", err)
+	}
+	return archive, nil
+}
+
+// 退避したオファー案件の集約をDBに再取り込みする
+// オブジェクトはトランザクションのロールバックに備えて残すため、不要になった場合は呼び出し元で削除する
+func (o *OfferItemArchiveRepositoryImpl) Unarchive(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) (*model.OfferItemArchive, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemArchiveRepository.Unarchive")
+	defer span.End()
+
+	archiveEntity, err := entity.OfferItemArchives(
+		entity.OfferItemArchiveWhere.OfferItemID.EQ(offerItemID.String()),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperr.OfferItemNotFoundError.Wrap(err)
+		}
+		return nil, fmt.Errorf("entity.OfferItemArchives.One: %w", err)
+	}
+	content, err := o.loadArchiveObject(ctx, archiveEntity)
+	if err != nil {
+		return nil, fmt.Errorf("o.loadArchiveObject: %w", err)
+	}
+
+	for _, r := range content.rows() {
+		// 列のデフォルト値で上書きされないよう、全ての列を指定して挿入する
+		if err := r.row.Insert(ctx, tx, boil.Whitelist(entityColumns(r.row)...)); err != nil {
+			return nil, apperr.OfferItemInternalError.Wrap(fmt.Errorf("%s.Insert: %w", r.table, err))
+		}
+	}
+	if _, err := archiveEntity.Delete(ctx, tx); err != nil {
+		return nil, fmt.Errorf("archiveEntity.Delete: %w", err)
+	}
+
+	archive, err := convertOfferItemArchiveToModel(ctx, archiveEntity, content)
+	if err != nil {
+		return nil, fmt.Errorf("convertOfferItemArchiveToModel: %w", err)
+	}
+	return archive, nil
+}
+
+// 指定日時より前に完了またはアーカイブの状態になったオファー案件のIDを取得する。論理削除済みのオファー案件は対象外とする
+func (o *OfferItemArchiveRepositoryImpl) ListArchivableIDs(ctx context.Context, exec boil.ContextExecutor, completedBefore time.Time) (model.OfferItemIDList, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemArchiveRepository.ListArchivableIDs")
+	defer span.End()
+
+	// 完了への遷移以降は更新されないため、更新日時を完了日時とみなす
+	offerItemEntities, err := entity.OfferItems(
+		qm.Select(entity.OfferItemColumns.ID),
+		entity.OfferItemWhere.Status.IN([]int{model.OfferItemStatusCompleted.Int(), model.OfferItemStatusArchived.Int()}),
+		entity.OfferItemWhere.UpdatedAt.LT(completedBefore),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.OfferItems.All: %w", err)
+	}
+	ids := make(model.OfferItemIDList, 0, len(offerItemEntities))
+	for _, e := range offerItemEntities {
+		ids = append(ids, model.OfferItemID(e.ID))
+	}
+	return ids, nil
+}
+
+func (o *OfferItemArchiveRepositoryImpl) loadArchiveObject(ctx context.Context, archiveEntity *entity.OfferItemArchive) (*offerItemArchiveContent, error) {
+	object, err := o.objectStorage.Get(ctx, model.ObjectKey(archiveEntity.ObjectKey))
+	if err != nil {
+		return nil, fmt.Errorf("o.objectStorage.Get: %w", err)
+	}
+	content, err := decodeOfferItemArchive(object.Data())
+	if err != nil {
+		return nil, apperr.OfferItemInternalError.Wrap(fmt.Errorf("decodeOfferItemArchive: %w. key: %s", err, archiveEntity.ObjectKey))
+	}
+	return content, nil
+}
+
+// loadOfferItemArchiveContent オファー案件の集約を論理削除済みのレコードも含めて行ロックして取得する
+func loadOfferItemArchiveContent(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID) (*offerItemArchiveContent, error) {
+	id := offerItemID.String()
+	offerItemEntity, err := entity.OfferItems(
+		entity.OfferItemWhere.ID.EQ(id),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperr.OfferItemNotFoundError.Wrap(err)
+		}
+		return nil, fmt.Errorf("entity.OfferItems.One: %w", err)
+	}
+
+	c := &offerItemArchiveContent{offerItem: offerItemEntity}
+	if c.schedules, err = entity.Schedules(qm.WithDeleted(), entity.ScheduleWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.Schedules.All: %w", err)
+	}
+	if c.draftedItemInfos, err = entity.DraftedItemInfos(qm.WithDeleted(), entity.DraftedItemInfoWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.DraftedItemInfos.All: %w", err)
+	}
+	if c.questionnaires, err = entity.Questionnaires(qm.WithDeleted(), entity.QuestionnaireWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.Questionnaires.All: %w", err)
+	}
+	if c.questionnaireQuestions, err = entity.QuestionnaireQuestions(qm.WithDeleted(), entity.QuestionnaireQuestionWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestions.All: %w", err)
+	}
+	if c.questionnaireQuestionHistories, err = entity.QuestionnaireQuestionHistories(entity.QuestionnaireQuestionHistoryWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionHistories.All: %w", err)
+	}
+	if c.assignees, err = entity.Assignees(qm.WithDeleted(), entity.AssigneeWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.Assignees.All: %w", err)
+	}
+	if len(c.assignees) > 0 {
+		assigneeIDs := make([]string, 0, len(c.assignees))
+		for _, a := range c.assignees {
+			assigneeIDs = append(assigneeIDs, a.ID)
+		}
+		if c.assigneeLogs, err = entity.AssigneeLogs(entity.AssigneeLogWhere.AssigneeID.IN(assigneeIDs)).All(ctx, tx); err != nil {
+			return nil, fmt.Errorf("entity.AssigneeLogs.All: %w", err)
+		}
+		if c.writingFeeHistories, err = entity.WritingFeeHistories(entity.WritingFeeHistoryWhere.AssigneeID.IN(assigneeIDs)).All(ctx, tx); err != nil {
+			return nil, fmt.Errorf("entity.WritingFeeHistories.All: %w", err)
+		}
+	}
+	if c.writingFeeTiers, err = entity.WritingFeeTiers(entity.WritingFeeTierWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.WritingFeeTiers.All: %w", err)
+	}
+	if c.examinations, err = entity.Examinations(qm.WithDeleted(), entity.ExaminationWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.Examinations.All: %w", err)
+	}
+	if c.questionnaireQuestionAnswers, err = entity.QuestionnaireQuestionAnswers(qm.WithDeleted(), entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionAnswers.All: %w", err)
+	}
+	if c.offerItemRevisions, err = entity.OfferItemRevisions(entity.OfferItemRevisionWhere.OfferItemID.EQ(id)).All(ctx, tx); err != nil {
+		return nil, fmt.Errorf("entity.OfferItemRevisions.All: %w", err)
+	}
+	return c, nil
+}
+
+// encodeOfferItemArchive ヘッダーとテーブルの行を NDJSON にする
+func encodeOfferItemArchive(header offerItemArchiveHeader, content *offerItemArchiveContent) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	if err := encoder.Encode(header); err != nil {
+		return nil, fmt.Errorf("encoder.Encode: %w", err)
+	}
+	for _, r := range content.rows() {
+		row, err := json.Marshal(r.row)
+		if err != nil {
+			return nil, fmt.Errorf("json.Marshal: %w. table: %s", err, r.table)
+		}
+		if err := encoder.Encode(offerItemArchiveRecord{Table: r.table, Row: row}); err != nil {
+			return nil, fmt.Errorf("encoder.Encode: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeOfferItemArchive NDJSON のアーカイブを読み込む。対応していない形式のバージョンの場合はエラーとする
+func decodeOfferItemArchive(data []byte) (*offerItemArchiveContent, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	// 変更履歴のスナップショットなど1行が大きくなる場合があるため、バッファを拡張する
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("scanner.Scan: %w", err)
+		}
+		return nil, fmt.Errorf("archive is empty")
+	}
+	var header offerItemArchiveHeader
+	if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	if header.FormatVersion != model.OfferItemArchiveFormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version: %d", header.FormatVersion)
+	}
+
+	content := &offerItemArchiveContent{}
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var record offerItemArchiveRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+		if err := content.addRow(record.Table, record.Row); err != nil {
+			return nil, fmt.Errorf("content.addRow: %w", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanner.Scan: %w", err)
+	}
+	if content.offerItem == nil || content.offerItem.ID != header.OfferItemID {
+		return nil, fmt.Errorf("offer item is not found in archive: %s", header.OfferItemID)
+	}
+	return content, nil
+}
+
+// entityColumns エンティティの boil タグから全ての列名を取得する
+func entityColumns(row interface{}) []string {
+	t := reflect.TypeOf(row)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	columns := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("boil"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		columns = append(columns, name)
+	}
+	return columns
+}
+
+// convertOfferItemArchiveToModel 論理削除されていないレコードから退避したオファー案件とアンケートを復元する
+func convertOfferItemArchiveToModel(ctx context.Context, archiveEntity *entity.OfferItemArchive, content *offerItemArchiveContent) (*model.OfferItemArchive, error) {
+	schedules := make(model.ScheduleList, 0, len(content.schedules))
+	for _, s := range content.schedules {
+		if s.DeletedAt.Valid {
+			continue
+		}
+		schedules = append(schedules, converter.ScheduleEntityToModel(s))
+	}
+	var draftedItemInfo *model.ItemInfo
+	for _, d := range content.draftedItemInfos {
+		if d.DeletedAt.Valid {
+			continue
+		}
+		var err error
+		if draftedItemInfo, err = converter.ConvertDraftedItemToModel(d); err != nil {
+			return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
+		}
+	}
+	offerItem, err := converter.ConvertOfferItemToModel(content.offerItem, schedules, draftedItemInfo)
+	if err != nil {
+		return nil, fmt.Errorf("converter.ConvertOfferItemToModel: %w", err)
+	}
+
+	var questionnaire *model.Questionnaire
+	for _, q := range content.questionnaires {
+		if q.DeletedAt.Valid {
+			continue
+		}
+		questions := make([]*entity.QuestionnaireQuestion, 0, len(content.questionnaireQuestions))
+		for _, question := range content.questionnaireQuestions {
+			if !question.DeletedAt.Valid {
+				questions = append(questions, question)
+			}
+		}
+		questionnaire = convertQuestionnaireToModel(ctx, q, questions, content.questionnaireQuestionHistories)
+	}
+
+	return model.NewOfferItemArchiveFromRepository(
+		model.OfferItemID(archiveEntity.OfferItemID),
+		archiveEntity.FormatVersion,
+		model.ObjectKey(archiveEntity.ObjectKey),
+		archiveEntity.ArchivedBy,
+		archiveEntity.ArchivedAt,
+		offerItem,
+		questionnaire,
+	), nil
+}
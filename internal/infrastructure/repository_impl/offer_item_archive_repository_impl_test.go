@@ -0,0 +1,75 @@
+package repository_impl
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/volatiletech/null/v8"
+)
+
+func TestOfferItemArchiveEncodeDecode(t *testing.T) {
+	archivedAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	content := &offerItemArchiveContent{
+		offerItem: &entity.OfferItem{ID: "offer_item_id", Name: "name", Status: model.OfferItemStatusArchived.Int(), Version: 3},
+		schedules: entity.ScheduleSlice{
+			{ID: "schedule_id", OfferItemID: "offer_item_id", ScheduleType: 1, StartDate: null.TimeFrom(archivedAt)},
+		},
+		assignees: entity.AssigneeSlice{
+			{ID: "assignee_1", OfferItemID: "offer_item_id"},
+			{ID: "assignee_2", OfferItemID: "offer_item_id", DeletedAt: null.TimeFrom(archivedAt)},
+		},
+		questionnaireQuestionAnswers: entity.QuestionnaireQuestionAnswerSlice{
+			{AssigneeID: "assignee_1", QuestionnaireQuestionID: "q1", OfferItemID: "offer_item_id", SelectedOptions: null.JSONFrom([]byte(`["a"]`))},
+		},
+	}
+	header := offerItemArchiveHeader{
+		FormatVersion: model.OfferItemArchiveFormatVersion,
+		OfferItemID:   "offer_item_id",
+		ArchivedAt:    archivedAt,
+	}
+
+	data, err := encodeOfferItemArchive(header, content)
+	assert.NoError(t, err)
+	// ヘッダーとテーブルの行が1行ずつ保存される
+	assert.Equal(t, 1+len(content.rows()), bytes.Count(data, []byte("\n")))
+
+	decoded, err := decodeOfferItemArchive(data)
+	assert.NoError(t, err)
+	assert.Equal(t, content.offerItem, decoded.offerItem)
+	assert.Equal(t, content.schedules, decoded.schedules)
+	assert.Equal(t, content.assignees, decoded.assignees)
+	assert.Equal(t, content.questionnaireQuestionAnswers, decoded.questionnaireQuestionAnswers)
+
+	t.Run("対応していないバージョン", func(t *testing.T) {
+		header := header
+		header.FormatVersion = model.OfferItemArchiveFormatVersion + 1
+		data, err := encodeOfferItemArchive(header, content)
+		assert.NoError(t, err)
+		_, err = decodeOfferItemArchive(data)
+		assert.Error(t, err)
+	})
+	t.Run("未知のテーブル", func(t *testing.T) {
+		unknown := append(append([]byte{}, data...), []byte(`{"table":"unknown","row":{}}`+"\n")...)
+		_, err := decodeOfferItemArchive(unknown)
+		assert.Error(t, err)
+	})
+	t.Run("ヘッダーと異なるオファー案件", func(t *testing.T) {
+		header := header
+		header.OfferItemID = "other_offer_item_id"
+		data, err := encodeOfferItemArchive(header, content)
+		assert.NoError(t, err)
+		_, err = decodeOfferItemArchive(data)
+		assert.Error(t, err)
+	})
+}
+
+func TestEntityColumns(t *testing.T) {
+	columns := entityColumns(&entity.QuestionnaireQuestionAnswer{})
+	assert.Contains(t, columns, entity.QuestionnaireQuestionAnswerColumns.AssigneeID)
+	assert.Contains(t, columns, entity.QuestionnaireQuestionAnswerColumns.DeletedAt)
+	assert.NotContains(t, columns, "-")
+}
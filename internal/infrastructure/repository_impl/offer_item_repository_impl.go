@@ -10,6 +10,8 @@ import (
 
 	"github.com/terui-ryota/offer-item/internal/domain/dto"
 	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"go.opencensus.io/trace"
@@ -48,72 +50,96 @@ func NewOfferItemRepositoryImpl() repository.OfferItemRepository {
 type OfferItemRepositoryImpl struct{}
 
 // // オファー案件の一覧を取得する
-func (o *OfferItemRepositoryImpl) List(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition, isClosed bool) (*model.ListOfferItemResult, error) {
+func (o *OfferItemRepositoryImpl) List(ctx context.Context, exec boil.ContextExecutor, condition *model.ListCondition, statuses []model.OfferItemStatus) (*model.ListOfferItemResult, error) {
 	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.List")
 	defer span.End()
 
 	queries := make([]qm.QueryMod, 0)
 
-	if !isClosed {
-		queries = append(queries, entity.OfferItemWhere.IsClosed.EQ(false))
+	// 状態が指定されていない場合は全ての状態を対象とする
+	if len(statuses) > 0 {
+		queries = append(queries, entity.OfferItemWhere.Status.IN(offerItemStatusesToInts(statuses)))
 	}
 	// データ取得前に検索結果の総数を取得する
-	totalCount, err := entity.OfferItems(queries...).Count(ctx, exec)
+	var totalCount int64
+	if condition.WithTotalCount() {
+		count, err := entity.OfferItems(queries...).Count(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("entity.OfferItems.Count: %w", err)
+		}
+		totalCount = count
+	}
+
+	offerItemEntities, nextCursor, err := listOfferItemEntities(ctx, exec, queries, condition)
 	if err != nil {
-		return nil, fmt.Errorf("entity.OfferItems.Count: %w", err)
+		return nil, fmt.Errorf("listOfferItemEntities: %w", err)
 	}
 
-	// リスト条件をクエリに追加する
-	queries = append(queries, qm.Limit(condition.Limit()), qm.Offset(condition.Offset()))
-	if len(condition.Sorts()) > 0 {
-		queries = append(queries, qm.OrderBy(dbhelper.CreateOrderByClause(entity.OfferItemColumns, condition.Sorts())))
+	// スケジュールと案件情報をまとめて取得し、モデルに変換する
+	offerItems, err := convertOfferItemEntitiesToModels(ctx, exec, offerItemEntities)
+	if err != nil {
+		return nil, fmt.Errorf("convertOfferItemEntitiesToModels: %w", err)
 	}
 
-	// DBからデータを取得し、モデルに変換する
-	offerItems := make(model.OfferItemList, 0, condition.Limit())
-	offerItemEntities, err := entity.OfferItems(queries...).All(ctx, exec)
+	listOfferItem, err := model.NewListOfferItemResult(offerItems, int(totalCount))
 	if err != nil {
-		return nil, fmt.Errorf("entity.OfferItems.All: %w", err)
+		return nil, fmt.Errorf("model.NewListOfferItemResult: %w", err)
 	}
+	listOfferItem.ListResult().SetNextCursor(nextCursor)
+	return listOfferItem, nil
+}
 
-	for _, offerItemEntity := range offerItemEntities {
-		offerItemID := model.OfferItemID(offerItemEntity.ID)
-		// offerItemIDをkeyにスケジュールを取得
-		scheduleEntities, err := entity.Schedules(entity.ScheduleWhere.OfferItemID.EQ(offerItemID.String())).All(ctx, exec)
-		if err != nil {
-			return nil, fmt.Errorf("entity.Schedules.All: %w", err)
-		}
-		schedules := make(model.ScheduleList, 0, len(scheduleEntities))
-		// スケジュールをモデルに変換
-		for _, scheduleEntity := range scheduleEntities {
-			schedule := converter.ScheduleEntityToModel(scheduleEntity)
-			schedules = append(schedules, schedule)
-		}
+// オファー案件一覧のソートキーと ORDER BY の式の対応
+// スケジュール・アサイニーを参照するソートキーは、件数や総数の取得に影響しないよう相関サブクエリで指定する
+func offerItemSortExpressions(now time.Time) dbhelper.SortExpressions {
+	scheduleOfOfferItem := fmt.Sprintf("%s = %s AND %s IS NULL",
+		entity.ScheduleTableColumns.OfferItemID, entity.OfferItemTableColumns.ID, entity.ScheduleTableColumns.DeletedAt)
+	return dbhelper.SortExpressions{
+		model.OfferItemSortKeyName.String():      dbhelper.ColumnSortExpression(entity.TableNames.OfferItem, entity.OfferItemColumns.Name),
+		model.OfferItemSortKeyCreatedAt.String(): dbhelper.ColumnSortExpression(entity.TableNames.OfferItem, entity.OfferItemColumns.CreatedAt),
+		model.OfferItemSortKeyInvitationEndDate.String(): {
+			Expr: fmt.Sprintf("(SELECT MAX(%s) FROM %s WHERE %s AND %s = ?)",
+				entity.ScheduleTableColumns.EndDate, entity.TableNames.Schedule, scheduleOfOfferItem, entity.ScheduleTableColumns.ScheduleType),
+			Args: []interface{}{model.ScheduleTypeInvitation.Int()},
+		},
+		model.OfferItemSortKeyAssigneeCount.String(): {
+			Expr: fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE %s = %s AND %s IS NULL)",
+				entity.TableNames.Assignee, entity.AssigneeTableColumns.OfferItemID, entity.OfferItemTableColumns.ID, entity.AssigneeTableColumns.DeletedAt),
+		},
+		model.OfferItemSortKeyNextDeadline.String(): {
+			Expr: fmt.Sprintf("(SELECT MIN(%s) FROM %s WHERE %s AND %s >= ?)",
+				entity.ScheduleTableColumns.EndDate, entity.TableNames.Schedule, scheduleOfOfferItem, entity.ScheduleTableColumns.EndDate),
+			Args: []interface{}{now},
+		},
+	}
+}
 
-		draftedItemInfoEntity, err := entity.DraftedItemInfos(entity.DraftedItemInfoWhere.OfferItemID.EQ(offerItemID.String())).One(ctx, exec)
-		if err != nil {
-			return nil, fmt.Errorf("entity.DraftedItemInfos.One: %w", err)
-		}
-		draftedItemInfo, err := converter.ConvertDraftedItemToModel(draftedItemInfoEntity)
-		if err != nil {
-			return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
-		}
+// リスト条件を指定してオファー案件を取得する
+// カーソルによるページ送りの場合は、次のページが存在すれば次のページのカーソルも返す
+func listOfferItemEntities(ctx context.Context, exec boil.ContextExecutor, queries []qm.QueryMod, condition *model.ListCondition) (entity.OfferItemSlice, *model.Cursor, error) {
+	listQueries, pagination, err := dbhelper.ListConditionQueryMods(entity.TableNames.OfferItem, offerItemSortExpressions(time.Now()), condition)
+	if err != nil {
+		return nil, nil, apperr.OfferItemValidationError.Wrap(fmt.Errorf("dbhelper.ListConditionQueryMods: %w", err))
+	}
 
-		offerItem, err := converter.ConvertOfferItemToModel(offerItemEntity, schedules, draftedItemInfo)
-		if err != nil {
-			return nil, fmt.Errorf("converter.OfferItemEntityToModel: %w", err)
-		}
-		offerItems = append(offerItems, offerItem)
+	offerItemEntities, err := entity.OfferItems(append(queries, listQueries...)...).All(ctx, exec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("entity.OfferItems.All: %w", err)
+	}
+	if pagination == nil || len(offerItemEntities) <= condition.Limit() {
+		return offerItemEntities, nil, nil
 	}
 
-	listOfferItem, err := model.NewListOfferItemResult(offerItems, int(totalCount))
+	offerItemEntities = offerItemEntities[:condition.Limit()]
+	nextCursor, err := pagination.NextCursor(offerItemEntities[len(offerItemEntities)-1])
 	if err != nil {
-		return nil, fmt.Errorf("model.NewListOfferItemResult: %w", err)
+		return nil, nil, fmt.Errorf("pagination.NextCursor: %w", err)
 	}
-	return listOfferItem, nil
+	return offerItemEntities, nextCursor, nil
 }
 
-// オファー案件を論理削除する
+// オファー案件と、集約に含まれるスケジュール・アサイニー・審査・案件情報・アンケート・回答を論理削除する
+// 復元時に同時に削除されたレコードのみを対象にできるよう、全てのテーブルに同じ削除日時を設定する
 func (o *OfferItemRepositoryImpl) Delete(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error {
 	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.Delete")
 	defer span.End()
@@ -130,20 +156,258 @@ func (o *OfferItemRepositoryImpl) Delete(ctx context.Context, tx *sql.Tx, id mod
 		return fmt.Errorf("entity.OfferItemWhere.ID.EQ.One: %w", err)
 	}
 
-	// 論理削除
-	if _, err := offerItemEntity.Delete(ctx, tx, false); err != nil {
-		return fmt.Errorf("offerItemEntity.Delete: %w", err)
+	// datetime の精度に合わせ、復元時の比較で一致するようにする
+	deletedAt := time.Now().Truncate(time.Second)
+	deletedBy := null.StringFrom(requestmeta.RequestedByFromContext(ctx))
+	offerItemEntity.DeletedAt = null.TimeFrom(deletedAt)
+	offerItemEntity.DeletedBy = deletedBy
+	if _, err := offerItemEntity.Update(ctx, tx, boil.Whitelist(entity.OfferItemColumns.DeletedAt, entity.OfferItemColumns.DeletedBy)); err != nil {
+		return fmt.Errorf("offerItemEntity.Update: %w", err)
 	}
-	if _, err := entity.Schedules(entity.ScheduleWhere.OfferItemID.EQ(id.String())).DeleteAll(ctx, tx, false); err != nil {
-		return fmt.Errorf("entity.Schedules.DeleteAll: %w", err)
+	if err := updateOfferItemAggregateDeletedAt(ctx, tx, id, null.Time{}, null.TimeFrom(deletedAt), deletedBy); err != nil {
+		return fmt.Errorf("updateOfferItemAggregateDeletedAt: %w", err)
 	}
-	if _, err := entity.Assignees(entity.AssigneeWhere.OfferItemID.EQ(id.String())).DeleteAll(ctx, tx, false); err != nil {
-		return fmt.Errorf("entity.Assignees.DeleteAll: %w", err)
+
+	return nil
+}
+
+// 論理削除されたオファー案件を、同時に論理削除された集約のレコードとともに復元する
+// 集約の削除より前に個別に削除されていたレコード(削除済みのアサイニーなど)は復元しない
+func (o *OfferItemRepositoryImpl) Restore(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.Restore")
+	defer span.End()
+
+	offerItemEntity, err := entity.OfferItems(
+		qm.WithDeleted(),
+		entity.OfferItemWhere.ID.EQ(id.String()),
+		entity.OfferItemWhere.DeletedAt.IsNotNull(),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperr.OfferItemNotFoundError.Wrap(err)
+		}
+		return fmt.Errorf("entity.OfferItems.One: %w", err)
 	}
-	if _, err := entity.Examinations(entity.ExaminationWhere.OfferItemID.EQ(id.String())).DeleteAll(ctx, tx, false); err != nil {
-		return fmt.Errorf("entity.Examinations.DeleteAll: %w", err)
+
+	if err := updateOfferItemAggregateDeletedAt(ctx, tx, id, offerItemEntity.DeletedAt, null.Time{}, null.String{}); err != nil {
+		return fmt.Errorf("updateOfferItemAggregateDeletedAt: %w", err)
+	}
+	offerItemEntity.DeletedAt = null.Time{}
+	offerItemEntity.DeletedBy = null.String{}
+	if _, err := offerItemEntity.Update(ctx, tx, boil.Whitelist(entity.OfferItemColumns.DeletedAt, entity.OfferItemColumns.DeletedBy)); err != nil {
+		return fmt.Errorf("offerItemEntity.Update: %w", err)
+	}
+	return nil
+}
+
+// updateOfferItemAggregateDeletedAt 集約に含まれるテーブルのうち、削除日時が from のレコードの削除日時を to、削除した操作者を deletedBy に更新する
+// from が null の場合は削除されていないレコードを対象とする
+func updateOfferItemAggregateDeletedAt(ctx context.Context, tx *sql.Tx, id model.OfferItemID, from, to null.Time, deletedBy null.String) error {
+	cols := entity.M{"deleted_at": to, "deleted_by": deletedBy}
+	where := func(column string) []qm.QueryMod {
+		mods := []qm.QueryMod{qm.WithDeleted(), qm.Where("offer_item_id = ?", id.String())}
+		if from.Valid {
+			return append(mods, qm.Where(column+" = ?", from.Time))
+		}
+		return append(mods, qm.Where(column+" IS NULL"))
+	}
+	if _, err := entity.Schedules(where(entity.ScheduleColumns.DeletedAt)...).UpdateAll(ctx, tx, cols); err != nil {
+		return fmt.Errorf("entity.Schedules.UpdateAll: %w", err)
+	}
+	if _, err := entity.Assignees(where(entity.AssigneeColumns.DeletedAt)...).UpdateAll(ctx, tx, cols); err != nil {
+		return fmt.Errorf("entity.Assignees.UpdateAll: %w", err)
+	}
+	if _, err := entity.Examinations(where(entity.ExaminationColumns.DeletedAt)...).UpdateAll(ctx, tx, cols); err != nil {
+		return fmt.Errorf("entity.Examinations.UpdateAll: %w", err)
+	}
+	if _, err := entity.DraftedItemInfos(where(entity.DraftedItemInfoColumns.DeletedAt)...).UpdateAll(ctx, tx, cols); err != nil {
+		return fmt.Errorf("entity.DraftedItemInfos.UpdateAll: %w", err)
+	}
+	if _, err := entity.Questionnaires(where(entity.QuestionnaireColumns.DeletedAt)...).UpdateAll(ctx, tx, cols); err != nil {
+		return fmt.Errorf("entity.Questionnaires.UpdateAll: %w", err)
+	}
+	if _, err := entity.QuestionnaireQuestions(where(entity.QuestionnaireQuestionColumns.DeletedAt)...).UpdateAll(ctx, tx, cols); err != nil {
+		return fmt.Errorf("entity.QuestionnaireQuestions.UpdateAll: %w", err)
+	}
+	if _, err := entity.QuestionnaireQuestionAnswers(where(entity.QuestionnaireQuestionAnswerColumns.DeletedAt)...).UpdateAll(ctx, tx, cols); err != nil {
+		return fmt.Errorf("entity.QuestionnaireQuestionAnswers.UpdateAll: %w", err)
+	}
+	return nil
+}
+
+// 指定日時より前に論理削除されたオファー案件のIDを取得する
+// 支払い明細は支払いの記録として残す必要があるため、支払い明細が存在するオファー案件は対象外とする
+func (o *OfferItemRepositoryImpl) ListIDsDeletedBefore(ctx context.Context, exec boil.ContextExecutor, deletedBefore time.Time) (model.OfferItemIDList, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.ListIDsDeletedBefore")
+	defer span.End()
+
+	offerItemEntities, err := entity.OfferItems(
+		qm.WithDeleted(),
+		qm.Select(entity.OfferItemColumns.ID),
+		entity.OfferItemWhere.DeletedAt.LT(null.TimeFrom(deletedBefore)),
+		qm.Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s = %s)", entity.TableNames.PaymentItem, entity.PaymentItemTableColumns.OfferItemID, entity.OfferItemTableColumns.ID)),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.OfferItems.All: %w", err)
+	}
+	ids := make(model.OfferItemIDList, 0, len(offerItemEntities))
+	for _, e := range offerItemEntities {
+		ids = append(ids, model.OfferItemID(e.ID))
+	}
+	return ids, nil
+}
+
+// 論理削除されたオファー案件の集約を物理削除する。変更履歴やアサイニーのログなど、集約に紐づく履歴も削除する
+// オブジェクトストレージはトランザクションで扱えないため、削除したレコードが参照していたオブジェクトのキーを返し、コミット後に呼び出し元で削除する
+func (o *OfferItemRepositoryImpl) Purge(ctx context.Context, tx *sql.Tx, id model.OfferItemID) ([]model.ObjectKey, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.Purge")
+	defer span.End()
+
+	offerItemEntity, err := entity.OfferItems(
+		qm.WithDeleted(),
+		entity.OfferItemWhere.ID.EQ(id.String()),
+		entity.OfferItemWhere.DeletedAt.IsNotNull(),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperr.OfferItemNotFoundError.Wrap(err)
+		}
+		return nil, fmt.Errorf("entity.OfferItems.One: %w", err)
+	}
+
+	objectKeys, err := listOfferItemObjectKeys(ctx, tx, offerItemEntity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("listOfferItemObjectKeys: %w", err)
+	}
+	if err := deleteOfferItemAggregate(ctx, tx, offerItemEntity); err != nil {
+		return nil, fmt.Errorf("deleteOfferItemAggregate: %w", err)
+	}
+	return objectKeys, nil
+}
+
+// 論理削除されたレコードが参照しているキーも含める
+func (o *OfferItemRepositoryImpl) ListObjectKeys(ctx context.Context, exec boil.ContextExecutor, id model.OfferItemID) ([]model.ObjectKey, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepositoryImpl.ListObjectKeys")
+	defer span.End()
+
+	keys, err := listOfferItemObjectKeys(ctx, exec, id.String())
+	if err != nil {
+		return nil, fmt.Errorf("listOfferItemObjectKeys: %w", err)
 	}
+	return keys, nil
+}
 
+// listOfferItemObjectKeys 集約のレコードが参照しているアンケートの質問画像・SNSのスクリーンショットのキーを取得する
+// 過去バージョンの質問は現在の質問と同じ画像を参照することがあるため、重複は除く。移行前の画像データはキーではないため含めない
+func listOfferItemObjectKeys(ctx context.Context, exec boil.ContextExecutor, offerItemID string) ([]model.ObjectKey, error) {
+	questions, err := entity.QuestionnaireQuestions(
+		qm.WithDeleted(),
+		qm.Select(entity.QuestionnaireQuestionColumns.Image),
+		entity.QuestionnaireQuestionWhere.OfferItemID.EQ(offerItemID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestions.All: %w", err)
+	}
+	histories, err := entity.QuestionnaireQuestionHistories(
+		qm.Select(entity.QuestionnaireQuestionHistoryColumns.Image),
+		entity.QuestionnaireQuestionHistoryWhere.OfferItemID.EQ(offerItemID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionHistories.All: %w", err)
+	}
+	examinations, err := entity.Examinations(
+		qm.WithDeleted(),
+		qm.Select(entity.ExaminationColumns.SNSScreenshotURL),
+		entity.ExaminationWhere.OfferItemID.EQ(offerItemID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.Examinations.All: %w", err)
+	}
+
+	keys := make([]model.ObjectKey, 0, len(questions)+len(histories)+len(examinations))
+	seen := make(map[string]struct{})
+	appendKey := func(column model.LegacyImageColumn, value string) {
+		if !column.IsObjectKey(value) {
+			return
+		}
+		if _, ok := seen[value]; ok {
+			return
+		}
+		seen[value] = struct{}{}
+		keys = append(keys, model.ObjectKey(value))
+	}
+	for _, q := range questions {
+		appendKey(model.LegacyImageColumnQuestionnaireQuestionImage, q.Image)
+	}
+	for _, h := range histories {
+		appendKey(model.LegacyImageColumnQuestionnaireQuestionHistoryImage, h.Image)
+	}
+	for _, e := range examinations {
+		appendKey(model.LegacyImageColumnExaminationSNSScreenshot, e.SNSScreenshotURL.String)
+	}
+	return keys, nil
+}
+
+// deleteOfferItemAggregate オファー案件の集約を論理削除済みのレコードも含めて物理削除する
+// 支払い明細は支払いの記録として残すため削除しない
+func deleteOfferItemAggregate(ctx context.Context, tx *sql.Tx, offerItemEntity *entity.OfferItem) error {
+	assigneeEntities, err := entity.Assignees(
+		qm.WithDeleted(),
+		qm.Select(entity.AssigneeColumns.ID),
+		entity.AssigneeWhere.OfferItemID.EQ(offerItemEntity.ID),
+	).All(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("entity.Assignees.All: %w", err)
+	}
+	assigneeIDs := make([]string, 0, len(assigneeEntities))
+	for _, a := range assigneeEntities {
+		assigneeIDs = append(assigneeIDs, a.ID)
+	}
+
+	// 外部キーの参照元から順に削除する
+	if _, err := entity.QuestionnaireQuestionAnswers(qm.WithDeleted(), entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.QuestionnaireQuestionAnswers.DeleteAll: %w", err)
+	}
+	if _, err := entity.QuestionnaireQuestionHistories(entity.QuestionnaireQuestionHistoryWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("entity.QuestionnaireQuestionHistories.DeleteAll: %w", err)
+	}
+	if _, err := entity.QuestionnaireQuestions(qm.WithDeleted(), entity.QuestionnaireQuestionWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.QuestionnaireQuestions.DeleteAll: %w", err)
+	}
+	if _, err := entity.Questionnaires(qm.WithDeleted(), entity.QuestionnaireWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.Questionnaires.DeleteAll: %w", err)
+	}
+	if _, err := entity.Examinations(qm.WithDeleted(), entity.ExaminationWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.Examinations.DeleteAll: %w", err)
+	}
+	if len(assigneeIDs) > 0 {
+		if _, err := entity.WritingFeeHistories(entity.WritingFeeHistoryWhere.AssigneeID.IN(assigneeIDs)).DeleteAll(ctx, tx); err != nil {
+			return fmt.Errorf("entity.WritingFeeHistories.DeleteAll: %w", err)
+		}
+		if _, err := entity.AssigneeLogs(entity.AssigneeLogWhere.AssigneeID.IN(assigneeIDs)).DeleteAll(ctx, tx); err != nil {
+			return fmt.Errorf("entity.AssigneeLogs.DeleteAll: %w", err)
+		}
+	}
+	if _, err := entity.Assignees(qm.WithDeleted(), entity.AssigneeWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.Assignees.DeleteAll: %w", err)
+	}
+	if _, err := entity.WritingFeeTiers(entity.WritingFeeTierWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("entity.WritingFeeTiers.DeleteAll: %w", err)
+	}
+	if _, err := entity.Schedules(qm.WithDeleted(), entity.ScheduleWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.Schedules.DeleteAll: %w", err)
+	}
+	if _, err := entity.DraftedItemInfos(qm.WithDeleted(), entity.DraftedItemInfoWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.DraftedItemInfos.DeleteAll: %w", err)
+	}
+	if _, err := entity.OfferItemRevisions(entity.OfferItemRevisionWhere.OfferItemID.EQ(offerItemEntity.ID)).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("entity.OfferItemRevisions.DeleteAll: %w", err)
+	}
+	if _, err := offerItemEntity.Delete(ctx, tx, true); err != nil {
+		return fmt.Errorf("offerItemEntity.Delete: %w", err)
+	}
 	return nil
 }
 
@@ -152,8 +416,77 @@ func (o *OfferItemRepositoryImpl) Search(ctx context.Context, exec boil.ContextE
 	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.Search")
 	defer span.End()
 
+	queries := searchOfferItemQueries(criteria)
+
+	// データ取得前に検索結果の総数を取得する
+	var totalCount int64
+	if condition.WithTotalCount() {
+		count, err := entity.OfferItems(queries...).Count(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("entity.OfferItems.Count: %w", err)
+		}
+		if count == 0 {
+			result, err := model.NewListOfferItemResult(model.OfferItemList{}, 0)
+			if err != nil {
+				return nil, fmt.Errorf("model.NewListOfferItemResult: %w", err)
+			}
+			return result, nil
+		}
+		totalCount = count
+	}
+
+	// 全文検索でソート設定が無い場合は関連度の高い順に並べる
+	// カーソルによるページ送りでは関連度をソートキーにできないため、ソート設定の順に並べる
+	if terms := criteria.QueryTerms(); len(terms) > 0 && !condition.CursorMode() && len(condition.Sorts()) == 0 {
+		queries = append(queries, qm.OrderBy(offerItemFullTextMatch+" DESC, "+entity.OfferItemTableColumns.ID, fullTextBooleanQuery(terms)))
+	}
+
+	offerItemEntities, nextCursor, err := listOfferItemEntities(ctx, exec, queries, condition)
+	if err != nil {
+		return nil, fmt.Errorf("listOfferItemEntities: %w", err)
+	}
+
+	// スケジュールと案件情報をまとめて取得し、モデルに変換する
+	offerItems, err := convertOfferItemEntitiesToModels(ctx, exec, offerItemEntities)
+	if err != nil {
+		return nil, fmt.Errorf("convertOfferItemEntitiesToModels: %w", err)
+	}
+
+	result, err := model.NewListOfferItemResult(offerItems, int(totalCount))
+	if err != nil {
+		return nil, fmt.Errorf("model.NewListOfferItemResult: %w", err)
+	}
+	result.ListResult().SetNextCursor(nextCursor)
+
+	return result, nil
+}
+
+// 全文検索の対象カラム。FULLTEXT インデックス (ngram パーサー) と同じカラムを同じ順序で指定する必要がある
+var offerItemFullTextMatch = fmt.Sprintf("MATCH(%s, %s, %s, %s) AGAINST (? IN BOOLEAN MODE)",
+	entity.OfferItemTableColumns.Name,
+	entity.OfferItemTableColumns.ProductFeatures,
+	entity.OfferItemTableColumns.CautionaryPoints,
+	entity.OfferItemTableColumns.ReferenceInfo,
+)
+
+// 全ての語を含む行に一致するブーリアンモードの検索文字列を生成する
+// 語はフレーズとして扱い、語に含まれる演算子は検索文字列として扱う
+func fullTextBooleanQuery(terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, `+"`+strings.ReplaceAll(term, `"`, "")+`"`)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// searchOfferItemQueries 検索条件をクエリに変換する
+func searchOfferItemQueries(criteria *dto.SearchOfferItemCriteria) []qm.QueryMod {
 	queries := make([]qm.QueryMod, 0)
 
+	if terms := criteria.QueryTerms(); len(terms) > 0 {
+		queries = append(queries, qm.Where(offerItemFullTextMatch, fullTextBooleanQuery(terms)))
+	}
+
 	if criteria.NameContains != nil && *criteria.NameContains != "" {
 		// 検索クエリが大文字小文字を無視するように設定
 		queries = append(queries, qm.Where("LOWER("+entity.OfferItemColumns.Name+") like ?", fmt.Sprintf("%%%s%%", strings.ToLower(*criteria.NameContains))))
@@ -168,65 +501,92 @@ func (o *OfferItemRepositoryImpl) Search(ctx context.Context, exec boil.ContextE
 		// 文字列比較が大文字小文字を無視するように設定
 		queries = append(queries, qm.Where("LOWER("+entity.OfferItemColumns.DFItemID+") = ?", strings.ToLower(criteria.DfItemIDEqual.String())))
 	}
-	// データ取得前に検索結果の総数を取得する
-	totalCount, err := entity.OfferItems(queries...).Count(ctx, exec)
-	if err != nil {
-		return nil, fmt.Errorf("entity.OfferItems.Count: %w", err)
-	}
-	if totalCount == 0 {
-		result, err := model.NewListOfferItemResult(model.OfferItemList{}, int(totalCount))
-		if err != nil {
-			return nil, fmt.Errorf("model.NewListOfferItemResult: %w", err)
-		}
-		return result, nil
+
+	if len(criteria.StatusIn) > 0 {
+		queries = append(queries, entity.OfferItemWhere.Status.IN(offerItemStatusesToInts(criteria.StatusIn)))
 	}
 
-	// リスト条件をクエリに追加する
-	queries = append(queries, qm.Limit(condition.Limit()), qm.Offset(condition.Offset()))
-	if len(condition.Sorts()) > 0 {
-		queries = append(queries, qm.OrderBy(dbhelper.CreateOrderByClause(entity.OfferItemColumns, condition.Sorts())))
+	if criteria.PostTargetEqual != nil {
+		queries = append(queries, entity.OfferItemWhere.PostTarget.EQ(uint(*criteria.PostTargetEqual)))
 	}
 
-	// DBからデータを取得し、モデルに変換する
-	offerItems := make(model.OfferItemList, 0, condition.Limit())
-	offerItemEntities, err := entity.OfferItems(queries...).All(ctx, exec)
-	if err != nil {
-		return nil, fmt.Errorf("entity.OfferItems.All: %w", err)
+	flags := []struct {
+		value  *bool
+		column string
+	}{
+		{criteria.Flags.HasSample, entity.OfferItemTableColumns.HasSample},
+		{criteria.Flags.NeedsPreliminaryReview, entity.OfferItemTableColumns.NeedsPreliminaryReview},
+		{criteria.Flags.NeedsAfterReview, entity.OfferItemTableColumns.NeedsAfterReview},
+		{criteria.Flags.RequiresSecondApproval, entity.OfferItemTableColumns.RequiresSecondApproval},
+		{criteria.Flags.NeedsPRMark, entity.OfferItemTableColumns.NeedsPRMark},
+		{criteria.Flags.PostRequired, entity.OfferItemTableColumns.PostRequired},
+		{criteria.Flags.HasLottery, entity.OfferItemTableColumns.HasLottery},
+		{criteria.Flags.HasCoupon, entity.OfferItemTableColumns.HasCoupon},
+		{criteria.Flags.HasSpecialCommission, entity.OfferItemTableColumns.HasSpecialCommission},
 	}
-	for _, offerItemEntity := range offerItemEntities {
-		offerItemID := model.OfferItemID(offerItemEntity.ID)
-		scheduleEntities, err := entity.Schedules(entity.ScheduleWhere.OfferItemID.EQ(offerItemID.String())).All(ctx, exec)
-		if err != nil {
-			return nil, fmt.Errorf("entity.Schedules.All: %w", err)
-		}
-		schedules := make(model.ScheduleList, 0, len(scheduleEntities))
-		for _, scheduleEntity := range scheduleEntities {
-			schedule := converter.ScheduleEntityToModel(scheduleEntity)
-			schedules = append(schedules, schedule)
+	for _, f := range flags {
+		if f.value != nil {
+			queries = append(queries, qm.Where(f.column+" = ?", *f.value))
 		}
+	}
 
-		draftedItemInfoEntity, err := entity.DraftedItemInfos(entity.DraftedItemInfoWhere.OfferItemID.EQ(offerItemID.String())).One(ctx, exec)
-		if err != nil {
-			return nil, fmt.Errorf("entity.DraftedItemInfos.One: %w", err)
+	// スケジュールの種別ごとに、範囲内のスケジュールが存在する案件に絞り込む
+	for _, r := range criteria.ScheduleRanges {
+		conditions := []string{
+			fmt.Sprintf("%s = %s", entity.ScheduleTableColumns.OfferItemID, entity.OfferItemTableColumns.ID),
+			fmt.Sprintf("%s IS NULL", entity.ScheduleTableColumns.DeletedAt),
+			fmt.Sprintf("%s = ?", entity.ScheduleTableColumns.ScheduleType),
 		}
-		draftedItemInfo, err := converter.ConvertDraftedItemToModel(draftedItemInfoEntity)
-		if err != nil {
-			return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
+		args := []interface{}{r.ScheduleType.Int()}
+		for _, c := range []struct {
+			value *time.Time
+			cond  string
+		}{
+			{r.StartDateFrom, entity.ScheduleTableColumns.StartDate + " >= ?"},
+			{r.StartDateTo, entity.ScheduleTableColumns.StartDate + " <= ?"},
+			{r.EndDateFrom, entity.ScheduleTableColumns.EndDate + " >= ?"},
+			{r.EndDateTo, entity.ScheduleTableColumns.EndDate + " <= ?"},
+		} {
+			if c.value != nil {
+				conditions = append(conditions, c.cond)
+				args = append(args, *c.value)
+			}
 		}
-
-		offerItem, err := converter.ConvertOfferItemToModel(offerItemEntity, schedules, draftedItemInfo)
-		if err != nil {
-			return nil, fmt.Errorf("converter.OfferItemEntityToModel: %w", err)
+		queries = append(queries, qm.Where(
+			fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s)", entity.TableNames.Schedule, strings.Join(conditions, " AND ")),
+			args...,
+		))
+	}
+
+	if criteria.AssigneeAmebaID != nil && *criteria.AssigneeAmebaID != "" {
+		queries = append(queries, qm.Where(
+			fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s IS NULL AND %s = ?)",
+				entity.TableNames.Assignee, entity.AssigneeTableColumns.OfferItemID, entity.OfferItemTableColumns.ID,
+				entity.AssigneeTableColumns.DeletedAt, entity.AssigneeTableColumns.AmebaID),
+			criteria.AssigneeAmebaID.String(),
+		))
+	}
+
+	// ステージごとのアサイニー数で絞り込む
+	for _, s := range criteria.AssigneeStages {
+		count := fmt.Sprintf("(SELECT COUNT(*) FROM %s WHERE %s = %s AND %s IS NULL AND %s = ?)",
+			entity.TableNames.Assignee, entity.AssigneeTableColumns.OfferItemID, entity.OfferItemTableColumns.ID,
+			entity.AssigneeTableColumns.DeletedAt, entity.AssigneeTableColumns.Stage)
+		if s.Min > 0 {
+			queries = append(queries, qm.Where(count+" >= ?", s.Stage.Int(), s.Min))
+		}
+		if s.Max != nil {
+			queries = append(queries, qm.Where(count+" <= ?", s.Stage.Int(), *s.Max))
 		}
-		offerItems = append(offerItems, offerItem)
 	}
 
-	result, err := model.NewListOfferItemResult(offerItems, int(totalCount))
-	if err != nil {
-		return nil, fmt.Errorf("model.NewListOfferItemResult: %w", err)
+	if criteria.CreatedAtFrom != nil {
+		queries = append(queries, entity.OfferItemWhere.CreatedAt.GTE(*criteria.CreatedAtFrom))
 	}
-
-	return result, nil
+	if criteria.CreatedAtTo != nil {
+		queries = append(queries, entity.OfferItemWhere.CreatedAt.LTE(*criteria.CreatedAtTo))
+	}
+	return queries
 }
 
 // オファー案件を取得する
@@ -245,13 +605,9 @@ func (o *OfferItemRepositoryImpl) Get(ctx context.Context, exec boil.ContextExec
 		schedules = append(schedules, schedule)
 	}
 
-	draftedItemInfoEntity, err := entity.DraftedItemInfos(entity.DraftedItemInfoWhere.OfferItemID.EQ(offerItemID.String())).One(ctx, exec)
+	draftedItemInfo, err := getDraftedItemInfo(ctx, exec, offerItemID)
 	if err != nil {
-		return nil, fmt.Errorf("entity.DraftedItemInfos.One: %w", err)
-	}
-	draftedItemInfo, err := converter.ConvertDraftedItemToModel(draftedItemInfoEntity)
-	if err != nil {
-		return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
+		return nil, fmt.Errorf("getDraftedItemInfo: %w", err)
 	}
 
 	// オファー案件取得
@@ -263,6 +619,14 @@ func (o *OfferItemRepositoryImpl) Get(ctx context.Context, exec boil.ContextExec
 	offerItemEntity, err := entity.OfferItems(offerItemQueries...).One(ctx, exec)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
+			// 退避済みのオファー案件は更新できないため、存在しない場合と区別する
+			archived, archiveErr := entity.OfferItemArchives(entity.OfferItemArchiveWhere.OfferItemID.EQ(offerItemID.String())).Exists(ctx, exec)
+			if archiveErr != nil {
+				return nil, fmt.Errorf("entity.OfferItemArchives.Exists: %w", archiveErr)
+			}
+			if archived {
+				return nil, apperr.OfferItemArchivedError.Wrap(err)
+			}
 			return nil, apperr.OfferItemNotFoundError.Wrap(err)
 		}
 		return nil, fmt.Errorf("entity.OfferItems.One: %w", err)
@@ -286,9 +650,12 @@ func (o *OfferItemRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, offerI
 		return apperr.OfferItemInternalError.Wrap(err)
 	}
 
-	draftedItemInfoEntity := converter.ConvertDraftedItemModelToEntity(offerItem.DraftedItemInfo())
-	if err := draftedItemInfoEntity.Insert(ctx, tx, boil.Infer()); err != nil {
-		return apperr.OfferItemInternalError.Wrap(err)
+	// 下書きの場合は案件情報が未設定のことがある
+	if offerItem.DraftedItemInfo() != nil {
+		draftedItemInfoEntity := converter.ConvertDraftedItemModelToEntity(offerItem.DraftedItemInfo())
+		if err := draftedItemInfoEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+			return apperr.OfferItemInternalError.Wrap(err)
+		}
 	}
 
 	for _, schedule := range offerItem.Schedules() {
@@ -313,20 +680,25 @@ func (o *OfferItemRepositoryImpl) Update(ctx context.Context, tx *sql.Tx, offerI
 		entity.OfferItemColumns.DeletedBy,
 	)
 	offerItemEntity := converter.ConvertOfferItemModelToEntity(offerItem)
+	// 取得時のバージョンから1つ進める
+	offerItemEntity.Version = offerItem.Version() + 1
 	if _, err := offerItemEntity.Update(ctx, tx, blackList); err != nil {
 		return apperr.OfferItemInternalError.Wrap(err)
 	}
 
+	// 下書きではスケジュールが後から追加されるため、存在しないスケジュールは作成する
 	for _, schedule := range offerItem.Schedules() {
 		scheduleEntity := converter.ScheduleModelToEntity(schedule)
-		if _, err := scheduleEntity.Update(ctx, tx, blackList); err != nil {
+		if err := scheduleEntity.Upsert(ctx, tx, blackList, boil.Infer()); err != nil {
 			return apperr.OfferItemInternalError.Wrap(err)
 		}
 	}
 
-	draftedItemInfoEntity := converter.ConvertDraftedItemModelToEntity(offerItem.DraftedItemInfo())
-	if _, err := draftedItemInfoEntity.Update(ctx, tx, blackList); err != nil {
-		return apperr.OfferItemInternalError.Wrap(err)
+	if offerItem.DraftedItemInfo() != nil {
+		draftedItemInfoEntity := converter.ConvertDraftedItemModelToEntity(offerItem.DraftedItemInfo())
+		if err := draftedItemInfoEntity.Upsert(ctx, tx, blackList, boil.Infer()); err != nil {
+			return apperr.OfferItemInternalError.Wrap(err)
+		}
 	}
 	return nil
 }
@@ -344,7 +716,7 @@ func (o *OfferItemRepositoryImpl) BulkGet(ctx context.Context, exec boil.Context
 	queries := make([]qm.QueryMod, 0, 2)
 	queries = append(queries, entity.OfferItemWhere.ID.IN(amebaIDsStr))
 	if !isClosed {
-		queries = append(queries, entity.OfferItemWhere.IsClosed.EQ(false))
+		queries = append(queries, entity.OfferItemWhere.Status.NIN(offerItemStatusesToInts([]model.OfferItemStatus{model.OfferItemStatusCompleted, model.OfferItemStatusArchived})))
 	}
 	offerItemEntities, err := entity.OfferItems(queries...).All(ctx, exec)
 	if err != nil {
@@ -353,32 +725,12 @@ func (o *OfferItemRepositoryImpl) BulkGet(ctx context.Context, exec boil.Context
 		}
 		return nil, fmt.Errorf("entity.OfferItems.All: %w", err)
 	}
-	for _, offerItemEntity := range offerItemEntities {
-		offerItemID := model.OfferItemID(offerItemEntity.ID)
-		scheduleEntities, err := entity.Schedules(entity.ScheduleWhere.OfferItemID.EQ(offerItemID.String())).All(ctx, exec)
-		if err != nil {
-			return nil, fmt.Errorf("entity.Schedules.All: %w", err)
-		}
-		schedules := make(model.ScheduleList, 0, len(scheduleEntities))
-		for _, scheduleEntity := range scheduleEntities {
-			schedule := converter.ScheduleEntityToModel(scheduleEntity)
-			schedules = append(schedules, schedule)
-		}
-
-		draftedItemInfoEntity, err := entity.DraftedItemInfos(entity.DraftedItemInfoWhere.OfferItemID.EQ(offerItemID.String())).One(ctx, exec)
-		if err != nil {
-			return nil, fmt.Errorf("entity.DraftedItemInfos.One: %w", err)
-		}
-		draftedItemInfo, err := converter.ConvertDraftedItemToModel(draftedItemInfoEntity)
-		if err != nil {
-			return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
-		}
-
-		offerItem, err := converter.ConvertOfferItemToModel(offerItemEntity, schedules, draftedItemInfo)
-		if err != nil {
-			return nil, fmt.Errorf("converter.OfferItemEntityToModel: %w", err)
-		}
-		offerItems[offerItemID] = offerItem
+	offerItemList, err := convertOfferItemEntitiesToModels(ctx, exec, offerItemEntities)
+	if err != nil {
+		return nil, fmt.Errorf("convertOfferItemEntitiesToModels: %w", err)
+	}
+	for _, offerItem := range offerItemList {
+		offerItems[offerItem.ID()] = offerItem
 	}
 	return offerItems, nil
 }
@@ -406,3 +758,96 @@ func (o *OfferItemRepositoryImpl) ListIDsByEndDate(ctx context.Context, exec boi
 	}
 	return offerItemIDs, nil
 }
+
+// 指定した状態のいずれかに一致するオファー案件のIDを取得する
+func (o *OfferItemRepositoryImpl) ListIDsByStatuses(ctx context.Context, exec boil.ContextExecutor, statuses []model.OfferItemStatus) (model.OfferItemIDList, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.ListIDsByStatuses")
+	defer span.End()
+
+	offerItemEntities, err := entity.OfferItems(
+		qm.Select(entity.OfferItemColumns.ID),
+		entity.OfferItemWhere.Status.IN(offerItemStatusesToInts(statuses)),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, apperr.OfferItemInternalError.Wrap(err)
+	}
+
+	offerItemIDs := make(model.OfferItemIDList, 0, len(offerItemEntities))
+	for _, offerItemEntity := range offerItemEntities {
+		offerItemIDs = append(offerItemIDs, model.OfferItemID(offerItemEntity.ID))
+	}
+	return offerItemIDs, nil
+}
+
+// convertOfferItemEntitiesToModels オファー案件のスケジュールと案件情報を IN 句でまとめて取得し、モデルに変換する
+// オファー案件の件数に関わらずクエリの発行回数は一定になる
+func convertOfferItemEntitiesToModels(ctx context.Context, exec boil.ContextExecutor, offerItemEntities entity.OfferItemSlice) (model.OfferItemList, error) {
+	offerItems := make(model.OfferItemList, 0, len(offerItemEntities))
+	if len(offerItemEntities) == 0 {
+		return offerItems, nil
+	}
+
+	offerItemIDs := make([]string, 0, len(offerItemEntities))
+	for _, offerItemEntity := range offerItemEntities {
+		offerItemIDs = append(offerItemIDs, offerItemEntity.ID)
+	}
+
+	scheduleEntities, err := entity.Schedules(entity.ScheduleWhere.OfferItemID.IN(offerItemIDs)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.Schedules.All: %w", err)
+	}
+	schedulesByOfferItemID := make(map[string]model.ScheduleList, len(offerItemEntities))
+	for _, scheduleEntity := range scheduleEntities {
+		schedulesByOfferItemID[scheduleEntity.OfferItemID] = append(schedulesByOfferItemID[scheduleEntity.OfferItemID], converter.ScheduleEntityToModel(scheduleEntity))
+	}
+
+	draftedItemInfoEntities, err := entity.DraftedItemInfos(entity.DraftedItemInfoWhere.OfferItemID.IN(offerItemIDs)).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.DraftedItemInfos.All: %w", err)
+	}
+	draftedItemInfoByOfferItemID := make(map[string]*model.ItemInfo, len(draftedItemInfoEntities))
+	for _, draftedItemInfoEntity := range draftedItemInfoEntities {
+		draftedItemInfo, err := converter.ConvertDraftedItemToModel(draftedItemInfoEntity)
+		if err != nil {
+			return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
+		}
+		draftedItemInfoByOfferItemID[draftedItemInfoEntity.OfferItemID] = draftedItemInfo
+	}
+
+	for _, offerItemEntity := range offerItemEntities {
+		schedules, ok := schedulesByOfferItemID[offerItemEntity.ID]
+		if !ok {
+			schedules = model.ScheduleList{}
+		}
+		offerItem, err := converter.ConvertOfferItemToModel(offerItemEntity, schedules, draftedItemInfoByOfferItemID[offerItemEntity.ID])
+		if err != nil {
+			return nil, fmt.Errorf("converter.OfferItemEntityToModel: %w", err)
+		}
+		offerItems = append(offerItems, offerItem)
+	}
+	return offerItems, nil
+}
+
+// getDraftedItemInfo オファー案件の案件情報を取得する。下書きで未設定の場合は nil を返す
+func getDraftedItemInfo(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (*model.ItemInfo, error) {
+	draftedItemInfoEntity, err := entity.DraftedItemInfos(entity.DraftedItemInfoWhere.OfferItemID.EQ(offerItemID.String())).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("entity.DraftedItemInfos.One: %w", err)
+	}
+	draftedItemInfo, err := converter.ConvertDraftedItemToModel(draftedItemInfoEntity)
+	if err != nil {
+		return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
+	}
+	return draftedItemInfo, nil
+}
+
+func offerItemStatusesToInts(statuses []model.OfferItemStatus) []int {
+	res := make([]int, 0, len(statuses))
+	for _, s := range statuses {
+		res = append(res, s.Int())
+	}
+	return res
+}
@@ -0,0 +1,443 @@
+package repository_impl
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/domain/dto"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/util/dbhelper"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/util/dbtest"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"github.com/terui-ryota/offer-item/pkg/requestmeta"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+func TestSearchOfferItemQueries(t *testing.T) {
+	weekStart := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	weekEnd := weekStart.AddDate(0, 0, 7)
+	hasLottery := true
+	postTarget := model.PostTarget(1)
+	amebaID := model.AmebaID("foo")
+	maxCount := 3
+	query := "保湿　クリーム \"無添加\""
+
+	tests := []struct {
+		name         string
+		criteria     *dto.SearchOfferItemCriteria
+		wantContains []string
+		wantArgs     []interface{}
+	}{
+		{
+			name:     "条件なし",
+			criteria: &dto.SearchOfferItemCriteria{},
+			wantArgs: []interface{}{},
+		},
+		{
+			name: "抽選ありで募集が今週終了する案件",
+			criteria: &dto.SearchOfferItemCriteria{
+				Flags: dto.OfferItemFlagCriteria{HasLottery: &hasLottery},
+				ScheduleRanges: []dto.ScheduleRangeCriteria{
+					{ScheduleType: model.ScheduleTypeInvitation, EndDateFrom: &weekStart, EndDateTo: &weekEnd},
+				},
+			},
+			wantContains: []string{
+				"offer_item.has_lottery = ?",
+				"EXISTS (SELECT 1 FROM schedule WHERE schedule.offer_item_id = offer_item.id AND schedule.deleted_at IS NULL AND schedule.schedule_type = ? AND schedule.end_date >= ? AND schedule.end_date <= ?)",
+			},
+			wantArgs: []interface{}{true, model.ScheduleTypeInvitation.Int(), weekStart, weekEnd},
+		},
+		{
+			name:     "全文検索",
+			criteria: &dto.SearchOfferItemCriteria{Query: &query},
+			wantContains: []string{
+				"MATCH(offer_item.name, offer_item.product_features, offer_item.cautionary_points, offer_item.reference_info) AGAINST (? IN BOOLEAN MODE)",
+			},
+			wantArgs: []interface{}{`+"保湿" +"クリーム" +"無添加"`},
+		},
+		{
+			name: "投稿先・アサイニー・ステージ数・作成日時",
+			criteria: &dto.SearchOfferItemCriteria{
+				PostTargetEqual: &postTarget,
+				AssigneeAmebaID: &amebaID,
+				AssigneeStages: []dto.AssigneeStageCountCriteria{
+					{Stage: model.StageExamination, Min: 1, Max: &maxCount},
+				},
+				CreatedAtFrom: &weekStart,
+			},
+			wantContains: []string{
+				"`offer_item`.`post_target` = ?",
+				"assignee.ameba_id = ?",
+				"assignee.stage = ?) >= ?",
+				"assignee.stage = ?) <= ?",
+				"`offer_item`.`created_at` >= ?",
+			},
+			wantArgs: []interface{}{uint(1), "foo", model.StageExamination.Int(), 1, model.StageExamination.Int(), maxCount, weekStart},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, args := queries.BuildQuery(entity.OfferItems(searchOfferItemQueries(tt.criteria)...).Query)
+			for _, want := range tt.wantContains {
+				assert.Contains(t, sql, want)
+			}
+			assert.Equal(t, tt.wantArgs, append([]interface{}{}, args...))
+		})
+	}
+}
+
+func TestListOfferItemPageQueries(t *testing.T) {
+	createdAt := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	sortCreatedAt, _ := model.NewSort(model.OfferItemSortKeyCreatedAt.String(), true)
+	sortUnknown, _ := model.NewSort("unknown", false)
+	firstPage, _ := model.NewCursorListCondition(2, []*model.Sort{sortCreatedAt}, "", false)
+	cursor, _ := model.NewCursor("", false, "", "offer_item_id")
+	idPage, _ := model.NewCursorListCondition(2, nil, cursor.Encode(), false)
+
+	t.Run("先頭のページ", func(t *testing.T) {
+		mods, pagination, err := dbhelper.ListConditionQueryMods(entity.TableNames.OfferItem, offerItemSortExpressions(createdAt), firstPage)
+		assert.NoError(t, err)
+		sql, args := queries.BuildQuery(entity.OfferItems(mods...).Query)
+		assert.Contains(t, sql, "ORDER BY offer_item.created_at DESC, offer_item.id DESC LIMIT 3")
+		assert.Empty(t, args)
+
+		// 最後のデータの作成日時と ID から次のページの条件を生成する
+		next, err := pagination.NextCursor(&entity.OfferItem{ID: "offer_item_id", CreatedAt: createdAt})
+		assert.NoError(t, err)
+		nextPage, err := model.NewCursorListCondition(2, []*model.Sort{sortCreatedAt}, next.Encode(), false)
+		assert.NoError(t, err)
+		mods, _, err = dbhelper.ListConditionQueryMods(entity.TableNames.OfferItem, offerItemSortExpressions(createdAt), nextPage)
+		assert.NoError(t, err)
+		sql, args = queries.BuildQuery(entity.OfferItems(mods...).Query)
+		assert.Contains(t, sql, "(offer_item.created_at < ? OR (offer_item.created_at = ? AND offer_item.id < ?))")
+		assert.Equal(t, []interface{}{createdAt, createdAt, "offer_item_id"}, append([]interface{}{}, args...))
+	})
+	t.Run("ソート設定なし", func(t *testing.T) {
+		mods, _, err := dbhelper.ListConditionQueryMods(entity.TableNames.OfferItem, offerItemSortExpressions(createdAt), idPage)
+		assert.NoError(t, err)
+		sql, args := queries.BuildQuery(entity.OfferItems(mods...).Query)
+		assert.Contains(t, sql, "offer_item.id > ?")
+		assert.Contains(t, sql, "ORDER BY offer_item.id ASC LIMIT 3")
+		assert.Equal(t, []interface{}{"offer_item_id"}, append([]interface{}{}, args...))
+	})
+	t.Run("カラムではないソートキー", func(t *testing.T) {
+		sortAssigneeCount, _ := model.NewSort(model.OfferItemSortKeyAssigneeCount.String(), true)
+		condition, _ := model.NewCursorListCondition(2, []*model.Sort{sortAssigneeCount}, "", false)
+		_, _, err := dbhelper.ListConditionQueryMods(entity.TableNames.OfferItem, offerItemSortExpressions(createdAt), condition)
+		assert.Error(t, err)
+	})
+	t.Run("存在しないソートキー", func(t *testing.T) {
+		condition, _ := model.NewCursorListCondition(2, []*model.Sort{sortUnknown}, "", false)
+		_, _, err := dbhelper.ListConditionQueryMods(entity.TableNames.OfferItem, offerItemSortExpressions(createdAt), condition)
+		assert.Error(t, err)
+	})
+}
+
+// オファー案件ごとにスケジュール 2 件と案件情報を持つスタブのテーブルを生成する
+func stubOfferItemTables(n int) map[string]*dbtest.Table {
+	tables := map[string]*dbtest.Table{
+		entity.TableNames.OfferItem: {Columns: []string{entity.OfferItemColumns.ID, entity.OfferItemColumns.Name}},
+		entity.TableNames.Schedule:  {Columns: []string{entity.ScheduleColumns.ID, entity.ScheduleColumns.OfferItemID, entity.ScheduleColumns.ScheduleType}},
+		entity.TableNames.DraftedItemInfo: {Columns: []string{
+			entity.DraftedItemInfoColumns.OfferItemID,
+			entity.DraftedItemInfoColumns.MinCommissionType,
+			entity.DraftedItemInfoColumns.MinCommission,
+			entity.DraftedItemInfoColumns.MaxCommissionType,
+			entity.DraftedItemInfoColumns.MaxCommission,
+		}},
+	}
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("offer_item_%d", i)
+		tables[entity.TableNames.OfferItem].Rows = append(tables[entity.TableNames.OfferItem].Rows, []driver.Value{id, "name"})
+		for _, scheduleType := range []model.ScheduleType{model.ScheduleTypeInvitation, model.ScheduleTypeLottery} {
+			tables[entity.TableNames.Schedule].Rows = append(tables[entity.TableNames.Schedule].Rows, []driver.Value{fmt.Sprintf("%s_%d", id, scheduleType.Int()), id, int64(scheduleType.Int())})
+		}
+		tables[entity.TableNames.DraftedItemInfo].Rows = append(tables[entity.TableNames.DraftedItemInfo].Rows, []driver.Value{id, int64(model.CommissionTypeFixedRate), 1.0, int64(model.CommissionTypeFixedRate), 5.0})
+	}
+	return tables
+}
+
+func TestOfferItemRepositoryImpl_QueryCount(t *testing.T) {
+	repo := NewOfferItemRepositoryImpl()
+	condition, _ := model.NewListCondition(0, 100, nil)
+	criteria := &dto.SearchOfferItemCriteria{}
+
+	tests := []struct {
+		name string
+		call func(exec *dbtest.QueryCounter, ids []model.OfferItemID) (model.OfferItemList, error)
+		// 件数・オファー案件・スケジュール・案件情報
+		want int
+	}{
+		{
+			name: "List",
+			call: func(exec *dbtest.QueryCounter, _ []model.OfferItemID) (model.OfferItemList, error) {
+				result, err := repo.List(context.Background(), exec, condition, nil)
+				if err != nil {
+					return nil, err
+				}
+				return result.OfferItems(), nil
+			},
+			want: 4,
+		},
+		{
+			name: "Search",
+			call: func(exec *dbtest.QueryCounter, _ []model.OfferItemID) (model.OfferItemList, error) {
+				result, err := repo.Search(context.Background(), exec, criteria, condition)
+				if err != nil {
+					return nil, err
+				}
+				return result.OfferItems(), nil
+			},
+			want: 4,
+		},
+		{
+			name: "BulkGet",
+			call: func(exec *dbtest.QueryCounter, ids []model.OfferItemID) (model.OfferItemList, error) {
+				result, err := repo.BulkGet(context.Background(), exec, ids, true)
+				if err != nil {
+					return nil, err
+				}
+				list := make(model.OfferItemList, 0, len(result))
+				for _, id := range ids {
+					list = append(list, result[id])
+				}
+				return list, nil
+			},
+			want: 3,
+		},
+	}
+	for _, tt := range tests {
+		// オファー案件の件数に関わらずクエリの発行回数が一定であることを確認する
+		for _, n := range []int{1, 20} {
+			t.Run(fmt.Sprintf("%s_%d件", tt.name, n), func(t *testing.T) {
+				exec := dbtest.NewQueryCounter(dbtest.OpenStubDB(t, stubOfferItemTables(n)))
+				ids := make([]model.OfferItemID, 0, n)
+				for i := 0; i < n; i++ {
+					ids = append(ids, model.OfferItemID(fmt.Sprintf("offer_item_%d", i)))
+				}
+
+				offerItems, err := tt.call(exec, ids)
+				assert.NoError(t, err)
+				dbtest.AssertQueryCount(t, exec, tt.want)
+				assert.Len(t, offerItems, n)
+				for _, offerItem := range offerItems {
+					assert.Len(t, offerItem.Schedules(), 2)
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkOfferItemRepositoryImpl_List(b *testing.B) {
+	repo := NewOfferItemRepositoryImpl()
+	condition, _ := model.NewListCondition(0, 100, nil)
+	db := dbtest.OpenStubDB(b, stubOfferItemTables(100))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := repo.List(context.Background(), db, condition, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestOfferItemSortExpressions(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	sort := func(key model.OfferItemSortKey, desc bool) *model.Sort {
+		s, _ := model.NewSort(key.String(), desc)
+		return s
+	}
+
+	tests := []struct {
+		name         string
+		sorts        []*model.Sort
+		wantContains string
+		wantArgs     []interface{}
+		wantErr      bool
+	}{
+		{
+			name:         "名前",
+			sorts:        []*model.Sort{sort(model.OfferItemSortKeyName, false)},
+			wantContains: "ORDER BY offer_item.name, offer_item.id",
+			wantArgs:     []interface{}{},
+		},
+		{
+			name:         "募集終了日",
+			sorts:        []*model.Sort{sort(model.OfferItemSortKeyInvitationEndDate, true)},
+			wantContains: "ORDER BY (SELECT MAX(schedule.end_date) FROM schedule WHERE schedule.offer_item_id = offer_item.id AND schedule.deleted_at IS NULL AND schedule.schedule_type = ?) DESC, offer_item.id",
+			wantArgs:     []interface{}{model.ScheduleTypeInvitation.Int()},
+		},
+		{
+			name:         "アサイニー数と次の締め切り",
+			sorts:        []*model.Sort{sort(model.OfferItemSortKeyAssigneeCount, true), sort(model.OfferItemSortKeyNextDeadline, false)},
+			wantContains: "ORDER BY (SELECT COUNT(*) FROM assignee WHERE assignee.offer_item_id = offer_item.id AND assignee.deleted_at IS NULL) DESC, (SELECT MIN(schedule.end_date) FROM schedule WHERE schedule.offer_item_id = offer_item.id AND schedule.deleted_at IS NULL AND schedule.end_date >= ?), offer_item.id",
+			wantArgs:     []interface{}{now},
+		},
+		{
+			name:    "存在しないソートキー",
+			sorts:   []*model.Sort{sort("updated_at", false)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderBy, err := dbhelper.CreateOrderByQueryMod(entity.TableNames.OfferItem, offerItemSortExpressions(now), tt.sorts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			sql, args := queries.BuildQuery(entity.OfferItems(orderBy).Query)
+			assert.Contains(t, sql, tt.wantContains)
+			assert.Equal(t, tt.wantArgs, append([]interface{}{}, args...))
+		})
+	}
+}
+
+// 更新系のクエリの対象テーブル
+var statementTablePattern = regexp.MustCompile("^(?:UPDATE|DELETE FROM|INSERT INTO) `(\\w+)`")
+
+func statementTables(statements []dbtest.Statement) []string {
+	tables := make([]string, 0, len(statements))
+	for _, s := range statements {
+		if m := statementTablePattern.FindStringSubmatch(s.Query); m != nil {
+			tables = append(tables, m[1])
+		}
+	}
+	return tables
+}
+
+// 集約に含まれ、オファー案件と同時に論理削除・復元するテーブル
+var offerItemAggregateTables = []string{
+	entity.TableNames.Schedule,
+	entity.TableNames.Assignee,
+	entity.TableNames.Examination,
+	entity.TableNames.DraftedItemInfo,
+	entity.TableNames.Questionnaire,
+	entity.TableNames.QuestionnaireQuestion,
+	entity.TableNames.QuestionnaireQuestionAnswer,
+}
+
+func TestOfferItemRepositoryImpl_Delete(t *testing.T) {
+	db, recorder := dbtest.OpenRecordingStubDB(t, map[string]*dbtest.Table{
+		entity.TableNames.OfferItem: {Columns: []string{entity.OfferItemColumns.ID}, Rows: [][]driver.Value{{"offer_item_id"}}},
+	})
+	ctx := requestmeta.WithRequestedBy(context.Background(), "operator")
+	tx, err := db.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, NewOfferItemRepositoryImpl().Delete(ctx, tx, "offer_item_id"))
+
+	statements := recorder.Statements()
+	assert.Equal(t, append([]string{entity.TableNames.OfferItem}, offerItemAggregateTables...), statementTables(statements))
+	deletedAt := statements[0].Args[0]
+	assert.IsType(t, time.Time{}, deletedAt)
+	assert.Equal(t, []driver.Value{deletedAt, "operator", "offer_item_id"}, statements[0].Args)
+	// 削除されていないレコードのみに、オファー案件と同じ削除日時と操作者を設定する
+	for _, s := range statements[1:] {
+		assert.Contains(t, s.Query, "SET `deleted_at` = ?, `deleted_by` = ?")
+		assert.Contains(t, s.Query, "(deleted_at IS NULL)")
+		assert.Equal(t, []driver.Value{deletedAt, "operator", "offer_item_id"}, s.Args)
+	}
+}
+
+func TestOfferItemRepositoryImpl_Restore(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		rows    [][]driver.Value
+		wantErr error
+	}{
+		{
+			name: "正常系。同時に論理削除されたレコードのみ復元する",
+			rows: [][]driver.Value{{"offer_item_id", deletedAt}},
+		},
+		{
+			name:    "異常系。論理削除されていない場合",
+			wantErr: apperr.OfferItemNotFoundError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, recorder := dbtest.OpenRecordingStubDB(t, map[string]*dbtest.Table{
+				entity.TableNames.OfferItem: {Columns: []string{entity.OfferItemColumns.ID, entity.OfferItemColumns.DeletedAt}, Rows: tt.rows},
+			})
+			ctx := context.Background()
+			tx, err := db.BeginTx(ctx, nil)
+			assert.NoError(t, err)
+
+			err = NewOfferItemRepositoryImpl().Restore(ctx, tx, "offer_item_id")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, recorder.Statements())
+				return
+			}
+			assert.NoError(t, err)
+
+			statements := recorder.Statements()
+			assert.Equal(t, append(append([]string{}, offerItemAggregateTables...), entity.TableNames.OfferItem), statementTables(statements))
+			// 集約の削除より前に個別に削除されたレコードを復元しないよう、オファー案件と削除日時が一致するレコードのみを対象にする
+			for _, s := range statements[:len(statements)-1] {
+				assert.Contains(t, s.Query, "(deleted_at = ?)")
+				assert.Equal(t, []driver.Value{nil, nil, "offer_item_id", deletedAt}, s.Args)
+			}
+			assert.Equal(t, []driver.Value{nil, nil, "offer_item_id"}, statements[len(statements)-1].Args)
+		})
+	}
+}
+
+func TestOfferItemRepositoryImpl_Purge(t *testing.T) {
+	deletedAt := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	db, recorder := dbtest.OpenRecordingStubDB(t, map[string]*dbtest.Table{
+		entity.TableNames.OfferItem: {Columns: []string{entity.OfferItemColumns.ID, entity.OfferItemColumns.DeletedAt}, Rows: [][]driver.Value{{"offer_item_id", deletedAt}}},
+		entity.TableNames.Assignee:  {Columns: []string{entity.AssigneeColumns.ID}, Rows: [][]driver.Value{{"assignee_id"}}},
+		entity.TableNames.QuestionnaireQuestion: {Columns: []string{entity.QuestionnaireQuestionColumns.Image}, Rows: [][]driver.Value{
+			{"questionnaire_image/1.png"},
+			{"data:image/png;base64,iVBORw0KGgo="},
+			{""},
+		}},
+		entity.TableNames.QuestionnaireQuestionHistory: {Columns: []string{entity.QuestionnaireQuestionHistoryColumns.Image}, Rows: [][]driver.Value{
+			{"questionnaire_image/1.png"},
+			{"questionnaire_image/0.png"},
+		}},
+		entity.TableNames.Examination: {Columns: []string{entity.ExaminationColumns.SNSScreenshotURL}, Rows: [][]driver.Value{
+			{"sns_screenshot/1.png"},
+			{nil},
+		}},
+	})
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	assert.NoError(t, err)
+
+	objectKeys, err := NewOfferItemRepositoryImpl().Purge(ctx, tx, "offer_item_id")
+	assert.NoError(t, err)
+	// 移行前の画像データと重複を除いたキーを返す
+	assert.Equal(t, []model.ObjectKey{"questionnaire_image/1.png", "questionnaire_image/0.png", "sns_screenshot/1.png"}, objectKeys)
+	// 外部キーの参照元から順に削除する
+	assert.Equal(t, []string{
+		entity.TableNames.QuestionnaireQuestionAnswer,
+		entity.TableNames.QuestionnaireQuestionHistory,
+		entity.TableNames.QuestionnaireQuestion,
+		entity.TableNames.Questionnaire,
+		entity.TableNames.Examination,
+		entity.TableNames.WritingFeeHistory,
+		entity.TableNames.AssigneeLog,
+		entity.TableNames.Assignee,
+		entity.TableNames.WritingFeeTier,
+		entity.TableNames.Schedule,
+		entity.TableNames.DraftedItemInfo,
+		entity.TableNames.OfferItemRevision,
+		entity.TableNames.OfferItem,
+	}, statementTables(recorder.Statements()))
+	for _, s := range recorder.Statements() {
+		assert.True(t, strings.HasPrefix(s.Query, "DELETE FROM"), s.Query)
+	}
+}
@@ -0,0 +1,196 @@
+package repository_impl
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/converter"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	pkgid "github.com/terui-ryota/offer-item/pkg/id"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.opencensus.io/trace"
+)
+
+// 変更履歴に保存する項目ごとの変更内容
+type fieldChangeRecord struct {
+	Field  string `json:"field"`
+	Setter string `json:"setter"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// 変更履歴に保存する更新後のオファー案件とアンケート。各テーブルのエンティティをそのまま保存する
+type offerItemSnapshot struct {
+	OfferItem       entity.OfferItem                `json:"offer_item"`
+	Schedules       []*entity.Schedule              `json:"schedules"`
+	DraftedItemInfo *entity.DraftedItemInfo         `json:"drafted_item_info,omitempty"`
+	Questionnaire   *entity.Questionnaire           `json:"questionnaire,omitempty"`
+	Questions       []*entity.QuestionnaireQuestion `json:"questions,omitempty"`
+}
+
+// オファー案件の変更履歴を作成する
+func (o *OfferItemRepositoryImpl) CreateRevision(ctx context.Context, tx *sql.Tx, revision *model.OfferItemRevision) error {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.CreateRevision")
+	defer span.End()
+
+	records := make([]fieldChangeRecord, 0, len(revision.Changes()))
+	for _, c := range revision.Changes() {
+		records = append(records, fieldChangeRecord{Field: c.Field(), Setter: c.Setter(), Before: c.Before(), After: c.After()})
+	}
+	changes, err := json.Marshal(records)
+	if err != nil {
+		return apperr.OfferItemInternalError.Wrap(fmt.Errorf("json.Marshal: %w", err))
+	}
+	revisionSnapshot, err := convertRevisionToSnapshot(revision)
+	if err != nil {
+		return apperr.OfferItemInternalError.Wrap(fmt.Errorf("convertRevisionToSnapshot: %w", err))
+	}
+	snapshot, err := json.Marshal(revisionSnapshot)
+	if err != nil {
+		return apperr.OfferItemInternalError.Wrap(fmt.Errorf("json.Marshal: %w", err))
+	}
+
+	revisionEntity := &entity.OfferItemRevision{
+		ID:          pkgid.New(),
+		OfferItemID: revision.OfferItemID().String(),
+		Version:     revision.Version(),
+		Changes:     null.JSONFrom(changes),
+		Snapshot:    null.JSONFrom(snapshot),
+		ChangedBy:   revision.ChangedBy(),
+		ChangedAt:   revision.ChangedAt(),
+	}
+	if err := revisionEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+		return apperr.OfferItemInternalError.Wrap(err)
+	}
+	return nil
+}
+
+// オファー案件の変更履歴をバージョンの昇順で取得する
+func (o *OfferItemRepositoryImpl) ListRevisions(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) ([]*model.OfferItemRevision, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.ListRevisions")
+	defer span.End()
+
+	revisionEntities, err := entity.OfferItemRevisions(
+		entity.OfferItemRevisionWhere.OfferItemID.EQ(offerItemID.String()),
+		qm.OrderBy(entity.OfferItemRevisionColumns.Version),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.OfferItemRevisions.All: %w", err)
+	}
+	revisions := make([]*model.OfferItemRevision, 0, len(revisionEntities))
+	for _, e := range revisionEntities {
+		revision, err := convertRevisionToModel(ctx, e)
+		if err != nil {
+			return nil, fmt.Errorf("convertRevisionToModel: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// 指定日時の時点で最新のオファー案件の変更履歴を取得する
+func (o *OfferItemRepositoryImpl) GetRevisionAsOf(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, at time.Time) (*model.OfferItemRevision, error) {
+	ctx, span := trace.StartSpan(ctx, "OfferItemRepository.GetRevisionAsOf")
+	defer span.End()
+
+	revisionEntity, err := entity.OfferItemRevisions(
+		entity.OfferItemRevisionWhere.OfferItemID.EQ(offerItemID.String()),
+		entity.OfferItemRevisionWhere.ChangedAt.LTE(at),
+		qm.OrderBy(fmt.Sprintf("%s DESC", entity.OfferItemRevisionColumns.Version)),
+	).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperr.OfferItemNotFoundError.Wrap(err)
+		}
+		return nil, fmt.Errorf("entity.OfferItemRevisions.One: %w", err)
+	}
+	revision, err := convertRevisionToModel(ctx, revisionEntity)
+	if err != nil {
+		return nil, fmt.Errorf("convertRevisionToModel: %w", err)
+	}
+	return revision, nil
+}
+
+func convertRevisionToSnapshot(revision *model.OfferItemRevision) (offerItemSnapshot, error) {
+	offerItem := revision.OfferItem()
+	snapshot := offerItemSnapshot{
+		OfferItem: converter.ConvertOfferItemModelToEntity(offerItem),
+		Schedules: make([]*entity.Schedule, 0, len(offerItem.Schedules())),
+	}
+	// 更新後のバージョンで保存する
+	snapshot.OfferItem.Version = revision.Version()
+	snapshot.OfferItem.UpdatedAt = revision.ChangedAt()
+	for _, s := range offerItem.Schedules() {
+		snapshot.Schedules = append(snapshot.Schedules, converter.ScheduleModelToEntity(s))
+	}
+	if offerItem.DraftedItemInfo() != nil {
+		draftedItemInfo := converter.ConvertDraftedItemModelToEntity(offerItem.DraftedItemInfo())
+		snapshot.DraftedItemInfo = &draftedItemInfo
+	}
+	if revision.Questionnaire() != nil {
+		// スナップショットには変更時点の質問のみ保存し、質問の履歴は含めない
+		questionnaire, questions, _, err := convertQuestionnaireToEntity(*revision.Questionnaire())
+		if err != nil {
+			return offerItemSnapshot{}, fmt.Errorf("convertQuestionnaireToEntity: %w", err)
+		}
+		snapshot.Questionnaire = &questionnaire
+		snapshot.Questions = questions
+	}
+	return snapshot, nil
+}
+
+func convertRevisionToModel(ctx context.Context, e *entity.OfferItemRevision) (*model.OfferItemRevision, error) {
+	var records []fieldChangeRecord
+	if e.Changes.Valid {
+		if err := e.Changes.Unmarshal(&records); err != nil {
+			return nil, apperr.OfferItemInternalError.Wrap(fmt.Errorf("e.Changes.Unmarshal: %w", err))
+		}
+	}
+	changes := make([]model.FieldChange, 0, len(records))
+	for _, r := range records {
+		changes = append(changes, model.NewFieldChangeFromRepository(r.Field, r.Setter, r.Before, r.After))
+	}
+
+	var snapshot offerItemSnapshot
+	if err := e.Snapshot.Unmarshal(&snapshot); err != nil {
+		return nil, apperr.OfferItemInternalError.Wrap(fmt.Errorf("e.Snapshot.Unmarshal: %w", err))
+	}
+	schedules := make(model.ScheduleList, 0, len(snapshot.Schedules))
+	for _, s := range snapshot.Schedules {
+		schedules = append(schedules, converter.ScheduleEntityToModel(s))
+	}
+	var draftedItemInfo *model.ItemInfo
+	if snapshot.DraftedItemInfo != nil {
+		var err error
+		draftedItemInfo, err = converter.ConvertDraftedItemToModel(snapshot.DraftedItemInfo)
+		if err != nil {
+			return nil, fmt.Errorf("converter.ConvertDraftedItemToModel: %w", err)
+		}
+	}
+	offerItem, err := converter.ConvertOfferItemToModel(&snapshot.OfferItem, schedules, draftedItemInfo)
+	if err != nil {
+		return nil, fmt.Errorf("converter.ConvertOfferItemToModel: %w", err)
+	}
+	var questionnaire *model.Questionnaire
+	if snapshot.Questionnaire != nil {
+		questionnaire = convertQuestionnaireToModel(ctx, snapshot.Questionnaire, snapshot.Questions, nil)
+	}
+
+	return model.NewOfferItemRevisionFromRepository(
+		model.OfferItemID(e.OfferItemID),
+		e.Version,
+		changes,
+		e.ChangedBy,
+		e.ChangedAt,
+		offerItem,
+		questionnaire,
+	), nil
+}
@@ -0,0 +1,133 @@
+package repository_impl
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/domain/repository"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/converter"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	"github.com/terui-ryota/offer-item/pkg/apperr"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.opencensus.io/trace"
+)
+
+func NewPaymentBatchRepositoryImpl() repository.PaymentBatchRepository {
+	return &PaymentBatchRepositoryImpl{}
+}
+
+type PaymentBatchRepositoryImpl struct{}
+
+// 支払いバッチと支払い明細を作成する
+func (p *PaymentBatchRepositoryImpl) Create(ctx context.Context, tx *sql.Tx, paymentBatch *model.PaymentBatch) error {
+	ctx, span := trace.StartSpan(ctx, "PaymentBatchRepositoryImpl.Create")
+	defer span.End()
+
+	if err := converter.PaymentBatchModelToEntity(paymentBatch).Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("entity.PaymentBatch.Insert: %w", err)
+	}
+	for _, item := range paymentBatch.Items() {
+		if err := converter.PaymentItemModelToEntity(paymentBatch.ID(), item).Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("entity.PaymentItem.Insert: %w", err)
+		}
+	}
+	return nil
+}
+
+// 支払いバッチを支払い明細とともに取得する
+func (p *PaymentBatchRepositoryImpl) Get(ctx context.Context, exec boil.ContextExecutor, id model.PaymentBatchID, withLock bool) (*model.PaymentBatch, error) {
+	ctx, span := trace.StartSpan(ctx, "PaymentBatchRepositoryImpl.Get")
+	defer span.End()
+
+	queries := []qm.QueryMod{
+		entity.PaymentBatchWhere.ID.EQ(id.String()),
+	}
+	if withLock {
+		queries = append(queries, qm.For("UPDATE"))
+	}
+	paymentBatchEntity, err := entity.PaymentBatches(queries...).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperr.OfferItemNotFoundError.Wrap(err)
+		}
+		return nil, fmt.Errorf("entity.PaymentBatches.One: %w", err)
+	}
+
+	itemEntities, err := entity.PaymentItems(
+		entity.PaymentItemWhere.PaymentBatchID.EQ(id.String()),
+		qm.OrderBy(entity.PaymentItemColumns.OfferItemID),
+		qm.OrderBy(entity.PaymentItemColumns.AmebaID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.PaymentItems.All: %w", err)
+	}
+	return converter.PaymentBatchEntityToModel(paymentBatchEntity, itemEntities), nil
+}
+
+// 支払いバッチのステータスを更新する。支払い明細は作成後に変更しない
+func (p *PaymentBatchRepositoryImpl) Update(ctx context.Context, tx *sql.Tx, paymentBatch *model.PaymentBatch) error {
+	ctx, span := trace.StartSpan(ctx, "PaymentBatchRepositoryImpl.Update")
+	defer span.End()
+
+	whiteList := boil.Whitelist(
+		entity.PaymentBatchColumns.Status,
+		entity.PaymentBatchColumns.ConfirmedAt,
+		entity.PaymentBatchColumns.UpdatedAt,
+	)
+	if _, err := converter.PaymentBatchModelToEntity(paymentBatch).Update(ctx, tx, whiteList); err != nil {
+		return fmt.Errorf("entity.PaymentBatch.Update: %w", err)
+	}
+	return nil
+}
+
+// 未確定の支払いバッチに含まれているアサイニーIDを取得する
+// 確定済みのバッチで支払った後に再審査を経て再び「支払い中」になったアサイニーは、新しいバッチで支払う
+func (p *PaymentBatchRepositoryImpl) ListUnconfirmedAssigneeIDs(ctx context.Context, exec boil.ContextExecutor, assigneeIDs []model.AssigneeID) (map[model.AssigneeID]struct{}, error) {
+	ctx, span := trace.StartSpan(ctx, "PaymentBatchRepositoryImpl.ListUnconfirmedAssigneeIDs")
+	defer span.End()
+
+	res := make(map[model.AssigneeID]struct{})
+	if len(assigneeIDs) == 0 {
+		return res, nil
+	}
+	ids := make([]string, 0, len(assigneeIDs))
+	for _, id := range assigneeIDs {
+		ids = append(ids, id.String())
+	}
+	itemEntities, err := entity.PaymentItems(
+		qm.Select(entity.PaymentItemColumns.PaymentBatchID, entity.PaymentItemColumns.AssigneeID),
+		entity.PaymentItemWhere.AssigneeID.IN(ids),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.PaymentItems.All: %w", err)
+	}
+	if len(itemEntities) == 0 {
+		return res, nil
+	}
+	batchIDs := make([]string, 0, len(itemEntities))
+	for _, ie := range itemEntities {
+		batchIDs = append(batchIDs, ie.PaymentBatchID)
+	}
+	batchEntities, err := entity.PaymentBatches(
+		qm.Select(entity.PaymentBatchColumns.ID),
+		entity.PaymentBatchWhere.ID.IN(batchIDs),
+		entity.PaymentBatchWhere.Status.EQ(uint(model.PaymentBatchStatusCreated.Int())),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.PaymentBatches.All: %w", err)
+	}
+	unconfirmed := make(map[string]struct{}, len(batchEntities))
+	for _, be := range batchEntities {
+		unconfirmed[be.ID] = struct{}{}
+	}
+	for _, ie := range itemEntities {
+		if _, ok := unconfirmed[ie.PaymentBatchID]; ok {
+			res[model.AssigneeID(ie.AssigneeID)] = struct{}{}
+		}
+	}
+	return res, nil
+}
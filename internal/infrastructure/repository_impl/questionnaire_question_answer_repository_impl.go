@@ -3,13 +3,17 @@ package repository_impl
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/terui-ryota/offer-item/internal/domain/model"
 	"github.com/terui-ryota/offer-item/internal/domain/repository"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	null "github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"go.opencensus.io/trace"
 )
 
@@ -30,7 +34,7 @@ func (r *questionnaireQuestionAnswerRepository) DeleteByOfferItemID(ctx context.
 
 	if _, err := entity.QuestionnaireQuestionAnswers(
 		entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemID.String()),
-	).DeleteAll(ctx, tx); err != nil {
+	).DeleteAll(ctx, tx, true); err != nil {
 		return fmt.Errorf("entity.QuestionnaireQuestionAnswers.DeleteAll: %w", err)
 	}
 	return nil
@@ -60,14 +64,163 @@ func (r *questionnaireQuestionAnswerRepository) BulkGetByOfferItemIDAndAssigneeI
 		}
 		return nil, fmt.Errorf("entity.QuestionnaireQuestionAnswers.All: %w", err)
 	}
-	res := make(map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer)
-	for _, a := range answers {
-		as, ok := res[model.AssigneeID(a.AssigneeID)]
-		if !ok {
-			as = make(map[model.QuestionID]model.QuestionAnswer)
+	res, err := convertQuestionnaireQuestionAnswersToModel(answers)
+	if err != nil {
+		return nil, fmt.Errorf("convertQuestionnaireQuestionAnswersToModel: %w", err)
+	}
+	return res, nil
+}
+
+// ListByOfferItemID implements repository.QuestionnaireQuestionAnswerRepository.
+func (r *questionnaireQuestionAnswerRepository) ListByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer, error) {
+	ctx, span := trace.StartSpan(ctx, "questionnaireQuestionAnswerRepository.ListByOfferItemID")
+	defer span.End()
+
+	answers, err := entity.QuestionnaireQuestionAnswers(
+		entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemID.String()),
+	).All(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return make(map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer), nil
 		}
-		as[model.QuestionID(a.QuestionnaireQuestionID)] = *model.NewQuestionAnswerFromRepository(model.AssigneeID(a.AssigneeID), model.OfferItemID(a.OfferItemID), a.QuestionnaireQuestionID, a.Answer)
-		res[model.AssigneeID(a.AssigneeID)] = as
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionAnswers.All: %w", err)
+	}
+	res, err := convertQuestionnaireQuestionAnswersToModel(answers)
+	if err != nil {
+		return nil, fmt.Errorf("convertQuestionnaireQuestionAnswersToModel: %w", err)
+	}
+	return res, nil
+}
+
+// CountRespondents implements repository.QuestionnaireQuestionAnswerRepository.
+// 1 つ以上の質問に回答したアサイニー数を返す
+func (r *questionnaireQuestionAnswerRepository) CountRespondents(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "questionnaireQuestionAnswerRepository.CountRespondents")
+	defer span.End()
+
+	var record struct {
+		Count int `boil:"count"`
+	}
+	if err := entity.QuestionnaireQuestionAnswers(
+		qm.Select("COUNT(DISTINCT "+entity.QuestionnaireQuestionAnswerColumns.AssigneeID+") AS count"),
+		entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemID.String()),
+	).Bind(ctx, exec, &record); err != nil {
+		return 0, fmt.Errorf("entity.QuestionnaireQuestionAnswers.Bind: %w", err)
+	}
+	return record.Count, nil
+}
+
+// CountByQuestionVersion implements repository.QuestionnaireQuestionAnswerRepository.
+// 質問のバージョンごとの回答数を返す
+func (r *questionnaireQuestionAnswerRepository) CountByQuestionVersion(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (map[model.QuestionVersionKey]int, error) {
+	ctx, span := trace.StartSpan(ctx, "questionnaireQuestionAnswerRepository.CountByQuestionVersion")
+	defer span.End()
+
+	var records []struct {
+		QuestionID      string `boil:"questionnaire_question_id"`
+		QuestionVersion int    `boil:"question_version"`
+		Count           int    `boil:"count"`
+	}
+	if err := entity.QuestionnaireQuestionAnswers(
+		qm.Select(
+			entity.QuestionnaireQuestionAnswerColumns.QuestionnaireQuestionID,
+			entity.QuestionnaireQuestionAnswerColumns.QuestionVersion,
+			"COUNT(*) AS count",
+		),
+		entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemID.String()),
+		qm.GroupBy(entity.QuestionnaireQuestionAnswerColumns.QuestionnaireQuestionID+", "+entity.QuestionnaireQuestionAnswerColumns.QuestionVersion),
+	).Bind(ctx, exec, &records); err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionAnswers.Bind: %w", err)
+	}
+
+	res := make(map[model.QuestionVersionKey]int, len(records))
+	for _, record := range records {
+		res[model.NewQuestionVersionKey(model.QuestionID(record.QuestionID), record.QuestionVersion)] = record.Count
+	}
+	return res, nil
+}
+
+// CountByAnswerContent implements repository.QuestionnaireQuestionAnswerRepository.
+// 選択式の質問の回答内容ごとの回答数を返す。複数選択は選択した選択肢の組み合わせごとに集計する
+// 自由回答の質問を含めると回答ごとの行になるため、questionIDs には選択式の質問のみ指定する
+func (r *questionnaireQuestionAnswerRepository) CountByAnswerContent(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, questionIDs []model.QuestionID) ([]model.AnswerContentCount, error) {
+	ctx, span := trace.StartSpan(ctx, "questionnaireQuestionAnswerRepository.CountByAnswerContent")
+	defer span.End()
+
+	if len(questionIDs) == 0 {
+		return []model.AnswerContentCount{}, nil
+	}
+	ids := make([]string, 0, len(questionIDs))
+	for _, id := range questionIDs {
+		ids = append(ids, id.String())
+	}
+
+	var records []struct {
+		QuestionID      string    `boil:"questionnaire_question_id"`
+		QuestionVersion int       `boil:"question_version"`
+		Answer          string    `boil:"answer"`
+		SelectedOptions null.JSON `boil:"selected_options"`
+		Count           int       `boil:"count"`
+	}
+	if err := entity.QuestionnaireQuestionAnswers(
+		qm.Select(
+			entity.QuestionnaireQuestionAnswerColumns.QuestionnaireQuestionID,
+			entity.QuestionnaireQuestionAnswerColumns.QuestionVersion,
+			entity.QuestionnaireQuestionAnswerColumns.Answer,
+			entity.QuestionnaireQuestionAnswerColumns.SelectedOptions,
+			"COUNT(*) AS count",
+		),
+		entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemID.String()),
+		entity.QuestionnaireQuestionAnswerWhere.QuestionnaireQuestionID.IN(ids),
+		qm.GroupBy(
+			entity.QuestionnaireQuestionAnswerColumns.QuestionnaireQuestionID+", "+
+				entity.QuestionnaireQuestionAnswerColumns.QuestionVersion+", "+
+				entity.QuestionnaireQuestionAnswerColumns.Answer+", "+
+				entity.QuestionnaireQuestionAnswerColumns.SelectedOptions,
+		),
+	).Bind(ctx, exec, &records); err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionAnswers.Bind: %w", err)
+	}
+
+	res := make([]model.AnswerContentCount, 0, len(records))
+	for _, record := range records {
+		var selectedOptions []string
+		if record.SelectedOptions.Valid {
+			if err := record.SelectedOptions.Unmarshal(&selectedOptions); err != nil {
+				return nil, fmt.Errorf("record.SelectedOptions.Unmarshal: %w", err)
+			}
+		}
+		res = append(res, model.NewAnswerContentCountFromRepository(model.QuestionID(record.QuestionID), record.QuestionVersion, record.Answer, selectedOptions, record.Count))
+	}
+	return res, nil
+}
+
+// ListTextAnswers implements repository.QuestionnaireQuestionAnswerRepository.
+// 質問のバージョンの回答をアサイニーID順に offset, limit の範囲で返す(limit が 0 の場合は offset 以降の全件)
+func (r *questionnaireQuestionAnswerRepository) ListTextAnswers(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID, key model.QuestionVersionKey, offset, limit int) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "questionnaireQuestionAnswerRepository.ListTextAnswers")
+	defer span.End()
+
+	if limit == 0 {
+		// MySQL は LIMIT 無しの OFFSET を受け付けないため、上限の無い LIMIT を指定する
+		limit = math.MaxInt64
+	}
+	answers, err := entity.QuestionnaireQuestionAnswers(
+		qm.Select(entity.QuestionnaireQuestionAnswerColumns.Answer),
+		entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemID.String()),
+		entity.QuestionnaireQuestionAnswerWhere.QuestionnaireQuestionID.EQ(key.QuestionID().String()),
+		entity.QuestionnaireQuestionAnswerWhere.QuestionVersion.EQ(uint(key.Version())),
+		qm.OrderBy(entity.QuestionnaireQuestionAnswerColumns.AssigneeID),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionAnswers.All: %w", err)
+	}
+
+	res := make([]string, 0, len(answers))
+	for _, a := range answers {
+		res = append(res, a.Answer)
 	}
 	return res, nil
 }
@@ -76,25 +229,60 @@ func (r *questionnaireQuestionAnswerRepository) Save(ctx context.Context, tx *sq
 	ctx, span := trace.StartSpan(ctx, "questionnaireQuestionAnswerRepository.Save")
 	defer span.End()
 
+	// 論理削除済みの回答が残っている場合も置き換える
 	if _, err := entity.QuestionnaireQuestionAnswers(
+		qm.WithDeleted(),
 		entity.QuestionnaireQuestionAnswerWhere.OfferItemID.EQ(offerItemID.String()),
 		entity.QuestionnaireQuestionAnswerWhere.AssigneeID.EQ(assigneeID.String()),
-	).DeleteAll(ctx, tx); err != nil {
+	).DeleteAll(ctx, tx, true); err != nil {
 		return fmt.Errorf("entity.QuestionnaireQuestionAnswers.DeleteAll: %w", err)
 	}
 	for _, a := range answers {
-		if err := convertQuestionnaireQuestionAnswerToEntity(&a).Insert(ctx, tx, boil.Infer()); err != nil {
-			return fmt.Errorf("convertQuestionnaireQuestionAnswerToEntity.Insert: %w", err)
+		answer, err := convertQuestionnaireQuestionAnswerToEntity(&a)
+		if err != nil {
+			return fmt.Errorf("convertQuestionnaireQuestionAnswerToEntity: %w", err)
+		}
+		if err := answer.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("answer.Insert: %w", err)
 		}
 	}
 	return nil
 }
 
-func convertQuestionnaireQuestionAnswerToEntity(m *model.QuestionAnswer) *entity.QuestionnaireQuestionAnswer {
+func convertQuestionnaireQuestionAnswersToModel(answers entity.QuestionnaireQuestionAnswerSlice) (map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer, error) {
+	res := make(map[model.AssigneeID]map[model.QuestionID]model.QuestionAnswer)
+	for _, a := range answers {
+		as, ok := res[model.AssigneeID(a.AssigneeID)]
+		if !ok {
+			as = make(map[model.QuestionID]model.QuestionAnswer)
+		}
+		var selectedOptions []string
+		if a.SelectedOptions.Valid {
+			if err := a.SelectedOptions.Unmarshal(&selectedOptions); err != nil {
+				return nil, fmt.Errorf("a.SelectedOptions.Unmarshal: %w", err)
+			}
+		}
+		as[model.QuestionID(a.QuestionnaireQuestionID)] = *model.NewQuestionAnswerFromRepository(model.AssigneeID(a.AssigneeID), model.OfferItemID(a.OfferItemID), a.QuestionnaireQuestionID, a.Answer, selectedOptions, int(a.QuestionVersion))
+		res[model.AssigneeID(a.AssigneeID)] = as
+	}
+	return res, nil
+}
+
+func convertQuestionnaireQuestionAnswerToEntity(m *model.QuestionAnswer) (*entity.QuestionnaireQuestionAnswer, error) {
+	selectedOptions := null.JSONFromPtr(nil)
+	if m.SelectedOptions() != nil {
+		bs, err := json.Marshal(m.SelectedOptions())
+		if err != nil {
+			return nil, fmt.Errorf("json.Marshal: %w", err)
+		}
+		selectedOptions = null.JSONFrom(bs)
+	}
 	return &entity.QuestionnaireQuestionAnswer{
 		AssigneeID:              m.AssigneeID().String(),
 		QuestionnaireQuestionID: m.QuestionID().String(),
 		OfferItemID:             m.OfferItemID().String(),
 		Answer:                  m.Content(),
-	}
+		SelectedOptions:         selectedOptions,
+		QuestionVersion:         uint(m.QuestionVersion()),
+	}, nil
 }
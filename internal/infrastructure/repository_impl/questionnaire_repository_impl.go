@@ -12,6 +12,7 @@ import (
 	"github.com/terui-ryota/offer-item/internal/domain/repository"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
 	"github.com/terui-ryota/offer-item/pkg/apperr"
+	pkgid "github.com/terui-ryota/offer-item/pkg/id"
 	"github.com/terui-ryota/offer-item/pkg/logger"
 	null "github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -61,9 +62,19 @@ func (*questionnaireRepositoryImpl) BulkGet(ctx context.Context, exec boil.Conte
 		qs = append(qs, q)
 		questionsMap[q.OfferItemID] = qs
 	}
+	histories, err := entity.QuestionnaireQuestionHistories(
+		entity.QuestionnaireQuestionHistoryWhere.OfferItemID.IN(idStrs),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionHistories.All: %w", err)
+	}
+	historiesMap := make(map[string][]*entity.QuestionnaireQuestionHistory)
+	for _, h := range histories {
+		historiesMap[h.OfferItemID] = append(historiesMap[h.OfferItemID], h)
+	}
 	res := make(map[model.OfferItemID]model.Questionnaire)
 	for _, q := range questionnaires {
-		m := convertQuestionnaireToModel(ctx, q, questionsMap[q.OfferItemID])
+		m := convertQuestionnaireToModel(ctx, q, questionsMap[q.OfferItemID], historiesMap[q.OfferItemID])
 		res[model.OfferItemID(q.OfferItemID)] = *m
 	}
 	return res, nil
@@ -92,13 +103,62 @@ func (*questionnaireRepositoryImpl) Delete(ctx context.Context, tx *sql.Tx, id m
 	if len(qs) != 0 {
 		if _, err := entity.QuestionnaireQuestions(
 			entity.QuestionnaireQuestionWhere.OfferItemID.EQ(id.String()),
-		).DeleteAll(ctx, tx); err != nil {
+		).DeleteAll(ctx, tx, true); err != nil {
 			return fmt.Errorf("entity.QuestionnaireQuestions.DeleteAll: %w", err)
 		}
 	}
+	if _, err := entity.QuestionnaireQuestionHistories(
+		entity.QuestionnaireQuestionHistoryWhere.OfferItemID.EQ(id.String()),
+	).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("entity.QuestionnaireQuestionHistories.DeleteAll: %w", err)
+	}
 	if _, err := entity.Questionnaires(
+		qm.WithDeleted(),
 		entity.QuestionnaireWhere.OfferItemID.EQ(id.String()),
-	).DeleteAll(ctx, tx); err != nil {
+	).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.Questionnaires.DeleteAll: %w", err)
+	}
+	return nil
+}
+
+// SoftDelete implements repository.QuestionnaireRepository.
+// 回答が残るため、現在の質問は過去バージョンとして残してから削除する
+func (*questionnaireRepositoryImpl) SoftDelete(ctx context.Context, tx *sql.Tx, id model.OfferItemID) error {
+	ctx, span := trace.StartSpan(ctx, "questionnaireRepositoryImpl.SoftDelete")
+	defer span.End()
+
+	questions, err := entity.QuestionnaireQuestions(
+		entity.QuestionnaireQuestionWhere.OfferItemID.EQ(id.String()),
+	).All(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("entity.QuestionnaireQuestions.All: %w", err)
+	}
+	for _, q := range questions {
+		history := &entity.QuestionnaireQuestionHistory{
+			ID:                      pkgid.New(),
+			QuestionnaireQuestionID: q.ID,
+			OfferItemID:             q.OfferItemID,
+			Version:                 q.Version,
+			Title:                   q.Title,
+			Type:                    q.Type,
+			Image:                   q.Image,
+			AnswerOptions:           q.AnswerOptions,
+			IsOptional:              q.IsOptional,
+			MinValue:                q.MinValue,
+			MaxValue:                q.MaxValue,
+		}
+		if err := history.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("history.Insert: %w", err)
+		}
+	}
+	if _, err := entity.QuestionnaireQuestions(
+		entity.QuestionnaireQuestionWhere.OfferItemID.EQ(id.String()),
+	).DeleteAll(ctx, tx, true); err != nil {
+		return fmt.Errorf("entity.QuestionnaireQuestions.DeleteAll: %w", err)
+	}
+	if _, err := entity.Questionnaires(
+		entity.QuestionnaireWhere.OfferItemID.EQ(id.String()),
+	).DeleteAll(ctx, tx, false); err != nil {
 		return fmt.Errorf("entity.Questionnaires.DeleteAll: %w", err)
 	}
 	return nil
@@ -127,10 +187,16 @@ func (*questionnaireRepositoryImpl) Get(ctx context.Context, exec boil.ContextEx
 	if errors.Is(err, sql.ErrNoRows) {
 		questions = make(entity.QuestionnaireQuestionSlice, 0)
 	}
-	return convertQuestionnaireToModel(ctx, questionnaire, questions), nil
+	histories, err := entity.QuestionnaireQuestionHistories(
+		entity.QuestionnaireQuestionHistoryWhere.OfferItemID.EQ(id.String()),
+	).All(ctx, exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("entity.QuestionnaireQuestionHistories.All: %w", err)
+	}
+	return convertQuestionnaireToModel(ctx, questionnaire, questions, histories), nil
 }
 
-func convertQuestionnaireToModel(ctx context.Context, questionnaire *entity.Questionnaire, questions []*entity.QuestionnaireQuestion) *model.Questionnaire {
+func convertQuestionnaireToModel(ctx context.Context, questionnaire *entity.Questionnaire, questions []*entity.QuestionnaireQuestion, histories []*entity.QuestionnaireQuestionHistory) *model.Questionnaire {
 	sort.Slice(questions, func(i, j int) bool {
 		return questions[i].Priority < questions[j].Priority
 	})
@@ -142,38 +208,76 @@ func convertQuestionnaireToModel(ctx context.Context, questionnaire *entity.Ques
 			model.NewQuestionType(q.Type),
 			q.Title,
 			q.Image,
-			func() []string {
-				if !q.AnswerOptions.Valid {
+			unmarshalAnswerOptions(ctx, q.AnswerOptions),
+			!q.IsOptional,
+			q.MinValue.Ptr(),
+			q.MaxValue.Ptr(),
+			func() *model.DisplayCondition {
+				if !q.DisplayConditionQuestionID.Valid {
 					return nil
 				}
-				res := make([]string, 0)
-				if err := q.AnswerOptions.Unmarshal(&res); err != nil {
-					logger.FromContext(ctx).Errorf("failed to marshal: %w", err)
+				c, err := model.NewDisplayCondition(model.QuestionID(q.DisplayConditionQuestionID.String), q.DisplayConditionOption.String)
+				if err != nil {
+					logger.FromContext(ctx).Errorf("model.NewDisplayCondition: %w", err)
+					return nil
 				}
-				return res
+				return c
 			}(),
+			int(q.Version),
+		))
+	}
+	hs := make([]model.Question, 0, len(histories))
+	for _, h := range histories {
+		hs = append(hs, *model.NewQuestionFromRepository(
+			model.QuestionID(h.QuestionnaireQuestionID),
+			model.OfferItemID(h.OfferItemID),
+			model.NewQuestionType(h.Type),
+			h.Title,
+			h.Image,
+			unmarshalAnswerOptions(ctx, h.AnswerOptions),
+			!h.IsOptional,
+			h.MinValue.Ptr(),
+			h.MaxValue.Ptr(),
+			nil,
+			int(h.Version),
 		))
 	}
 	return model.NewQuestionnaireFromRepository(
 		model.OfferItemID(questionnaire.OfferItemID),
 		questionnaire.Description,
 		qs,
+		hs,
 	)
 }
 
+func unmarshalAnswerOptions(ctx context.Context, v null.JSON) []string {
+	if !v.Valid {
+		return nil
+	}
+	res := make([]string, 0)
+	if err := v.Unmarshal(&res); err != nil {
+		logger.FromContext(ctx).Errorf("failed to marshal: %w", err)
+	}
+	return res
+}
+
 // Save implements repository.QuestionnaireRepository.
 func (*questionnaireRepositoryImpl) Save(ctx context.Context, tx *sql.Tx, m model.Questionnaire) error {
 	ctx, span := trace.StartSpan(ctx, "questionnaireRepositoryImpl.Save")
 	defer span.End()
 
-	questionnaire, questions := convertQuestionnaireToEntity(m)
-	if _, err := entity.Questionnaires(entity.QuestionnaireWhere.OfferItemID.EQ(m.OfferItemID().String())).DeleteAll(ctx, tx); err != nil {
+	questionnaire, questions, histories, err := convertQuestionnaireToEntity(m)
+	if err != nil {
+		return fmt.Errorf("convertQuestionnaireToEntity: %w", err)
+	}
+	// 論理削除済みのアンケートが残っている場合も置き換える
+	if _, err := entity.Questionnaires(qm.WithDeleted(), entity.QuestionnaireWhere.OfferItemID.EQ(m.OfferItemID().String())).DeleteAll(ctx, tx, true); err != nil {
 		return fmt.Errorf("entity.Questionnaires.DeleteAll: %w", err)
 	}
 	if err := questionnaire.Insert(ctx, tx, boil.Infer()); err != nil {
 		return fmt.Errorf("questionnaire.Insert: %w", err)
 	}
-	if _, err := entity.QuestionnaireQuestions(entity.QuestionnaireQuestionWhere.OfferItemID.EQ(m.OfferItemID().String())).DeleteAll(ctx, tx); err != nil {
+	if _, err := entity.QuestionnaireQuestions(qm.WithDeleted(), entity.QuestionnaireQuestionWhere.OfferItemID.EQ(m.OfferItemID().String())).DeleteAll(ctx, tx, true); err != nil {
 		return fmt.Errorf("entity.QuestionnaireQuestions.DeleteAll: %w", err)
 	}
 	for _, q := range questions {
@@ -181,16 +285,39 @@ func (*questionnaireRepositoryImpl) Save(ctx context.Context, tx *sql.Tx, m mode
 			return fmt.Errorf("q.Insert: %w", err)
 		}
 	}
+	// 過去バージョンは追記のみ行う
+	saved, err := entity.QuestionnaireQuestionHistories(
+		entity.QuestionnaireQuestionHistoryWhere.OfferItemID.EQ(m.OfferItemID().String()),
+	).All(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("entity.QuestionnaireQuestionHistories.All: %w", err)
+	}
+	savedKeys := make(map[string]struct{}, len(saved))
+	for _, h := range saved {
+		savedKeys[fmt.Sprintf("%s:%d", h.QuestionnaireQuestionID, h.Version)] = struct{}{}
+	}
+	for _, h := range histories {
+		if _, ok := savedKeys[fmt.Sprintf("%s:%d", h.QuestionnaireQuestionID, h.Version)]; ok {
+			continue
+		}
+		if err := h.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("h.Insert: %w", err)
+		}
+	}
 	return nil
 }
 
-func convertQuestionnaireToEntity(m model.Questionnaire) (entity.Questionnaire, []*entity.QuestionnaireQuestion) {
+func convertQuestionnaireToEntity(m model.Questionnaire) (entity.Questionnaire, []*entity.QuestionnaireQuestion, []*entity.QuestionnaireQuestionHistory, error) {
 	questionnaire := entity.Questionnaire{
 		OfferItemID: m.OfferItemID().String(),
 		Description: m.Description(),
 	}
 	questions := make([]*entity.QuestionnaireQuestion, 0, len(m.Questions()))
 	for idx, q := range m.Questions() {
+		answerOptions, err := marshalAnswerOptions(q.Options())
+		if err != nil {
+			return entity.Questionnaire{}, nil, nil, fmt.Errorf("marshalAnswerOptions: %w", err)
+		}
 		questions = append(questions, &entity.QuestionnaireQuestion{
 			ID:          q.ID().String(),
 			OfferItemID: q.OfferItemID().String(),
@@ -198,19 +325,55 @@ func convertQuestionnaireToEntity(m model.Questionnaire) (entity.Questionnaire,
 			Type:        int(q.QuestionType()),
 			Image:       q.ImageURL(),
 			Priority:    idx + 1,
-			AnswerOptions: func() null.JSON {
-				if len(q.Options()) == 0 {
-					return null.JSONFromPtr(nil)
+			IsOptional:  !q.Required(),
+			MinValue:    null.Float64FromPtr(q.MinValue()),
+			MaxValue:    null.Float64FromPtr(q.MaxValue()),
+			DisplayConditionQuestionID: func() null.String {
+				if q.DisplayCondition() == nil {
+					return null.StringFromPtr(nil)
 				}
-				bs, err := json.Marshal(q.Options())
-				if err != nil {
-					logger.Default().Errorf("json.Marshal: %w", err)
-					return null.JSONFromPtr(nil)
-				} else {
-					return null.JSONFromPtr(&bs)
+				return null.StringFrom(q.DisplayCondition().QuestionID().String())
+			}(),
+			DisplayConditionOption: func() null.String {
+				if q.DisplayCondition() == nil {
+					return null.StringFromPtr(nil)
 				}
+				return null.StringFrom(q.DisplayCondition().Option())
 			}(),
+			AnswerOptions: answerOptions,
+			Version:       uint(q.Version()),
+		})
+	}
+	histories := make([]*entity.QuestionnaireQuestionHistory, 0, len(m.QuestionHistories()))
+	for _, h := range m.QuestionHistories() {
+		answerOptions, err := marshalAnswerOptions(h.Options())
+		if err != nil {
+			return entity.Questionnaire{}, nil, nil, fmt.Errorf("marshalAnswerOptions: %w", err)
+		}
+		histories = append(histories, &entity.QuestionnaireQuestionHistory{
+			ID:                      pkgid.New(),
+			QuestionnaireQuestionID: h.ID().String(),
+			OfferItemID:             h.OfferItemID().String(),
+			Version:                 uint(h.Version()),
+			Title:                   h.Title(),
+			Type:                    int(h.QuestionType()),
+			Image:                   h.ImageURL(),
+			AnswerOptions:           answerOptions,
+			IsOptional:              !h.Required(),
+			MinValue:                null.Float64FromPtr(h.MinValue()),
+			MaxValue:                null.Float64FromPtr(h.MaxValue()),
 		})
 	}
-	return questionnaire, questions
+	return questionnaire, questions, histories, nil
+}
+
+func marshalAnswerOptions(options []string) (null.JSON, error) {
+	if len(options) == 0 {
+		return null.JSONFromPtr(nil), nil
+	}
+	bs, err := json.Marshal(options)
+	if err != nil {
+		return null.JSON{}, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return null.JSONFrom(bs), nil
 }
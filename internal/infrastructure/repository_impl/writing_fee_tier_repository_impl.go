@@ -0,0 +1,64 @@
+package repository_impl
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/terui-ryota/offer-item/internal/domain/repository"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/db/entity"
+	pkgid "github.com/terui-ryota/offer-item/pkg/id"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.opencensus.io/trace"
+)
+
+func NewWritingFeeTierRepositoryImpl() repository.WritingFeeTierRepository {
+	return &WritingFeeTierRepositoryImpl{}
+}
+
+type WritingFeeTierRepositoryImpl struct{}
+
+// オファー案件の執筆報酬の単価を最小フォロワー数の昇順で取得する
+func (w *WritingFeeTierRepositoryImpl) ListByOfferItemID(ctx context.Context, exec boil.ContextExecutor, offerItemID model.OfferItemID) (model.WritingFeeTierList, error) {
+	ctx, span := trace.StartSpan(ctx, "WritingFeeTierRepositoryImpl.ListByOfferItemID")
+	defer span.End()
+
+	tierEntities, err := entity.WritingFeeTiers(
+		entity.WritingFeeTierWhere.OfferItemID.EQ(offerItemID.String()),
+		qm.OrderBy(entity.WritingFeeTierColumns.MinFollowerCount),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("entity.WritingFeeTiers.All: %w", err)
+	}
+	tiers := make(model.WritingFeeTierList, 0, len(tierEntities))
+	for _, e := range tierEntities {
+		tiers = append(tiers, model.NewWritingFeeTierFromRepository(e.MinFollowerCount, e.WritingFee))
+	}
+	return tiers, nil
+}
+
+// オファー案件の執筆報酬の単価を置き換える
+func (w *WritingFeeTierRepositoryImpl) Save(ctx context.Context, tx *sql.Tx, offerItemID model.OfferItemID, tiers model.WritingFeeTierList) error {
+	ctx, span := trace.StartSpan(ctx, "WritingFeeTierRepositoryImpl.Save")
+	defer span.End()
+
+	if _, err := entity.WritingFeeTiers(
+		entity.WritingFeeTierWhere.OfferItemID.EQ(offerItemID.String()),
+	).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("entity.WritingFeeTiers.DeleteAll: %w", err)
+	}
+	for _, t := range tiers {
+		e := &entity.WritingFeeTier{
+			ID:               pkgid.New(),
+			OfferItemID:      offerItemID.String(),
+			MinFollowerCount: t.MinFollowerCount(),
+			WritingFee:       t.WritingFee(),
+		}
+		if err := e.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("entity.WritingFeeTier.Insert: %w", err)
+		}
+	}
+	return nil
+}
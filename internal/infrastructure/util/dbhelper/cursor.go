@@ -0,0 +1,200 @@
+package dbhelper
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	idColumn = "id"
+
+	// カーソルに保持するソートキーの値の種別
+	cursorValuePrefixTime   = "t:"
+	cursorValuePrefixString = "s:"
+)
+
+// カーソルによるページ送りの設定
+// ソート設定の先頭のカラムと ID の組をソートキーとし、同じ値のデータが複数あってもページ間で重複・欠落しないようにする
+type CursorPagination struct {
+	// サブクエリを含む検索でもカラムを特定できるよう、カラムはテーブル名で修飾する
+	table string
+	// ソート設定のソートキー。ソート設定が無い場合は空文字
+	orderBy string
+	desc    bool
+	// ソートキーに対応するカラム名。ソート設定が無い場合は空文字
+	column string
+}
+
+func NewCursorPagination(table string, expressions SortExpressions, sorts []*model.Sort) (*CursorPagination, error) {
+	p := &CursorPagination{table: table}
+	if len(sorts) == 0 {
+		return p, nil
+	}
+
+	sort := sorts[0]
+	e, ok := expressions[sort.OrderBy()]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort key: %s", sort.OrderBy())
+	}
+	// 集計などの式の値はデータから取得できず、カーソルに保持できない
+	if e.Column == "" {
+		return nil, fmt.Errorf("sort key cannot be used with cursor: %s", sort.OrderBy())
+	}
+	p.orderBy = sort.OrderBy()
+	p.desc = sort.Desc()
+	p.column = e.Column
+	return p, nil
+}
+
+// カーソルの位置より後ろのデータを、取得上限数より 1 件多く取得するクエリを生成する
+// 1 件多く取得できた場合は次のページが存在する
+func (p *CursorPagination) QueryMods(cursor *model.Cursor, limit int) ([]qm.QueryMod, error) {
+	direction, op := "ASC", ">"
+	if p.desc {
+		direction, op = "DESC", "<"
+	}
+	id := p.table + "." + idColumn
+
+	mods := make([]qm.QueryMod, 0, 3)
+	if p.sortColumn() == "" {
+		if cursor != nil {
+			mods = append(mods, qm.Where(fmt.Sprintf("%s %s ?", id, op), cursor.ID()))
+		}
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s %s", id, direction)))
+	} else {
+		column := p.table + "." + p.sortColumn()
+		if cursor != nil {
+			value, err := decodeCursorValue(cursor.Value())
+			if err != nil {
+				return nil, fmt.Errorf("decodeCursorValue: %w", err)
+			}
+			// 行値構文 (col, id) > (?, ?) はインデックスが使われない場合があるため展開して指定する
+			mods = append(mods, qm.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND %s %s ?))", column, op, column, id, op), value, value, cursor.ID()))
+		}
+		mods = append(mods, qm.OrderBy(fmt.Sprintf("%s %s, %s %s", column, direction, id, direction)))
+	}
+	return append(mods, qm.Limit(limit+1)), nil
+}
+
+// ページの最後のデータから次のページのカーソルを生成する
+// last には db/entity の構造体のポインタが渡されることを想定する
+func (p *CursorPagination) NextCursor(last interface{}) (*model.Cursor, error) {
+	id, err := columnValue(last, idColumn)
+	if err != nil {
+		return nil, fmt.Errorf("columnValue: %w", err)
+	}
+	idString, ok := id.(string)
+	if !ok {
+		return nil, fmt.Errorf("id column must be string: %T", id)
+	}
+
+	value := ""
+	if p.sortColumn() != "" {
+		v, err := columnValue(last, p.sortColumn())
+		if err != nil {
+			return nil, fmt.Errorf("columnValue: %w", err)
+		}
+		if value, err = encodeCursorValue(v); err != nil {
+			return nil, fmt.Errorf("encodeCursorValue: %w", err)
+		}
+	}
+
+	cursor, err := model.NewCursor(p.orderBy, p.desc, value, idString)
+	if err != nil {
+		return nil, fmt.Errorf("model.NewCursor: %w", err)
+	}
+	return cursor, nil
+}
+
+// ID でソートする場合は ID のみをソートキーとする
+func (p *CursorPagination) sortColumn() string {
+	if p.column == idColumn {
+		return ""
+	}
+	return p.column
+}
+
+// boil タグからカラムに対応するフィールドの値を取得する
+func columnValue(entity interface{}, column string) (interface{}, error) {
+	v := reflect.Indirect(reflect.ValueOf(entity))
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("entity must be struct: %T", entity)
+	}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("boil"), ",")[0]
+		if name != column {
+			continue
+		}
+		value := v.Field(i).Interface()
+		// null パッケージの型は driver.Valuer を実装している
+		if valuer, ok := value.(driver.Valuer); ok {
+			return valuer.Value()
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("column not found: %s", column)
+}
+
+// 日時は DB の接続設定のタイムゾーンに依存しないよう time.Time のまま比較に使用するため、種別を付けて保持する
+func encodeCursorValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		// NULL は比較演算で一致しないため、NULL を含むカラムはソートキーに使用できない
+		return "", errors.New("sort column value must not be null")
+	case time.Time:
+		return cursorValuePrefixTime + v.Format(time.RFC3339Nano), nil
+	case []byte:
+		return cursorValuePrefixString + string(v), nil
+	default:
+		return cursorValuePrefixString + fmt.Sprint(v), nil
+	}
+}
+
+func decodeCursorValue(value string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(value, cursorValuePrefixTime):
+		t, err := time.Parse(time.RFC3339Nano, strings.TrimPrefix(value, cursorValuePrefixTime))
+		if err != nil {
+			return nil, fmt.Errorf("time.Parse: %w", err)
+		}
+		return t, nil
+	case strings.HasPrefix(value, cursorValuePrefixString):
+		return strings.TrimPrefix(value, cursorValuePrefixString), nil
+	default:
+		return nil, fmt.Errorf("invalid cursor value: %s", value)
+	}
+}
+
+// リスト取得条件からクエリを生成する
+// カーソルによるページ送りの場合は、次のページのカーソルの生成に使用する設定も返す
+func ListConditionQueryMods(table string, expressions SortExpressions, condition *model.ListCondition) ([]qm.QueryMod, *CursorPagination, error) {
+	if !condition.CursorMode() {
+		mods := []qm.QueryMod{qm.Limit(condition.Limit()), qm.Offset(condition.Offset())}
+		if len(condition.Sorts()) > 0 {
+			orderBy, err := CreateOrderByQueryMod(table, expressions, condition.Sorts())
+			if err != nil {
+				return nil, nil, fmt.Errorf("CreateOrderByQueryMod: %w", err)
+			}
+			mods = append(mods, orderBy)
+		}
+		return mods, nil, nil
+	}
+
+	pagination, err := NewCursorPagination(table, expressions, condition.Sorts())
+	if err != nil {
+		return nil, nil, fmt.Errorf("NewCursorPagination: %w", err)
+	}
+	mods, err := pagination.QueryMods(condition.Cursor(), condition.Limit())
+	if err != nil {
+		return nil, nil, fmt.Errorf("pagination.QueryMods: %w", err)
+	}
+	return mods, pagination, nil
+}
@@ -1,36 +1,50 @@
 package dbhelper
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
-// カラム情報とソート設定リストから、OrderBy 句を生成する
-// カラム情報は、db/entity の {entity名}Columns が渡されることを想定する
-func CreateOrderByClause(entityColumns interface{}, sorts []*model.Sort) (orderByClause string) {
-	columns := GetColumnsFromEntityColumns(entityColumns)
-	exists := func(list []string, target string) bool {
-		for _, elem := range list {
-			if elem == target {
-				return true
-			}
-		}
-		return false
-	}
+// ソートキーに対応する ORDER BY の式
+type SortExpression struct {
+	// ORDER BY に指定する式。サブクエリを指定する場合は括弧で囲む
+	Expr string
+	Args []interface{}
+	// 式がテーブルのカラムの場合のカラム名。カーソルによるページ送りのソートキーにはカラムのみ使用できる
+	Column string
+}
+
+// ソートキーと ORDER BY の式の対応。リストごとに使用できるソートキーのみを定義する
+type SortExpressions map[string]SortExpression
 
+// テーブルのカラムをソートキーとする式を生成する
+func ColumnSortExpression(table, column string) SortExpression {
+	return SortExpression{Expr: table + "." + column, Column: column}
+}
+
+// ソート設定リストから ORDER BY 句を生成する
+// 定義されていないソートキーはエラーとし、同じ値のデータの順序が一定になるよう最後に ID を追加する
+func CreateOrderByQueryMod(table string, expressions SortExpressions, sorts []*model.Sort) (qm.QueryMod, error) {
+	clauses := make([]string, 0, len(sorts)+1)
+	args := make([]interface{}, 0)
 	for _, sort := range sorts {
-		if exists(columns, sort.OrderBy()) {
-			if orderByClause != "" {
-				orderByClause = orderByClause + ", "
-			}
-			orderByClause = orderByClause + sort.OrderBy()
-			if sort.Desc() {
-				orderByClause = orderByClause + " desc"
-			}
+		e, ok := expressions[sort.OrderBy()]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort key: %s", sort.OrderBy())
 		}
+		clause := e.Expr
+		if sort.Desc() {
+			clause = clause + " DESC"
+		}
+		clauses = append(clauses, clause)
+		args = append(args, e.Args...)
 	}
-	return
+	clauses = append(clauses, table+"."+idColumn)
+	return qm.OrderBy(strings.Join(clauses, ", "), args...), nil
 }
 
 // カラム情報から string スライスを生成する
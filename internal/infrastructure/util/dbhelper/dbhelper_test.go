@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/terui-ryota/offer-item/internal/domain/model"
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 )
 
 var testEntityColumns = struct {
@@ -16,30 +19,61 @@ var testEntityColumns = struct {
 	UpdatedAt: "updated_at",
 }
 
-func TestCreateOrderByClause(t *testing.T) {
-	sorts := func() []*model.Sort {
-		list := make([]*model.Sort, 0, 3)
-		sort, _ := model.NewSort("id", false)
-		list = append(list, sort)
-		sort, _ = model.NewSort("created_at", true)
-		list = append(list, sort)
-		sort, _ = model.NewSort("updated_at", false)
-		list = append(list, sort)
-		return list
-	}()
-	expected := "id, created_at desc, updated_at"
-	result := CreateOrderByClause(testEntityColumns, sorts)
-	assert.Equal(t, expected, result)
+var testSortExpressions = SortExpressions{
+	"created_at": ColumnSortExpression("test", "created_at"),
+	"updated_at": ColumnSortExpression("test", "updated_at"),
+	"count":      {Expr: "(SELECT COUNT(*) FROM child WHERE child.test_id = test.id AND child.type = ?)", Args: []interface{}{1}},
+}
+
+func TestCreateOrderByQueryMod(t *testing.T) {
+	sort := func(orderBy string, desc bool) *model.Sort {
+		s, _ := model.NewSort(orderBy, desc)
+		return s
+	}
 
-	// 存在しないカラムを指定されても結果が変わらないことを確認
-	sorts = append(sorts,
-		func() *model.Sort {
-			sort, _ := model.NewSort("test", true)
-			return sort
-		}(),
-	)
-	result = CreateOrderByClause(testEntityColumns, sorts)
-	assert.Equal(t, expected, result)
+	tests := []struct {
+		name     string
+		sorts    []*model.Sort
+		expected string
+		args     []interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "カラム",
+			sorts:    []*model.Sort{sort("created_at", true), sort("updated_at", false)},
+			expected: "ORDER BY test.created_at DESC, test.updated_at, test.id",
+			args:     []interface{}{},
+		},
+		{
+			name:     "引数を持つ式",
+			sorts:    []*model.Sort{sort("count", true)},
+			expected: "ORDER BY (SELECT COUNT(*) FROM child WHERE child.test_id = test.id AND child.type = ?) DESC, test.id",
+			args:     []interface{}{1},
+		},
+		{
+			// 定義されていないソートキーは無視せずエラーにする
+			name:    "存在しないソートキー",
+			sorts:   []*model.Sort{sort("created_at", true), sort("test", true)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderBy, err := CreateOrderByQueryMod("test", testSortExpressions, tt.sorts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			q := &queries.Query{}
+			queries.SetDialect(q, &drivers.Dialect{LQ: '`', RQ: '`'})
+			queries.SetFrom(q, "test")
+			orderBy.Apply(q)
+			sql, args := queries.BuildQuery(q)
+			assert.Contains(t, sql, tt.expected)
+			assert.Equal(t, tt.args, append([]interface{}{}, args...))
+		})
+	}
 }
 
 func TestGetColumnsFromEntityColumns(t *testing.T) {
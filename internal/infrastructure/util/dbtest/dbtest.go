@@ -0,0 +1,231 @@
+// Package dbtest リポジトリのテストで使用する DB のスタブとクエリ数の検証を提供する
+package dbtest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// QueryCounter 発行されたクエリを記録する boil.ContextExecutor
+type QueryCounter struct {
+	exec boil.ContextExecutor
+
+	mu      sync.Mutex
+	queries []string
+}
+
+func NewQueryCounter(exec boil.ContextExecutor) *QueryCounter {
+	return &QueryCounter{exec: exec}
+}
+
+func (c *QueryCounter) record(query string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries = append(c.queries, query)
+}
+
+func (c *QueryCounter) Exec(query string, args ...interface{}) (sql.Result, error) {
+	c.record(query)
+	return c.exec.Exec(query, args...)
+}
+
+func (c *QueryCounter) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	c.record(query)
+	return c.exec.Query(query, args...)
+}
+
+func (c *QueryCounter) QueryRow(query string, args ...interface{}) *sql.Row {
+	c.record(query)
+	return c.exec.QueryRow(query, args...)
+}
+
+func (c *QueryCounter) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	c.record(query)
+	return c.exec.ExecContext(ctx, query, args...)
+}
+
+func (c *QueryCounter) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	c.record(query)
+	return c.exec.QueryContext(ctx, query, args...)
+}
+
+func (c *QueryCounter) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	c.record(query)
+	return c.exec.QueryRowContext(ctx, query, args...)
+}
+
+// Queries 記録したクエリを発行順に返す
+func (c *QueryCounter) Queries() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string{}, c.queries...)
+}
+
+func (c *QueryCounter) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.queries)
+}
+
+func (c *QueryCounter) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queries = nil
+}
+
+// AssertQueryCount 発行されたクエリ数を検証する。一致しない場合は発行されたクエリを出力する
+func AssertQueryCount(t testing.TB, c *QueryCounter, want int) bool {
+	t.Helper()
+	queries := c.Queries()
+	if len(queries) == want {
+		return true
+	}
+	t.Errorf("query count = %d, want %d\n%s", len(queries), want, strings.Join(queries, "\n"))
+	return false
+}
+
+// Table スタブの DB がテーブルに対するクエリに返すデータ
+type Table struct {
+	Columns []string
+	Rows    [][]driver.Value
+}
+
+// OpenStubDB クエリの FROM 句のテーブルに応じて、指定したデータを返す DB を生成する
+// WHERE 句は評価せず、COUNT(*) のクエリにはデータの件数を返す。指定の無いテーブルは空の結果を返す
+// 更新系のクエリは実行せず、1 行を更新したものとして扱う
+func OpenStubDB(t testing.TB, tables map[string]*Table) *sql.DB {
+	t.Helper()
+	db, _ := OpenRecordingStubDB(t, tables)
+	return db
+}
+
+// OpenRecordingStubDB OpenStubDB と同じ DB を生成し、実行された更新系のクエリを記録する
+func OpenRecordingStubDB(t testing.TB, tables map[string]*Table) (*sql.DB, *ExecRecorder) {
+	t.Helper()
+	recorder := &ExecRecorder{}
+	db := sql.OpenDB(&stubConnector{tables: tables, recorder: recorder})
+	t.Cleanup(func() { _ = db.Close() })
+	return db, recorder
+}
+
+// Statement スタブの DB で実行された更新系のクエリと引数
+type Statement struct {
+	Query string
+	Args  []driver.Value
+}
+
+// ExecRecorder スタブの DB で実行された更新系のクエリを記録する
+type ExecRecorder struct {
+	mu         sync.Mutex
+	statements []Statement
+}
+
+func (r *ExecRecorder) record(query string, args []driver.NamedValue) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	values := make([]driver.Value, 0, len(args))
+	for _, a := range args {
+		values = append(values, a.Value)
+	}
+	r.statements = append(r.statements, Statement{Query: query, Args: values})
+}
+
+// Statements 記録したクエリを実行順に返す
+func (r *ExecRecorder) Statements() []Statement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Statement{}, r.statements...)
+}
+
+var fromTablePattern = regexp.MustCompile("(?i)FROM `?(\\w+)`?")
+
+type stubConnector struct {
+	tables   map[string]*Table
+	recorder *ExecRecorder
+}
+
+func (c *stubConnector) Connect(context.Context) (driver.Conn, error) {
+	return &stubConn{tables: c.tables, recorder: c.recorder}, nil
+}
+
+func (c *stubConnector) Driver() driver.Driver {
+	return stubDriver{}
+}
+
+type stubDriver struct{}
+
+func (stubDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("dbtest: use OpenStubDB")
+}
+
+type stubConn struct {
+	tables   map[string]*Table
+	recorder *ExecRecorder
+}
+
+func (c *stubConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("dbtest: prepared statements are not supported")
+}
+
+func (c *stubConn) Close() error {
+	return nil
+}
+
+func (c *stubConn) Begin() (driver.Tx, error) {
+	return stubTx{}, nil
+}
+
+func (c *stubConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	table := &Table{}
+	if m := fromTablePattern.FindStringSubmatch(query); m != nil {
+		if t, ok := c.tables[m[1]]; ok {
+			table = t
+		}
+	}
+	if strings.Contains(strings.ToUpper(query), "COUNT(*)") {
+		return &stubRows{columns: []string{"count"}, rows: [][]driver.Value{{int64(len(table.Rows))}}}, nil
+	}
+	return &stubRows{columns: table.Columns, rows: table.Rows}, nil
+}
+
+func (c *stubConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.recorder.record(query, args)
+	return driver.RowsAffected(1), nil
+}
+
+type stubTx struct{}
+
+func (stubTx) Commit() error   { return nil }
+func (stubTx) Rollback() error { return nil }
+
+type stubRows struct {
+	columns []string
+	rows    [][]driver.Value
+	next    int
+}
+
+func (r *stubRows) Columns() []string {
+	return r.columns
+}
+
+func (r *stubRows) Close() error {
+	return nil
+}
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/google/wire"
 	"github.com/terui-ryota/offer-item/internal/application/service"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/component/rakuten"
+	"github.com/terui-ryota/offer-item/internal/infrastructure/component/storage"
 
 	"github.com/terui-ryota/offer-item/internal/infrastructure/adapter_impl"
 	"github.com/terui-ryota/offer-item/internal/infrastructure/repository_impl"
@@ -15,8 +16,15 @@ var WireSet = wire.NewSet(
 	repository_impl.NewExaminationRepositoryImpl,
 	repository_impl.NewQuestionnaireRepositoryImpl,
 	repository_impl.NewQuestionnaireQuestionAnswerRepositoryImpl,
+	repository_impl.NewPaymentBatchRepositoryImpl,
+	repository_impl.NewWritingFeeTierRepositoryImpl,
+	repository_impl.NewOfferItemArchiveRepositoryImpl,
+	repository_impl.NewLegacyImageRepositoryImpl,
 	adapter_impl.NewAffiliateItemAdapterImpl,
+	adapter_impl.NewAffiliateItemProviderRegistryFromConfig,
+	adapter_impl.NewAffiliateItemCache,
 	rakuten.NewRakutenIchibaClient,
 	rakuten.NewApplicationIDHelper,
+	storage.NewObjectStorage,
 	service.NewOfferItemServiceImpl,
 )
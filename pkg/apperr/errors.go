@@ -165,9 +165,12 @@ var (
 	OfferItemFormAlreadyAnsweredError           = newAppErr("OI400005", "form already answered", codes.FailedPrecondition)
 	OfferItemScheduleExpiredError               = newAppErr("OI400006", "schedule expired", codes.FailedPrecondition)
 	OfferItemNoNeedNotificationTaskCreatedError = newAppErr("OI400007", "no need notification task created", codes.FailedPrecondition)
+	OfferItemArchivedError                      = newAppErr("OI400008", "offer item is archived", codes.FailedPrecondition)
 	OfferItemNotFoundError                      = newAppErr("OI404000", "not found", codes.NotFound)
 	OfferItemAffiliateItemNotFoundError         = newAppErr("OI404001", "affiliate-item not found", codes.NotFound)
 	OfferItemBloggerPropertyNotFoundError       = newAppErr("OI404002", "blogger property not found", codes.NotFound)
+	OfferItemStorageObjectNotFoundError         = newAppErr("OI404003", "storage object not found", codes.NotFound)
+	OfferItemVersionConflictError               = newAppErr("OI409000", "offer item was updated by another operation", codes.Aborted)
 	OfferItemInternalError                      = newAppErr("OI500000", "internal error", codes.Internal)
 	OfferItemSendMailPreCheckFailedError        = newAppErr("OI500001", "validation before sending mail failed", codes.Internal)
 	OfferItemAffiliateItemUnavailableError      = newAppErr("OI503000", "unavailable affiliate-item context", codes.Unavailable)
@@ -0,0 +1,21 @@
+package requestmeta
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// incomingValue リクエストのメタデータから指定したキーの最初の値を取得する
+// 指定されていない場合や空文字の場合は false を返す
+func incomingValue(ctx context.Context, key string) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(key)
+	if len(values) == 0 || values[0] == "" {
+		return "", false
+	}
+	return values[0], true
+}
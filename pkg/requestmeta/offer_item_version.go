@@ -0,0 +1,25 @@
+package requestmeta
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// OfferItemVersionMetadataKey 更新元のオファー案件のバージョンを表す gRPC メタデータのキー
+const OfferItemVersionMetadataKey = "x-offer-item-version"
+
+// OfferItemVersionFromIncomingContext リクエストのメタデータからオファー案件のバージョンを取得する
+// 指定されていない場合は nil を返す
+func OfferItemVersionFromIncomingContext(ctx context.Context) (*int, error) {
+	raw, ok := incomingValue(ctx, OfferItemVersionMetadataKey)
+	if !ok || strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil || v < 0 {
+		return nil, fmt.Errorf("%s is invalid: %q", OfferItemVersionMetadataKey, raw)
+	}
+	return &v, nil
+}
@@ -0,0 +1,38 @@
+package requestmeta
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestedByMetadataKey 操作者を表す gRPC メタデータのキー
+const RequestedByMetadataKey = "x-requested-by"
+
+type requestedByContextKey struct{}
+
+// WithRequestedBy 操作者をコンテキストに設定する
+func WithRequestedBy(ctx context.Context, requestedBy string) context.Context {
+	return context.WithValue(ctx, requestedByContextKey{}, requestedBy)
+}
+
+// RequestedByFromContext コンテキストから操作者を取得する
+// バッチ処理など操作者が存在しない場合は空文字を返す
+func RequestedByFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(requestedByContextKey{}).(string)
+	return v
+}
+
+// UnaryServerInterceptor リクエストのメタデータに含まれる操作者をコンテキストに設定する
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(RequestedByMetadataKey); len(values) > 0 && strings.TrimSpace(values[0]) != "" {
+				ctx = WithRequestedBy(ctx, strings.TrimSpace(values[0]))
+			}
+		}
+		return handler(ctx, req)
+	}
+}
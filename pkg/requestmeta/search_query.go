@@ -0,0 +1,19 @@
+package requestmeta
+
+import (
+	"context"
+)
+
+// SearchQueryMetadataKey 全文検索のキーワードを表す gRPC メタデータのキー
+// 日本語のキーワードを送れるようバイナリのメタデータとして扱う
+const SearchQueryMetadataKey = "x-search-query-bin"
+
+// SearchQueryFromIncomingContext リクエストのメタデータから全文検索のキーワードを取得する
+// 指定されていない場合は nil を返す
+func SearchQueryFromIncomingContext(ctx context.Context) *string {
+	v, ok := incomingValue(ctx, SearchQueryMetadataKey)
+	if !ok {
+		return nil
+	}
+	return &v
+}
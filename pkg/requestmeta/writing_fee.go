@@ -0,0 +1,49 @@
+package requestmeta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// WritingFeeTiersMetadataKey 執筆報酬のデフォルト単価の一覧を JSON で表す gRPC メタデータのキー
+	// 空の配列を指定した場合はデフォルト単価を全て削除する
+	WritingFeeTiersMetadataKey = "x-writing-fee-tiers-bin"
+	// FollowerCountsMetadataKey アメーバIDごとのフォロワー数を JSON のオブジェクトで表す gRPC メタデータのキー
+	FollowerCountsMetadataKey = "x-follower-counts-bin"
+)
+
+// WritingFeeTier メタデータで指定する執筆報酬のデフォルト単価
+type WritingFeeTier struct {
+	MinFollowerCount int `json:"min_follower_count"`
+	WritingFee       int `json:"writing_fee"`
+}
+
+// WritingFeeTiersFromIncomingContext リクエストのメタデータから執筆報酬のデフォルト単価を取得する
+// 指定されていない場合は nil を返す
+func WritingFeeTiersFromIncomingContext(ctx context.Context) (*[]WritingFeeTier, error) {
+	raw, ok := incomingValue(ctx, WritingFeeTiersMetadataKey)
+	if !ok {
+		return nil, nil
+	}
+	tiers := make([]WritingFeeTier, 0)
+	if err := json.Unmarshal([]byte(raw), &tiers); err != nil {
+		return nil, fmt.Errorf("%s is invalid: %w", WritingFeeTiersMetadataKey, err)
+	}
+	return &tiers, nil
+}
+
+// FollowerCountsFromIncomingContext リクエストのメタデータからアメーバIDごとのフォロワー数を取得する
+// 指定されていない場合は空のマップを返す
+func FollowerCountsFromIncomingContext(ctx context.Context) (map[string]int, error) {
+	counts := make(map[string]int)
+	raw, ok := incomingValue(ctx, FollowerCountsMetadataKey)
+	if !ok {
+		return counts, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &counts); err != nil {
+		return nil, fmt.Errorf("%s is invalid: %w", FollowerCountsMetadataKey, err)
+	}
+	return counts, nil
+}